@@ -13,6 +13,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -122,7 +124,8 @@ func datasourceAutomationVariableCommonSchema(attType schema.ValueType) map[stri
 
 func resourceAutomationVariableCreateUpdate(d *schema.ResourceData, meta interface{}, varType string) error {
 	client := meta.(*ArmClient).automation.VariableClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -190,16 +193,17 @@ func resourceAutomationVariableCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceAutomationVariableRead(d *schema.ResourceData, meta interface{}, varType string) error {
 	client := meta.(*ArmClient).automation.VariableClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationVariableID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
-	name := id.Path["variables"]
+	accountName := id.AutomationAccountName
+	name := id.Name
 	varTypeLower := strings.ToLower(varType)
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
@@ -281,16 +285,17 @@ func datasourceAutomationVariableRead(d *schema.ResourceData, meta interface{},
 
 func resourceAutomationVariableDelete(d *schema.ResourceData, meta interface{}, varType string) error {
 	client := meta.(*ArmClient).automation.VariableClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationVariableID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
-	name := id.Path["variables"]
+	accountName := id.AutomationAccountName
+	name := id.Name
 
 	if _, err := client.Delete(ctx, resourceGroup, accountName, name); err != nil {
 		return fmt.Errorf("Error deleting Automation %s Variable %q (Automation Account Name %q / Resource Group %q): %+v", varType, name, accountName, resourceGroup, err)
@@ -7,26 +7,29 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
-		id, err := parseAzureResourceID(d.Id())
+		id, err := resourceid.ParseHDInsightClusterID(d.Id())
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := id.ResourceGroup
-		name := id.Path["clusters"]
+		name := id.Name
 
-		if d.HasChange("tags") {
+		if hasTagsChange(d) {
 			tags := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
-				Tags: expandTags(tags),
+				Tags: expandTagsWithDefaults(meta, tags),
 			}
 			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
 				return fmt.Errorf("Error updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
@@ -61,15 +64,16 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
-		id, err := parseAzureResourceID(d.Id())
+		id, err := resourceid.ParseHDInsightClusterID(d.Id())
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := id.ResourceGroup
-		name := id.Path["clusters"]
+		name := id.Name
 
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
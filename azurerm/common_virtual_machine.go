@@ -0,0 +1,1068 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// this file contains the functionality shared between the `azurerm_linux_virtual_machine`
+// and `azurerm_windows_virtual_machine` resources
+
+func virtualMachineOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					// whilst this is an enum in the API, Ultra SSD's can't be used as an OS Disk
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_encryption_set_id": diskEncryptionSetIDSchema(),
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			DiskEncryptionSet:  expandDiskEncryptionSetParameters(raw["disk_encryption_set_id"].(string)),
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if diffDiskSettings := raw["diff_disk_settings"].([]interface{}); len(diffDiskSettings) > 0 {
+		settings := diffDiskSettings[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(settings["option"].(string)),
+		}
+	}
+
+	if size := raw["disk_size_gb"].(int); size > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(size))
+	}
+
+	if name := raw["name"].(string); name != "" {
+		disk.Name = utils.String(name)
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineOSDisk(ctx context.Context, meta interface{}, input *compute.OSDisk) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if input.DiffDiskSettings != nil && input.DiffDiskSettings.Option != "" {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(input.DiffDiskSettings.Option),
+		})
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	diskEncryptionSetId := ""
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		diskEncryptionSetId = flattenDiskEncryptionSetParameters(input.ManagedDisk.DiskEncryptionSet)
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+
+		// the Virtual Machine doesn't always return the size of the OS Disk, so look it up if needed
+		if diskSizeGb == 0 {
+			disk, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, input.ManagedDisk, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			if disk != nil && disk.DiskProperties != nil && disk.DiskProperties.DiskSizeGB != nil {
+				diskSizeGb = int(*disk.DiskProperties.DiskSizeGB)
+			}
+		}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"disk_encryption_set_id":    diskEncryptionSetId,
+			"disk_size_gb":              diskSizeGb,
+			"diff_disk_settings":        diffDiskSettings,
+			"name":                      name,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}, nil
+}
+
+func virtualMachineSourceImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSourceImageIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ValidateFunc:  azure.ValidateResourceID,
+		ConflictsWith: []string{"source_image_reference"},
+	}
+}
+
+func expandVirtualMachineSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
+	if imageId != "" {
+		return &compute.ImageReference{
+			ID: utils.String(imageId),
+		}, nil
+	}
+
+	if len(referenceInput) == 0 {
+		return nil, fmt.Errorf("Either a `source_image_id` or a `source_image_reference` block must be specified!")
+	}
+
+	raw := referenceInput[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}, nil
+}
+
+func flattenVirtualMachineSourceImageReference(input *compute.ImageReference) []interface{} {
+	// since the image id is pulled out as a separate field, if that's set we should return an empty block here
+	if input == nil || (input.ID != nil && *input.ID != "") {
+		return []interface{}{}
+	}
+
+	var publisher, offer, sku, version string
+
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func virtualMachineBootDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.URLIsHTTPS,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 {
+		return &compute.DiagnosticsProfile{
+			BootDiagnostics: &compute.BootDiagnostics{
+				Enabled:    utils.Bool(false),
+				StorageURI: utils.String(""),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(true),
+			StorageURI: utils.String(raw["storage_account_uri"].(string)),
+		},
+	}
+}
+
+func flattenVirtualMachineBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
+	if input == nil || input.BootDiagnostics == nil || input.BootDiagnostics.Enabled == nil || !*input.BootDiagnostics.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountUri := ""
+	if input.BootDiagnostics.StorageURI != nil {
+		storageAccountUri = *input.BootDiagnostics.StorageURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_uri": storageAccountUri,
+		},
+	}
+}
+
+func virtualMachineIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineIdentity(input []interface{}) (*compute.VirtualMachineIdentity, error) {
+	if len(input) == 0 {
+		// this is only sent when updating the Virtual Machine, to remove any existing identities
+		return &compute.VirtualMachineIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+	for _, v := range raw["identity_ids"].(*schema.Set).List() {
+		identityIds[v.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+	}
+
+	if len(identityIds) > 0 {
+		if identity.Type != compute.ResourceIdentityTypeUserAssigned && identity.Type != compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+
+		identity.UserAssignedIdentities = identityIds
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+		},
+	}
+}
+
+func virtualMachinePlanSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachinePlan(input []interface{}) *compute.Plan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.Plan{
+		Name:      utils.String(raw["name"].(string)),
+		Product:   utils.String(raw["product"].(string)),
+		Publisher: utils.String(raw["publisher"].(string)),
+	}
+}
+
+func flattenVirtualMachinePlan(input *compute.Plan) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var name, product, publisher string
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Product != nil {
+		product = *input.Product
+	}
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":      name,
+			"product":   product,
+			"publisher": publisher,
+		},
+	}
+}
+
+func virtualMachinePrioritySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  string(compute.Regular),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Regular),
+			string(compute.Spot),
+		}, false),
+	}
+}
+
+func flattenVirtualMachinePriority(input compute.VirtualMachinePriorityTypes) string {
+	// Virtual Machines which were created without a Priority are Regular
+	if input == "" {
+		return string(compute.Regular)
+	}
+
+	return string(input)
+}
+
+func virtualMachineEvictionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Deallocate),
+			string(compute.Delete),
+		}, false),
+	}
+}
+
+func virtualMachineMaxBidPriceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeFloat,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validate.VirtualMachineMaxBidPrice,
+	}
+}
+
+// expandVirtualMachineBillingProfile returns the Billing Profile for a Spot Virtual Machine (or Scale Set),
+// which is nil for other priorities since a Billing Profile can't be specified for them
+func expandVirtualMachineBillingProfile(priority compute.VirtualMachinePriorityTypes, maxBidPrice float64) *compute.BillingProfile {
+	if priority != compute.Spot {
+		return nil
+	}
+
+	return &compute.BillingProfile{
+		MaxPrice: utils.Float(maxBidPrice),
+	}
+}
+
+func flattenVirtualMachineBillingProfile(input *compute.BillingProfile) float64 {
+	// when no Max Price is returned the Virtual Machine is paid for at up to the on-demand price
+	if input == nil || input.MaxPrice == nil {
+		return -1
+	}
+
+	return *input.MaxPrice
+}
+
+// validateVirtualMachinePriority validates the combination of the `priority`, `eviction_policy` and
+// `max_bid_price` fields at plan time, which is shared between the Virtual Machine and Virtual Machine
+// Scale Set resources - since the API otherwise only rejects these once the resource is being created
+func validateVirtualMachinePriority(priority compute.VirtualMachinePriorityTypes, evictionPolicy string, maxBidPrice float64) error {
+	// Low Priority and Spot Virtual Machines can be evicted, so need to know what to do when they are
+	evictable := priority == compute.Low || priority == compute.Spot
+	if evictable && evictionPolicy == "" {
+		return fmt.Errorf("An `eviction_policy` must be specified when `priority` is set to %q", string(priority))
+	}
+	if !evictable && evictionPolicy != "" {
+		return fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to %q or %q", string(compute.Low), string(compute.Spot))
+	}
+
+	if priority != compute.Spot && maxBidPrice != -1 {
+		return fmt.Errorf("A `max_bid_price` can only be specified when `priority` is set to %q", string(compute.Spot))
+	}
+
+	return nil
+}
+
+// virtualMachineCustomizeDiff validates the fields shared between the `azurerm_linux_virtual_machine`
+// and `azurerm_windows_virtual_machine` resources at plan time
+func virtualMachineCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	return validateVirtualMachinePriority(priority, d.Get("eviction_policy").(string), d.Get("max_bid_price").(float64))
+}
+
+// virtualMachineSecretSchema returns the schema for the `secret` block - on Windows each Certificate
+// must also specify the Certificate Store it should be installed into
+func virtualMachineSecretSchema(isWindows bool) *schema.Schema {
+	certificateSchema := map[string]*schema.Schema{
+		"url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateKeyVaultChildId,
+		},
+	}
+
+	if isWindows {
+		certificateSchema["store"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"certificate": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: certificateSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineSecrets(input []interface{}, isWindows bool) *[]compute.VaultSecretGroup {
+	output := make([]compute.VaultSecretGroup, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		certificates := make([]compute.VaultCertificate, 0)
+		for _, certificateRaw := range v["certificate"].(*schema.Set).List() {
+			certificateV := certificateRaw.(map[string]interface{})
+
+			certificate := compute.VaultCertificate{
+				CertificateURL: utils.String(certificateV["url"].(string)),
+			}
+			if isWindows {
+				certificate.CertificateStore = utils.String(certificateV["store"].(string))
+			}
+
+			certificates = append(certificates, certificate)
+		}
+
+		output = append(output, compute.VaultSecretGroup{
+			SourceVault: &compute.SubResource{
+				ID: utils.String(v["key_vault_id"].(string)),
+			},
+			VaultCertificates: &certificates,
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineSecrets(input *[]compute.VaultSecretGroup, isWindows bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input {
+		keyVaultId := ""
+		if v.SourceVault != nil && v.SourceVault.ID != nil {
+			keyVaultId = *v.SourceVault.ID
+		}
+
+		certificates := make([]interface{}, 0)
+		if v.VaultCertificates != nil {
+			for _, c := range *v.VaultCertificates {
+				certificate := make(map[string]interface{})
+
+				certificate["url"] = ""
+				if c.CertificateURL != nil {
+					certificate["url"] = *c.CertificateURL
+				}
+
+				if isWindows {
+					certificate["store"] = ""
+					if c.CertificateStore != nil {
+						certificate["store"] = *c.CertificateStore
+					}
+				}
+
+				certificates = append(certificates, certificate)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"key_vault_id": keyVaultId,
+			"certificate":  certificates,
+		})
+	}
+
+	return output
+}
+
+func expandVirtualMachineNetworkInterfaceIDs(input []interface{}) []compute.NetworkInterfaceReference {
+	output := make([]compute.NetworkInterfaceReference, 0)
+
+	for i, v := range input {
+		output = append(output, compute.NetworkInterfaceReference{
+			ID: utils.String(v.(string)),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				// the first Network Interface specified is the Primary
+				Primary: utils.Bool(i == 0),
+			},
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineNetworkInterfaceIDs(input *[]compute.NetworkInterfaceReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		output = append(output, *v.ID)
+	}
+
+	return output
+}
+
+// virtualMachineIsRunning determines if the specified Virtual Machine is currently running,
+// using the Power State exposed in the Instance View
+func virtualMachineIsRunning(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name string) (bool, error) {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			// could also be the provisioning state which we're not bothered with here
+			state := strings.ToLower(*status.Code)
+			if !strings.HasPrefix(state, "powerstate/") {
+				continue
+			}
+
+			return strings.TrimPrefix(state, "powerstate/") == "running", nil
+		}
+	}
+
+	return false, nil
+}
+
+// virtualMachineSizeIsAvailable determines if the Virtual Machine can be resized to the specified size
+// without being deallocated - that is, if the size is available on the cluster it's currently hosted on
+func virtualMachineSizeIsAvailable(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name, size string) (bool, error) {
+	sizes, err := client.ListAvailableSizes(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving available sizes for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if sizes.Value != nil {
+		for _, v := range *sizes.Value {
+			if v.Name != nil && strings.EqualFold(*v.Name, size) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// resizeVirtualMachineOSDisk resizes the Managed Disk used as the OS Disk for a Virtual Machine,
+// which requires that the Virtual Machine is deallocated
+func resizeVirtualMachineOSDisk(ctx context.Context, meta interface{}, osDisk *compute.OSDisk, newSize int) error {
+	client := meta.(*ArmClient).diskClient
+
+	if osDisk == nil || osDisk.ManagedDisk == nil || osDisk.ManagedDisk.ID == nil {
+		return fmt.Errorf("Error resizing the OS Disk: the ID of the Managed Disk was nil")
+	}
+
+	id, err := resourceid.ParseManagedDiskID(*osDisk.ManagedDisk.ID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Resizing OS Disk %q (Resource Group %q) to %dGB..", id.Name, id.ResourceGroup, newSize)
+	update := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{
+			DiskSizeGB: utils.Int32(int32(newSize)),
+		},
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error resizing OS Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for resize of OS Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Resized OS Disk %q (Resource Group %q) to %dGB.", id.Name, id.ResourceGroup, newSize)
+
+	return nil
+}
+
+// virtualMachineUpdateOptions describes the changes being made to a Virtual Machine which
+// require it to be shut down and/or deallocated before they can be applied
+type virtualMachineUpdateOptions struct {
+	shouldShutDown   bool
+	shouldDeallocate bool
+	osDiskNewSize    int
+}
+
+// updateVirtualMachine applies the specified update to the Virtual Machine - shutting
+// it down (and deallocating it) first where required, and turning it back on afterwards if it was running
+func updateVirtualMachine(ctx context.Context, meta interface{}, resourceGroup, name string, update compute.VirtualMachineUpdate, options virtualMachineUpdateOptions) error {
+	client := meta.(*ArmClient).vmClient
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	shouldTurnBackOn := false
+	if options.shouldShutDown || options.shouldDeallocate {
+		isRunning, err := virtualMachineIsRunning(ctx, client, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+		shouldTurnBackOn = isRunning
+
+		if isRunning {
+			log.Printf("[DEBUG] Shutting Down Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+			future, err := client.PowerOff(ctx, resourceGroup, name, utils.Bool(false))
+			if err != nil {
+				return fmt.Errorf("Error sending Power Off to Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for Power Off of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+			log.Printf("[DEBUG] Shut Down Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+		}
+	}
+
+	if options.shouldDeallocate {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.Deallocate(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error Deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+	}
+
+	if options.osDiskNewSize > 0 {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if existing.VirtualMachineProperties == nil || existing.VirtualMachineProperties.StorageProfile == nil {
+			return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): `storageProfile` was nil", name, resourceGroup)
+		}
+
+		if err := resizeVirtualMachineOSDisk(ctx, meta, existing.VirtualMachineProperties.StorageProfile.OsDisk, options.osDiskNewSize); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Update(ctx, resourceGroup, name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	if shouldTurnBackOn {
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.Start(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+		}
+		log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+	}
+
+	return nil
+}
+
+// deleteVirtualMachine deletes the Virtual Machine and then the Managed Disk used as it's OS Disk,
+// since the OS Disk is defined (and so managed) as a part of the Virtual Machine
+func deleteVirtualMachine(ctx context.Context, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).vmClient
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+		// Ephemeral OS Disks are removed with the Virtual Machine
+		if osDisk := props.StorageProfile.OsDisk; osDisk != nil && osDisk.ManagedDisk != nil && osDisk.DiffDiskSettings == nil {
+			log.Printf("[DEBUG] Deleting OS Disk for Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+			if err := resourceArmVirtualMachineDeleteManagedDisk(ctx, osDisk.ManagedDisk, meta); err != nil {
+				return fmt.Errorf("Error deleting OS Disk for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+			log.Printf("[DEBUG] Deleted OS Disk for Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+// virtualMachineIPAddresses contains the IP Addresses assigned to the Network Interfaces of a Virtual Machine
+type virtualMachineIPAddresses struct {
+	primaryPrivateAddress string
+	primaryPublicAddress  string
+	privateAddresses      []interface{}
+	publicAddresses       []interface{}
+}
+
+// retrieveVirtualMachineIPAddresses looks up the Private and Public IP Addresses assigned to
+// each Network Interface attached to the Virtual Machine
+func retrieveVirtualMachineIPAddresses(ctx context.Context, meta interface{}, input *[]compute.NetworkInterfaceReference) (*virtualMachineIPAddresses, error) {
+	nicClient := meta.(*ArmClient).ifaceClient
+	pipClient := meta.(*ArmClient).publicIPClient
+
+	output := virtualMachineIPAddresses{
+		privateAddresses: make([]interface{}, 0),
+		publicAddresses:  make([]interface{}, 0),
+	}
+
+	if input == nil {
+		return &output, nil
+	}
+
+	for i, reference := range *input {
+		if reference.ID == nil {
+			continue
+		}
+
+		isPrimary := i == 0
+		if props := reference.NetworkInterfaceReferenceProperties; props != nil && props.Primary != nil {
+			isPrimary = *props.Primary
+		}
+
+		nicId, err := resourceid.ParseNetworkInterfaceID(*reference.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		nic, err := nicClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+		}
+
+		if nic.InterfacePropertiesFormat == nil || nic.InterfacePropertiesFormat.IPConfigurations == nil {
+			continue
+		}
+
+		for _, config := range *nic.InterfacePropertiesFormat.IPConfigurations {
+			props := config.InterfaceIPConfigurationPropertiesFormat
+			if props == nil {
+				continue
+			}
+
+			if props.PrivateIPAddress != nil {
+				output.privateAddresses = append(output.privateAddresses, *props.PrivateIPAddress)
+				if isPrimary && output.primaryPrivateAddress == "" {
+					output.primaryPrivateAddress = *props.PrivateIPAddress
+				}
+			}
+
+			if props.PublicIPAddress == nil || props.PublicIPAddress.ID == nil {
+				continue
+			}
+
+			pipId, err := resourceid.ParsePublicIPID(*props.PublicIPAddress.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			pip, err := pipClient.Get(ctx, pipId.ResourceGroup, pipId.Name, "")
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving Public IP %q (Resource Group %q): %+v", pipId.Name, pipId.ResourceGroup, err)
+			}
+
+			if pipProps := pip.PublicIPAddressPropertiesFormat; pipProps != nil && pipProps.IPAddress != nil {
+				output.publicAddresses = append(output.publicAddresses, *pipProps.IPAddress)
+				if isPrimary && output.primaryPublicAddress == "" {
+					output.primaryPublicAddress = *pipProps.IPAddress
+				}
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+// setVirtualMachineIPAddresses sets the IP Address related fields for the Virtual Machine into the state,
+// along with the Connection Info used by Provisioners
+func setVirtualMachineIPAddresses(d *schema.ResourceData, addresses *virtualMachineIPAddresses, provisionerType string) error {
+	d.Set("private_ip_address", addresses.primaryPrivateAddress)
+	d.Set("public_ip_address", addresses.primaryPublicAddress)
+
+	if err := d.Set("private_ip_addresses", addresses.privateAddresses); err != nil {
+		return fmt.Errorf("Error setting `private_ip_addresses`: %+v", err)
+	}
+
+	if err := d.Set("public_ip_addresses", addresses.publicAddresses); err != nil {
+		return fmt.Errorf("Error setting `public_ip_addresses`: %+v", err)
+	}
+
+	connectionAddress := addresses.primaryPublicAddress
+	if connectionAddress == "" {
+		connectionAddress = addresses.primaryPrivateAddress
+	}
+
+	if connectionAddress != "" {
+		d.SetConnInfo(map[string]string{
+			"type": provisionerType,
+			"host": connectionAddress,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,1651 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// this file contains the functionality shared between the `azurerm_linux_virtual_machine_scale_set`
+// and `azurerm_windows_virtual_machine_scale_set` resources
+
+func virtualMachineScaleSetCapacitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntBetween(0, 1000),
+		DiffSuppressFunc: func(_, old, _ string, d *schema.ResourceData) bool {
+			// when the capacity is managed outside of Terraform (e.g. by an Autoscale Setting) any changes
+			// are ignored once the Scale Set exists - since the value in the config is only the initial capacity
+			if old == "" || d.Id() == "" {
+				return false
+			}
+
+			return d.Get("ignore_capacity_changes").(bool)
+		},
+	}
+}
+
+func virtualMachineScaleSetOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					// whilst this is an enum in the API, Ultra SSD's can't be used as an OS Disk
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_encryption_set_id": diskEncryptionSetIDSchema(),
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 4095),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.VirtualMachineScaleSetOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetOSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			DiskEncryptionSet:  expandDiskEncryptionSetParameters(raw["disk_encryption_set_id"].(string)),
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if osDiskSize := raw["disk_size_gb"].(int); osDiskSize > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(osDiskSize))
+	}
+
+	if diffDiskSettingsRaw := raw["diff_disk_settings"].([]interface{}); len(diffDiskSettingsRaw) > 0 {
+		diffDiskRaw := diffDiskSettingsRaw[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(diffDiskRaw["option"].(string)),
+		}
+	}
+
+	return &disk
+}
+
+func expandVirtualMachineScaleSetOSDiskUpdate(input []interface{}) *compute.VirtualMachineScaleSetUpdateOSDisk {
+	raw := input[0].(map[string]interface{})
+	disk := compute.VirtualMachineScaleSetUpdateOSDisk{
+		Caching:                 compute.CachingTypes(raw["caching"].(string)),
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+	}
+
+	if osDiskSize := raw["disk_size_gb"].(int); osDiskSize > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(osDiskSize))
+	}
+
+	return &disk
+}
+
+func flattenVirtualMachineScaleSetOSDisk(input *compute.VirtualMachineScaleSetOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if input.DiffDiskSettings != nil {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(input.DiffDiskSettings.Option),
+		})
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	diskEncryptionSetId := ""
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		diskEncryptionSetId = flattenDiskEncryptionSetParameters(input.ManagedDisk.DiskEncryptionSet)
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"diff_disk_settings":        diffDiskSettings,
+			"disk_encryption_set_id":    diskEncryptionSetId,
+			"disk_size_gb":              diskSizeGb,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+// unlike a Virtual Machine, the image used for a Virtual Machine Scale Set can be changed
+// in-place - with the change being rolled out to the instances based on the `upgrade_mode`
+func virtualMachineScaleSetSourceImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetSourceImageIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  azure.ValidateResourceID,
+		ConflictsWith: []string{"source_image_reference"},
+	}
+}
+
+func virtualMachineScaleSetDataDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				// the Disk Encryption Set of an existing Data Disk can't be changed, but new Data Disks can use one
+				"disk_encryption_set_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: resourceid.ValidateDiskEncryptionSetID,
+					// the Compute API returns the Resource Group name within this ID in UPPERCASE
+					DiffSuppressFunc: suppress.CaseDifference,
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 4095),
+				},
+
+				"lun": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 2000),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+						string(compute.StorageAccountTypesUltraSSDLRS),
+					}, false),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetDataDisks(input []interface{}) *[]compute.VirtualMachineScaleSetDataDisk {
+	disks := make([]compute.VirtualMachineScaleSetDataDisk, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		disks = append(disks, compute.VirtualMachineScaleSetDataDisk{
+			Caching:    compute.CachingTypes(raw["caching"].(string)),
+			DiskSizeGB: utils.Int32(int32(raw["disk_size_gb"].(int))),
+			Lun:        utils.Int32(int32(raw["lun"].(int))),
+			ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+				DiskEncryptionSet:  expandDiskEncryptionSetParameters(raw["disk_encryption_set_id"].(string)),
+				StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+			},
+			WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+			CreateOption:            compute.DiskCreateOptionTypesEmpty,
+		})
+	}
+
+	return &disks
+}
+
+func flattenVirtualMachineScaleSetDataDisks(input *[]compute.VirtualMachineScaleSetDataDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		diskSizeGb := 0
+		if v.DiskSizeGB != nil {
+			diskSizeGb = int(*v.DiskSizeGB)
+		}
+
+		lun := 0
+		if v.Lun != nil {
+			lun = int(*v.Lun)
+		}
+
+		diskEncryptionSetId := ""
+		storageAccountType := ""
+		if v.ManagedDisk != nil {
+			diskEncryptionSetId = flattenDiskEncryptionSetParameters(v.ManagedDisk.DiskEncryptionSet)
+			storageAccountType = string(v.ManagedDisk.StorageAccountType)
+		}
+
+		writeAcceleratorEnabled := false
+		if v.WriteAcceleratorEnabled != nil {
+			writeAcceleratorEnabled = *v.WriteAcceleratorEnabled
+		}
+
+		output = append(output, map[string]interface{}{
+			"caching":                   string(v.Caching),
+			"disk_encryption_set_id":    diskEncryptionSetId,
+			"disk_size_gb":              diskSizeGb,
+			"lun":                       lun,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		})
+	}
+
+	return output
+}
+
+func virtualMachineScaleSetNetworkInterfaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"ip_configuration": virtualMachineScaleSetIPConfigurationSchema(),
+
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"enable_accelerated_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"enable_ip_forwarding": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"network_security_group_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetIPConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"application_gateway_backend_address_pool_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"application_security_group_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+					Set:      schema.HashString,
+					MaxItems: 20,
+				},
+
+				"load_balancer_backend_address_pool_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"load_balancer_inbound_nat_rules_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"public_ip_address": virtualMachineScaleSetPublicIPAddressSchema(),
+
+				"subnet_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(compute.IPv4),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.IPv4),
+						string(compute.IPv6),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetPublicIPAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// Optional
+				"domain_name_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"idle_timeout_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(4, 32),
+				},
+
+				"ip_tag": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tag": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+
+				"public_ip_prefix_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetNetworkInterfaces(input []interface{}) (*[]compute.VirtualMachineScaleSetNetworkConfiguration, error) {
+	output := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		dnsServers := utils.ExpandStringSlice(raw["dns_servers"].([]interface{}))
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetIPConfiguration, 0)
+		ipConfigurationsRaw := raw["ip_configuration"].([]interface{})
+		for _, configV := range ipConfigurationsRaw {
+			configRaw := configV.(map[string]interface{})
+			ipConfiguration, err := expandVirtualMachineScaleSetIPConfiguration(configRaw)
+			if err != nil {
+				return nil, err
+			}
+
+			ipConfigurations = append(ipConfigurations, *ipConfiguration)
+		}
+
+		config := compute.VirtualMachineScaleSetNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: dnsServers,
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return &output, nil
+}
+
+func expandVirtualMachineScaleSetIPConfiguration(raw map[string]interface{}) (*compute.VirtualMachineScaleSetIPConfiguration, error) {
+	applicationGatewayBackendAddressPoolIdsRaw := raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List()
+	applicationGatewayBackendAddressPoolIds := expandIDsToSubResources(applicationGatewayBackendAddressPoolIdsRaw)
+
+	applicationSecurityGroupIdsRaw := raw["application_security_group_ids"].(*schema.Set).List()
+	applicationSecurityGroupIds := expandIDsToSubResources(applicationSecurityGroupIdsRaw)
+
+	loadBalancerBackendAddressPoolIdsRaw := raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List()
+	loadBalancerBackendAddressPoolIds := expandIDsToSubResources(loadBalancerBackendAddressPoolIdsRaw)
+
+	loadBalancerInboundNatPoolIdsRaw := raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List()
+	loadBalancerInboundNatPoolIds := expandIDsToSubResources(loadBalancerInboundNatPoolIdsRaw)
+
+	primary := raw["primary"].(bool)
+	version := compute.IPVersion(raw["version"].(string))
+	if primary && version == compute.IPv6 {
+		return nil, fmt.Errorf("An IPv6 Primary IP Configuration is unsupported - instead add a IPv4 IP Configuration as the Primary and make the IPv6 IP Configuration the secondary")
+	}
+
+	ipConfiguration := compute.VirtualMachineScaleSetIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+			Primary:                               utils.Bool(primary),
+			PrivateIPAddressVersion:               version,
+			ApplicationGatewayBackendAddressPools: applicationGatewayBackendAddressPoolIds,
+			ApplicationSecurityGroups:             applicationSecurityGroupIds,
+			LoadBalancerBackendAddressPools:       loadBalancerBackendAddressPoolIds,
+			LoadBalancerInboundNatPools:           loadBalancerInboundNatPoolIds,
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	publicIPConfigsRaw := raw["public_ip_address"].([]interface{})
+	if len(publicIPConfigsRaw) > 0 {
+		publicIPConfigRaw := publicIPConfigsRaw[0].(map[string]interface{})
+		publicIPAddressConfig := expandVirtualMachineScaleSetPublicIPAddress(publicIPConfigRaw)
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.PublicIPAddressConfiguration = publicIPAddressConfig
+	}
+
+	if version == compute.IPv4 && ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.Subnet == nil {
+		return nil, fmt.Errorf("`subnet_id` is required if `version` is `IPv4`")
+	}
+
+	return &ipConfiguration, nil
+}
+
+func expandVirtualMachineScaleSetPublicIPAddress(raw map[string]interface{}) *compute.VirtualMachineScaleSetPublicIPAddressConfiguration {
+	ipTagsRaw := raw["ip_tag"].([]interface{})
+	ipTags := make([]compute.VirtualMachineScaleSetIPTag, 0)
+	for _, ipTagV := range ipTagsRaw {
+		ipTagRaw := ipTagV.(map[string]interface{})
+		ipTags = append(ipTags, compute.VirtualMachineScaleSetIPTag{
+			Tag:       utils.String(ipTagRaw["tag"].(string)),
+			IPTagType: utils.String(ipTagRaw["type"].(string)),
+		})
+	}
+
+	publicIPAddressConfig := compute.VirtualMachineScaleSetPublicIPAddressConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetPublicIPAddressConfigurationProperties: &compute.VirtualMachineScaleSetPublicIPAddressConfigurationProperties{
+			IPTags: &ipTags,
+		},
+	}
+
+	if domainNameLabel := raw["domain_name_label"].(string); domainNameLabel != "" {
+		dns := &compute.VirtualMachineScaleSetPublicIPAddressConfigurationDNSSettings{
+			DomainNameLabel: utils.String(domainNameLabel),
+		}
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.DNSSettings = dns
+	}
+
+	if idleTimeout := raw["idle_timeout_in_minutes"].(int); idleTimeout > 0 {
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.IdleTimeoutInMinutes = utils.Int32(int32(idleTimeout))
+	}
+
+	if publicIPPrefixID := raw["public_ip_prefix_id"].(string); publicIPPrefixID != "" {
+		publicIPAddressConfig.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.PublicIPPrefix = &compute.SubResource{
+			ID: utils.String(publicIPPrefixID),
+		}
+	}
+
+	return &publicIPAddressConfig
+}
+
+func expandVirtualMachineScaleSetNetworkInterfacesUpdate(input []interface{}) (*[]compute.VirtualMachineScaleSetUpdateNetworkConfiguration, error) {
+	output := make([]compute.VirtualMachineScaleSetUpdateNetworkConfiguration, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		dnsServers := utils.ExpandStringSlice(raw["dns_servers"].([]interface{}))
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetUpdateIPConfiguration, 0)
+		ipConfigurationsRaw := raw["ip_configuration"].([]interface{})
+		for _, configV := range ipConfigurationsRaw {
+			configRaw := configV.(map[string]interface{})
+			ipConfiguration, err := expandVirtualMachineScaleSetIPConfigurationUpdate(configRaw)
+			if err != nil {
+				return nil, err
+			}
+
+			ipConfigurations = append(ipConfigurations, *ipConfiguration)
+		}
+
+		config := compute.VirtualMachineScaleSetUpdateNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetUpdateNetworkConfigurationProperties: &compute.VirtualMachineScaleSetUpdateNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: dnsServers,
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetUpdateNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return &output, nil
+}
+
+func expandVirtualMachineScaleSetIPConfigurationUpdate(raw map[string]interface{}) (*compute.VirtualMachineScaleSetUpdateIPConfiguration, error) {
+	applicationGatewayBackendAddressPoolIdsRaw := raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List()
+	applicationGatewayBackendAddressPoolIds := expandIDsToSubResources(applicationGatewayBackendAddressPoolIdsRaw)
+
+	applicationSecurityGroupIdsRaw := raw["application_security_group_ids"].(*schema.Set).List()
+	applicationSecurityGroupIds := expandIDsToSubResources(applicationSecurityGroupIdsRaw)
+
+	loadBalancerBackendAddressPoolIdsRaw := raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List()
+	loadBalancerBackendAddressPoolIds := expandIDsToSubResources(loadBalancerBackendAddressPoolIdsRaw)
+
+	loadBalancerInboundNatPoolIdsRaw := raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List()
+	loadBalancerInboundNatPoolIds := expandIDsToSubResources(loadBalancerInboundNatPoolIdsRaw)
+
+	primary := raw["primary"].(bool)
+	version := compute.IPVersion(raw["version"].(string))
+	if primary && version == compute.IPv6 {
+		return nil, fmt.Errorf("An IPv6 Primary IP Configuration is unsupported - instead add a IPv4 IP Configuration as the Primary and make the IPv6 IP Configuration the secondary")
+	}
+
+	ipConfiguration := compute.VirtualMachineScaleSetUpdateIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetUpdateIPConfigurationProperties: &compute.VirtualMachineScaleSetUpdateIPConfigurationProperties{
+			Primary:                               utils.Bool(primary),
+			PrivateIPAddressVersion:               version,
+			ApplicationGatewayBackendAddressPools: applicationGatewayBackendAddressPoolIds,
+			ApplicationSecurityGroups:             applicationSecurityGroupIds,
+			LoadBalancerBackendAddressPools:       loadBalancerBackendAddressPoolIds,
+			LoadBalancerInboundNatPools:           loadBalancerInboundNatPoolIds,
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetUpdateIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	publicIPConfigsRaw := raw["public_ip_address"].([]interface{})
+	if len(publicIPConfigsRaw) > 0 {
+		publicIPConfigRaw := publicIPConfigsRaw[0].(map[string]interface{})
+		publicIPAddressConfig := expandVirtualMachineScaleSetPublicIPAddressUpdate(publicIPConfigRaw)
+		ipConfiguration.VirtualMachineScaleSetUpdateIPConfigurationProperties.PublicIPAddressConfiguration = publicIPAddressConfig
+	}
+
+	if version == compute.IPv4 && ipConfiguration.VirtualMachineScaleSetUpdateIPConfigurationProperties.Subnet == nil {
+		return nil, fmt.Errorf("`subnet_id` is required if `version` is `IPv4`")
+	}
+
+	return &ipConfiguration, nil
+}
+
+func expandVirtualMachineScaleSetPublicIPAddressUpdate(raw map[string]interface{}) *compute.VirtualMachineScaleSetUpdatePublicIPAddressConfiguration {
+	publicIPAddressConfig := compute.VirtualMachineScaleSetUpdatePublicIPAddressConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties: &compute.VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties{},
+	}
+
+	if domainNameLabel := raw["domain_name_label"].(string); domainNameLabel != "" {
+		dns := &compute.VirtualMachineScaleSetPublicIPAddressConfigurationDNSSettings{
+			DomainNameLabel: utils.String(domainNameLabel),
+		}
+		publicIPAddressConfig.VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties.DNSSettings = dns
+	}
+
+	if idleTimeout := raw["idle_timeout_in_minutes"].(int); idleTimeout > 0 {
+		publicIPAddressConfig.VirtualMachineScaleSetUpdatePublicIPAddressConfigurationProperties.IdleTimeoutInMinutes = utils.Int32(int32(idleTimeout))
+	}
+
+	return &publicIPAddressConfig
+}
+
+func flattenVirtualMachineScaleSetNetworkInterfaces(input *[]compute.VirtualMachineScaleSetNetworkConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, v := range *input {
+		var name, networkSecurityGroupId string
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		props := v.VirtualMachineScaleSetNetworkConfigurationProperties
+		if props == nil {
+			continue
+		}
+
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *props.NetworkSecurityGroup.ID
+		}
+
+		var enableAcceleratedNetworking, enableIPForwarding, primary bool
+		if props.EnableAcceleratedNetworking != nil {
+			enableAcceleratedNetworking = *props.EnableAcceleratedNetworking
+		}
+		if props.EnableIPForwarding != nil {
+			enableIPForwarding = *props.EnableIPForwarding
+		}
+		if props.Primary != nil {
+			primary = *props.Primary
+		}
+
+		var dnsServers []interface{}
+		if settings := props.DNSSettings; settings != nil {
+			dnsServers = utils.FlattenStringSlice(settings.DNSServers)
+		}
+
+		var ipConfigurations []interface{}
+		if props.IPConfigurations != nil {
+			for _, configRaw := range *props.IPConfigurations {
+				config := flattenVirtualMachineScaleSetIPConfiguration(configRaw)
+				ipConfigurations = append(ipConfigurations, config)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"dns_servers":                   dnsServers,
+			"enable_accelerated_networking": enableAcceleratedNetworking,
+			"enable_ip_forwarding":          enableIPForwarding,
+			"ip_configuration":              ipConfigurations,
+			"network_security_group_id":     networkSecurityGroupId,
+			"primary":                       primary,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineScaleSetIPConfiguration(input compute.VirtualMachineScaleSetIPConfiguration) map[string]interface{} {
+	var name, subnetId string
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	props := input.VirtualMachineScaleSetIPConfigurationProperties
+	if props == nil {
+		return map[string]interface{}{
+			"name": name,
+		}
+	}
+
+	if props.Subnet != nil && props.Subnet.ID != nil {
+		subnetId = *props.Subnet.ID
+	}
+
+	var primary bool
+	if props.Primary != nil {
+		primary = *props.Primary
+	}
+
+	var publicIPAddresses []interface{}
+	if props.PublicIPAddressConfiguration != nil {
+		publicIPAddresses = append(publicIPAddresses, flattenVirtualMachineScaleSetPublicIPAddress(*props.PublicIPAddressConfiguration))
+	}
+
+	applicationGatewayBackendAddressPoolIds := flattenSubResourcesToIDs(props.ApplicationGatewayBackendAddressPools)
+	applicationSecurityGroupIds := flattenSubResourcesToIDs(props.ApplicationSecurityGroups)
+	loadBalancerBackendAddressPoolIds := flattenSubResourcesToIDs(props.LoadBalancerBackendAddressPools)
+	loadBalancerInboundNatRuleIds := flattenSubResourcesToIDs(props.LoadBalancerInboundNatPools)
+
+	return map[string]interface{}{
+		"name":              name,
+		"primary":           primary,
+		"public_ip_address": publicIPAddresses,
+		"subnet_id":         subnetId,
+		"version":           string(props.PrivateIPAddressVersion),
+		"application_gateway_backend_address_pool_ids": schema.NewSet(schema.HashString, applicationGatewayBackendAddressPoolIds),
+		"application_security_group_ids":               schema.NewSet(schema.HashString, applicationSecurityGroupIds),
+		"load_balancer_backend_address_pool_ids":       schema.NewSet(schema.HashString, loadBalancerBackendAddressPoolIds),
+		"load_balancer_inbound_nat_rules_ids":          schema.NewSet(schema.HashString, loadBalancerInboundNatRuleIds),
+	}
+}
+
+func flattenVirtualMachineScaleSetPublicIPAddress(input compute.VirtualMachineScaleSetPublicIPAddressConfiguration) map[string]interface{} {
+	ipTags := make([]interface{}, 0)
+	if input.IPTags != nil {
+		for _, rawTag := range *input.IPTags {
+			var tag, tagType string
+
+			if rawTag.IPTagType != nil {
+				tagType = *rawTag.IPTagType
+			}
+
+			if rawTag.Tag != nil {
+				tag = *rawTag.Tag
+			}
+
+			ipTags = append(ipTags, map[string]interface{}{
+				"tag":  tag,
+				"type": tagType,
+			})
+		}
+	}
+
+	var domainNameLabel, name, publicIPPrefixId string
+	if input.DNSSettings != nil && input.DNSSettings.DomainNameLabel != nil {
+		domainNameLabel = *input.DNSSettings.DomainNameLabel
+	}
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.PublicIPPrefix != nil && input.PublicIPPrefix.ID != nil {
+		publicIPPrefixId = *input.PublicIPPrefix.ID
+	}
+
+	var idleTimeoutInMinutes int
+	if input.IdleTimeoutInMinutes != nil {
+		idleTimeoutInMinutes = int(*input.IdleTimeoutInMinutes)
+	}
+
+	return map[string]interface{}{
+		"name":                    name,
+		"domain_name_label":       domainNameLabel,
+		"idle_timeout_in_minutes": idleTimeoutInMinutes,
+		"ip_tag":                  ipTags,
+		"public_ip_prefix_id":     publicIPPrefixId,
+	}
+}
+
+func expandIDsToSubResources(input []interface{}) *[]compute.SubResource {
+	ids := make([]compute.SubResource, 0)
+
+	for _, v := range input {
+		ids = append(ids, compute.SubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return &ids
+}
+
+func flattenSubResourcesToIDs(input *[]compute.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		ids = append(ids, *v.ID)
+	}
+
+	return ids
+}
+
+func expandVirtualMachineScaleSetIdentity(input []interface{}) (*compute.VirtualMachineScaleSetIdentity, error) {
+	if len(input) == 0 {
+		// this is only sent when updating the Virtual Machine Scale Set, to remove any existing identities
+		return &compute.VirtualMachineScaleSetIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineScaleSetIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+	for _, v := range raw["identity_ids"].(*schema.Set).List() {
+		identityIds[v.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+	}
+
+	if len(identityIds) > 0 {
+		if identity.Type != compute.ResourceIdentityTypeUserAssigned && identity.Type != compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+
+		identity.UserAssignedIdentities = identityIds
+	}
+
+	return &identity, nil
+}
+
+func flattenVirtualMachineScaleSetIdentity(input *compute.VirtualMachineScaleSetIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+		},
+	}
+}
+
+func virtualMachineScaleSetPrioritySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  string(compute.Regular),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Low),
+			string(compute.Regular),
+			string(compute.Spot),
+		}, false),
+	}
+}
+
+func virtualMachineScaleSetEvictionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Deallocate),
+			string(compute.Delete),
+		}, false),
+	}
+}
+
+func virtualMachineScaleSetMaxBidPriceSchema() *schema.Schema {
+	// the Max Bid Price of a Scale Set can only be changed whilst all of the instances are deallocated
+	maxBidPrice := virtualMachineMaxBidPriceSchema()
+	maxBidPrice.ForceNew = true
+	return maxBidPrice
+}
+
+func flattenVirtualMachineScaleSetPriority(input compute.VirtualMachinePriorityTypes) string {
+	// older Scale Sets don't return a Priority, in which case they're Regular
+	if input == "" {
+		return string(compute.Regular)
+	}
+
+	return string(input)
+}
+
+func virtualMachineScaleSetUpgradeModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  string(compute.UpgradeModeManual),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.UpgradeModeAutomatic),
+			string(compute.UpgradeModeManual),
+			string(compute.UpgradeModeRolling),
+		}, false),
+	}
+}
+
+func virtualMachineScaleSetRollingUpgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateIso8601Duration(),
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetRollingUpgradePolicy(input []interface{}) *compute.RollingUpgradePolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(raw["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(raw["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(raw["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(raw["pause_time_between_batches"].(string)),
+	}
+}
+
+func flattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	maxBatchInstancePercent := 0
+	if input.MaxBatchInstancePercent != nil {
+		maxBatchInstancePercent = int(*input.MaxBatchInstancePercent)
+	}
+
+	maxUnhealthyInstancePercent := 0
+	if input.MaxUnhealthyInstancePercent != nil {
+		maxUnhealthyInstancePercent = int(*input.MaxUnhealthyInstancePercent)
+	}
+
+	maxUnhealthyUpgradedInstancePercent := 0
+	if input.MaxUnhealthyUpgradedInstancePercent != nil {
+		maxUnhealthyUpgradedInstancePercent = int(*input.MaxUnhealthyUpgradedInstancePercent)
+	}
+
+	pauseTimeBetweenBatches := ""
+	if input.PauseTimeBetweenBatches != nil {
+		pauseTimeBetweenBatches = *input.PauseTimeBetweenBatches
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_batch_instance_percent":              maxBatchInstancePercent,
+			"max_unhealthy_instance_percent":          maxUnhealthyInstancePercent,
+			"max_unhealthy_upgraded_instance_percent": maxUnhealthyUpgradedInstancePercent,
+			"pause_time_between_batches":              pauseTimeBetweenBatches,
+		},
+	}
+}
+
+func virtualMachineScaleSetAutomaticOSUpgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disable_automatic_rollback": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"enable_automatic_os_upgrade": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// expandVirtualMachineScaleSetUpgradePolicy builds the Upgrade Policy from the `upgrade_mode`,
+// `automatic_os_upgrade_policy` and `rolling_upgrade_policy` fields
+func expandVirtualMachineScaleSetUpgradePolicy(d *schema.ResourceData) *compute.UpgradePolicy {
+	upgradePolicy := compute.UpgradePolicy{
+		Mode:                 compute.UpgradeMode(d.Get("upgrade_mode").(string)),
+		RollingUpgradePolicy: expandVirtualMachineScaleSetRollingUpgradePolicy(d.Get("rolling_upgrade_policy").([]interface{})),
+	}
+
+	if raw := d.Get("automatic_os_upgrade_policy").([]interface{}); len(raw) > 0 {
+		policy := raw[0].(map[string]interface{})
+		upgradePolicy.AutomaticOSUpgradePolicy = &compute.AutomaticOSUpgradePolicy{
+			DisableAutomaticRollback: utils.Bool(policy["disable_automatic_rollback"].(bool)),
+			EnableAutomaticOSUpgrade: utils.Bool(policy["enable_automatic_os_upgrade"].(bool)),
+		}
+	}
+
+	return &upgradePolicy
+}
+
+func flattenVirtualMachineScaleSetAutomaticOSUpgradePolicy(input *compute.UpgradePolicy) []interface{} {
+	if input == nil || input.AutomaticOSUpgradePolicy == nil {
+		return []interface{}{}
+	}
+
+	disableAutomaticRollback := false
+	if input.AutomaticOSUpgradePolicy.DisableAutomaticRollback != nil {
+		disableAutomaticRollback = *input.AutomaticOSUpgradePolicy.DisableAutomaticRollback
+	}
+
+	enableAutomaticOSUpgrade := false
+	if input.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil {
+		enableAutomaticOSUpgrade = *input.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_automatic_rollback":  disableAutomaticRollback,
+			"enable_automatic_os_upgrade": enableAutomaticOSUpgrade,
+		},
+	}
+}
+
+func setVirtualMachineScaleSetUpgradePolicy(d *schema.ResourceData, input *compute.UpgradePolicy) error {
+	upgradeMode := string(compute.UpgradeModeManual)
+	var rollingUpgradePolicy *compute.RollingUpgradePolicy
+	if input != nil {
+		upgradeMode = string(input.Mode)
+		rollingUpgradePolicy = input.RollingUpgradePolicy
+	}
+	d.Set("upgrade_mode", upgradeMode)
+
+	// the API returns default Automatic OS Upgrade & Rolling Upgrade Policies regardless of the Upgrade Mode,
+	// which can't be specified for every Upgrade Mode - so these are only set when they can be used
+	automaticOSUpgradePolicy := make([]interface{}, 0)
+	if upgradeMode != string(compute.UpgradeModeManual) {
+		automaticOSUpgradePolicy = flattenVirtualMachineScaleSetAutomaticOSUpgradePolicy(input)
+	}
+	if err := d.Set("automatic_os_upgrade_policy", automaticOSUpgradePolicy); err != nil {
+		return fmt.Errorf("Error setting `automatic_os_upgrade_policy`: %+v", err)
+	}
+
+	if upgradeMode != string(compute.UpgradeModeRolling) {
+		rollingUpgradePolicy = nil
+	}
+	if err := d.Set("rolling_upgrade_policy", flattenVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicy)); err != nil {
+		return fmt.Errorf("Error setting `rolling_upgrade_policy`: %+v", err)
+	}
+
+	return nil
+}
+
+func virtualMachineScaleSetAutomaticRepairsPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"grace_period": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT30M",
+					ValidateFunc: validateIso8601Duration(),
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetAutomaticRepairsPolicy(input []interface{}) *compute.AutomaticRepairsPolicy {
+	if len(input) == 0 {
+		// removing the block disables Automatic Instance Repairs
+		return &compute.AutomaticRepairsPolicy{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.AutomaticRepairsPolicy{
+		Enabled:     utils.Bool(raw["enabled"].(bool)),
+		GracePeriod: utils.String(raw["grace_period"].(string)),
+	}
+}
+
+func flattenVirtualMachineScaleSetAutomaticRepairsPolicy(input *compute.AutomaticRepairsPolicy) []interface{} {
+	// the API returns a disabled policy when none has been configured
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}
+	}
+
+	gracePeriod := ""
+	if input.GracePeriod != nil {
+		gracePeriod = *input.GracePeriod
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":      true,
+			"grace_period": gracePeriod,
+		},
+	}
+}
+
+func virtualMachineScaleSetScaleInPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  string(compute.Default),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(compute.Default),
+			string(compute.NewestVM),
+			string(compute.OldestVM),
+		}, false),
+	}
+}
+
+func expandVirtualMachineScaleSetScaleInPolicy(input string) *compute.ScaleInPolicy {
+	return &compute.ScaleInPolicy{
+		Rules: &[]compute.VirtualMachineScaleSetScaleInRules{
+			compute.VirtualMachineScaleSetScaleInRules(input),
+		},
+	}
+}
+
+func flattenVirtualMachineScaleSetScaleInPolicy(input *compute.ScaleInPolicy) string {
+	if input == nil || input.Rules == nil || len(*input.Rules) == 0 {
+		return string(compute.Default)
+	}
+
+	return string((*input.Rules)[0])
+}
+
+func virtualMachineScaleSetTerminateNotificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT5M",
+					ValidateFunc: validateIso8601Duration(),
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetScheduledEventsProfile(input []interface{}) *compute.ScheduledEventsProfile {
+	if len(input) == 0 {
+		// removing the block disables the Terminate Notification
+		return &compute.ScheduledEventsProfile{
+			TerminateNotificationProfile: &compute.TerminateNotificationProfile{
+				Enable: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.ScheduledEventsProfile{
+		TerminateNotificationProfile: &compute.TerminateNotificationProfile{
+			Enable:           utils.Bool(raw["enabled"].(bool)),
+			NotBeforeTimeout: utils.String(raw["timeout"].(string)),
+		},
+	}
+}
+
+func flattenVirtualMachineScaleSetScheduledEventsProfile(input *compute.ScheduledEventsProfile) []interface{} {
+	if input == nil || input.TerminateNotificationProfile == nil {
+		return []interface{}{}
+	}
+
+	profile := input.TerminateNotificationProfile
+	if profile.Enable == nil || !*profile.Enable {
+		return []interface{}{}
+	}
+
+	timeout := ""
+	if profile.NotBeforeTimeout != nil {
+		timeout = *profile.NotBeforeTimeout
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": true,
+			"timeout": timeout,
+		},
+	}
+}
+
+// virtualMachineScaleSetCustomizeDiff validates the combination of Upgrade Mode, Health Probe
+// and Upgrade Policies (and of Priority and Eviction Policy) at plan time, since the API only
+// surfaces these as (vague) errors during apply
+func virtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	if err := validateVirtualMachinePriority(priority, d.Get("eviction_policy").(string), d.Get("max_bid_price").(float64)); err != nil {
+		return err
+	}
+
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	automaticOSUpgradePolicy := d.Get("automatic_os_upgrade_policy").([]interface{})
+	rollingUpgradePolicy := d.Get("rolling_upgrade_policy").([]interface{})
+
+	if upgradeMode == compute.UpgradeModeRolling {
+		if len(rollingUpgradePolicy) == 0 {
+			return fmt.Errorf("A `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+		}
+
+		if d.Get("health_probe_id").(string) == "" {
+			return fmt.Errorf("`health_probe_id` must be set when `upgrade_mode` is set to %q", string(upgradeMode))
+		}
+	} else if len(rollingUpgradePolicy) > 0 {
+		return fmt.Errorf("A `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	if upgradeMode == compute.UpgradeModeManual && len(automaticOSUpgradePolicy) > 0 {
+		return fmt.Errorf("An `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	// the health of the instances is determined using the Health Probe
+	if raw := d.Get("automatic_instance_repair").([]interface{}); len(raw) > 0 && raw[0].(map[string]interface{})["enabled"].(bool) {
+		if d.Get("health_probe_id").(string) == "" {
+			return fmt.Errorf("`health_probe_id` must be set when `automatic_instance_repair` is enabled")
+		}
+	}
+
+	return nil
+}
+
+// updateVirtualMachineScaleSet applies the specified update to the Virtual Machine Scale Set - and when the
+// Virtual Machine Profile has changed, rolls the change out to the existing instances
+func updateVirtualMachineScaleSet(ctx context.Context, meta interface{}, resourceGroup, name string, update compute.VirtualMachineScaleSetUpdate, upgradeMode compute.UpgradeMode, profileChanged bool) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+
+	// when the Upgrade Mode is Rolling the platform starts a Rolling Upgrade once the model has changed - as such
+	// the latest Rolling Upgrade is retrieved first, so that the one started by this update can be identified
+	var previousRollingUpgrade *time.Time
+	if profileChanged && upgradeMode == compute.UpgradeModeRolling {
+		startTime, err := getVirtualMachineScaleSetLatestRollingUpgradeStartTime(ctx, meta, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+		previousRollingUpgrade = startTime
+	}
+
+	log.Printf("[DEBUG] Updating Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Update(ctx, resourceGroup, name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Virtual Machine Scale Set %q (Resource Group %q).", name, resourceGroup)
+
+	if !profileChanged {
+		return nil
+	}
+
+	return upgradeVirtualMachineScaleSetInstances(ctx, meta, resourceGroup, name, upgradeMode, previousRollingUpgrade)
+}
+
+// upgradeVirtualMachineScaleSetInstances rolls the latest model of the Virtual Machine Scale Set out to any instances
+// which aren't using it - when the Upgrade Mode is Rolling this waits for the Rolling Upgrade started by the platform,
+// whereas when it's Manual the instances are only upgraded when the user has opted into this via the `features` block
+func upgradeVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, resourceGroup, name string, upgradeMode compute.UpgradeMode, previousRollingUpgrade *time.Time) error {
+	if upgradeMode == compute.UpgradeModeManual && !meta.(*ArmClient).Features.VirtualMachineScaleSet.RollInstancesWhenRequired {
+		log.Printf("[DEBUG] Not upgrading the instances of Virtual Machine Scale Set %q (Resource Group %q) since the Upgrade Mode is Manual.", name, resourceGroup)
+		return nil
+	}
+
+	instanceIds, err := listVirtualMachineScaleSetInstancesRequiringUpgrade(ctx, meta, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] All instances of Virtual Machine Scale Set %q (Resource Group %q) are using the latest model.", name, resourceGroup)
+		return nil
+	}
+
+	if upgradeMode == compute.UpgradeModeRolling {
+		return waitForVirtualMachineScaleSetRollingUpgrade(ctx, meta, resourceGroup, name, previousRollingUpgrade)
+	}
+
+	client := meta.(*ArmClient).vmScaleSetClient
+
+	log.Printf("[DEBUG] Upgrading %d instances of Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", len(instanceIds), name, resourceGroup)
+	future, err := client.UpdateInstances(ctx, resourceGroup, name, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	})
+	if err != nil {
+		return fmt.Errorf("Error upgrading the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the instances of Virtual Machine Scale Set %q (Resource Group %q) to be upgraded: %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Upgraded the instances of Virtual Machine Scale Set %q (Resource Group %q).", name, resourceGroup)
+
+	return nil
+}
+
+func listVirtualMachineScaleSetInstancesRequiringUpgrade(ctx context.Context, meta interface{}, resourceGroup, name string) ([]string, error) {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+
+	instanceIds := make([]string, 0)
+	iterator, err := client.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		if instance.InstanceID != nil {
+			props := instance.VirtualMachineScaleSetVMProperties
+			if props == nil || props.LatestModelApplied == nil || !*props.LatestModelApplied {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return instanceIds, nil
+}
+
+// getVirtualMachineScaleSetLatestRollingUpgradeStartTime returns the time at which the latest Rolling Upgrade
+// of the Virtual Machine Scale Set started, which is nil when no Rolling Upgrade has taken place
+func getVirtualMachineScaleSetLatestRollingUpgradeStartTime(ctx context.Context, meta interface{}, resourceGroup, name string) (*time.Time, error) {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+	resp, err := client.GetLatest(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if props := resp.RollingUpgradeStatusInfoProperties; props != nil && props.RunningStatus != nil && props.RunningStatus.StartTime != nil {
+		return &props.RunningStatus.StartTime.Time, nil
+	}
+
+	return nil, nil
+}
+
+// virtualMachineScaleSetRollingUpgradeNotStarted is the status used whilst waiting for the platform to start the Rolling Upgrade
+const virtualMachineScaleSetRollingUpgradeNotStarted = "NotStarted"
+
+// waitForVirtualMachineScaleSetRollingUpgrade waits for the platform to start a Rolling Upgrade (after the one which
+// started at `previousRollingUpgrade`, if any) and then for that Rolling Upgrade to complete
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, meta interface{}, resourceGroup, name string, previousRollingUpgrade *time.Time) error {
+	log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resourceGroup)
+
+	timeout := 60 * time.Minute
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			virtualMachineScaleSetRollingUpgradeNotStarted,
+			string(compute.RollingUpgradeStatusCodeRollingForward),
+		},
+		Target:     []string{string(compute.RollingUpgradeStatusCodeCompleted)},
+		Refresh:    virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx, meta, resourceGroup, name, previousRollingUpgrade),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) completed.", name, resourceGroup)
+	return nil
+}
+
+func virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx context.Context, meta interface{}, resourceGroup, name string, previousRollingUpgrade *time.Time) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+		resp, err := client.GetLatest(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, virtualMachineScaleSetRollingUpgradeNotStarted, nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		props := resp.RollingUpgradeStatusInfoProperties
+		if props == nil || props.RunningStatus == nil {
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): `runningStatus` was nil", name, resourceGroup)
+		}
+
+		status := props.RunningStatus
+		if status.StartTime == nil || (previousRollingUpgrade != nil && !status.StartTime.Time.After(*previousRollingUpgrade)) {
+			return resp, virtualMachineScaleSetRollingUpgradeNotStarted, nil
+		}
+
+		switch status.Code {
+		case compute.RollingUpgradeStatusCodeCancelled, compute.RollingUpgradeStatusCodeFaulted:
+			message := ""
+			if props.Error != nil && props.Error.Message != nil {
+				message = *props.Error.Message
+			}
+
+			return nil, "", fmt.Errorf("Rolling Upgrade finished with the status %q: %s", string(status.Code), message)
+		}
+
+		if progress := props.Progress; progress != nil {
+			log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q): %s successful / %s in progress / %s pending / %s failed",
+				name, resourceGroup, formatInt32(progress.SuccessfulInstanceCount), formatInt32(progress.InProgressInstanceCount), formatInt32(progress.PendingInstanceCount), formatInt32(progress.FailedInstanceCount))
+		}
+
+		return resp, string(status.Code), nil
+	}
+}
+
+func formatInt32(input *int32) string {
+	if input == nil {
+		return "0"
+	}
+
+	return strconv.Itoa(int(*input))
+}
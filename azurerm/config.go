@@ -6,11 +6,9 @@ import (
 	"log"
 	"os"
 	"strings"
-	"sync"
-	"time"
 
 	resourcesprofile "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
 	storeAccount "github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
@@ -22,15 +20,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
-	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
@@ -66,6 +63,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resourcegraph"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
@@ -76,7 +74,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -91,7 +88,20 @@ type ArmClient struct {
 	environment              azure.Environment
 	skipProviderRegistration bool
 
+	// defaultTags are the tags configured on the Provider which are applied to each resource
+	defaultTags map[string]interface{}
+
+	// requiredTagKeys are the keys of the tags which must be specified on each resource
+	requiredTagKeys []string
+
+	// httpTraceSink is (optionally) where each request to Resource Manager is traced
+	httpTraceSink *common.HTTPTraceSink
+
+	// retryPolicy determines how throttled requests to Resource Manager are retried
+	retryPolicy *common.RetryPolicy
+
 	StopContext context.Context
+	Features    features.UserFeatures
 
 	// Services
 	analysisservices *analysisservices.Client
@@ -129,6 +139,7 @@ type ArmClient struct {
 	recoveryServices *recoveryservices.Client
 	redis            *redis.Client
 	relay            *relay.Client
+	resourceGraph    *resourcegraph.Client
 	scheduler        *scheduler.Client
 	search           *search.Client
 	securityCenter   *securitycenter.Client
@@ -148,19 +159,25 @@ type ArmClient struct {
 	autoscaleSettingsClient insights.AutoscaleSettingsClient
 
 	// Compute
-	availSetClient             compute.AvailabilitySetsClient
-	diskClient                 compute.DisksClient
-	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
-	snapshotsClient            compute.SnapshotsClient
-	usageOpsClient             compute.UsageClient
-	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
-	vmImageClient              compute.VirtualMachineImagesClient
-	vmClient                   compute.VirtualMachinesClient
+	availSetClient                  compute.AvailabilitySetsClient
+	dedicatedHostGroupsClient       compute.DedicatedHostGroupsClient
+	dedicatedHostsClient            compute.DedicatedHostsClient
+	diskClient                      compute.DisksClient
+	diskEncryptionSetsClient        compute.DiskEncryptionSetsClient
+	imageClient                     compute.ImagesClient
+	galleriesClient                 compute.GalleriesClient
+	galleryImagesClient             compute.GalleryImagesClient
+	galleryImageVersionsClient      compute.GalleryImageVersionsClient
+	proximityPlacementGroupsClient  compute.ProximityPlacementGroupsClient
+	snapshotsClient                 compute.SnapshotsClient
+	usageOpsClient                  compute.UsageClient
+	vmExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient               compute.VirtualMachineExtensionsClient
+	vmScaleSetClient                compute.VirtualMachineScaleSetsClient
+	vmScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	vmScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	vmImageClient                   compute.VirtualMachineImagesClient
+	vmClient                        compute.VirtualMachinesClient
 
 	sqlDatabasesClient                       sql.DatabasesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
@@ -234,9 +251,11 @@ func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Autho
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
 	client.RequestInspector = common.WithCorrelationRequestID(common.CorrelationRequestID())
-	client.Sender = sender.BuildSender("AzureRM")
+	client.Sender = autorest.DecorateSender(common.BuildSender(c.httpTraceSink), common.WithRetryPolicy(c.retryPolicy))
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 180 * time.Minute
+	// the context passed to each operation carries the deadline from the resource's Timeouts
+	// block, so no additional (default) polling deadline should be applied by the SDK
+	client.PollingDuration = 0
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
@@ -261,7 +280,7 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, httpTraceSink *common.HTTPTraceSink, retryPolicy *common.RetryPolicy) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -276,6 +295,8 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		httpTraceSink:            httpTraceSink,
+		retryPolicy:              retryPolicy,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -288,7 +309,8 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := sender.BuildSender("AzureRM")
+	// requests for tokens are deliberately not traced, since these are not Resource Manager requests
+	sender := common.BuildSender(nil)
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
@@ -321,6 +343,8 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return keyVaultSpt, nil
 	})
 
+	// PollingDuration is intentionally zero: the deadline for long-running operations comes
+	// from the context, which is bound to the resource's Timeouts block
 	o := &common.ClientOptions{
 		GraphAuthorizer:            graphAuth,
 		GraphEndpoint:              graphEndpoint,
@@ -330,68 +354,78 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		StorageAuthorizer:          storageAuth,
 		SubscriptionId:             c.SubscriptionID,
 		PartnerId:                  partnerId,
-		PollingDuration:            60 * time.Minute,
+		PollingDuration:            0,
 		SkipProviderReg:            skipProviderRegistration,
 		EnableCorrelationRequestID: true,
 		Environment:                *env,
+		HTTPTraceSink:              httpTraceSink,
+		RetryPolicy:                retryPolicy,
 	}
 
-	client.analysisservices = analysisservices.BuildClient(o)
-	client.apiManagement = apimanagement.BuildClient(o)
-	client.appInsights = applicationinsights.BuildClient(o)
-	client.automation = automation.BuildClient(o)
-	client.authorization = authorization.BuildClient(o)
-	client.cdn = cdn.BuildClient(o)
-	client.cognitive = cognitive.BuildClient(o)
-	client.containers = containers.BuildClient(o)
-	client.cosmos = cosmos.BuildClient(o)
-	client.databricks = databricks.BuildClient(o)
-	client.dataFactory = datafactory.BuildClient(o)
-	client.devSpace = devspace.BuildClient(o)
-	client.devTestLabs = devtestlabs.BuildClient(o)
-	client.dns = dns.BuildClient(o)
-	client.eventGrid = eventgrid.BuildClient(o)
-	client.eventhub = eventhub.BuildClient(o)
-	client.hdinsight = hdinsight.BuildClient(o)
-	client.iothub = iothub.BuildClient(o)
-	client.keyvault = keyvault.BuildClient(o)
-	client.logic = logic.BuildClient(o)
-	client.logAnalytics = loganalytics.BuildClient(o)
-	client.maps = maps.BuildClient(o)
-	client.mariadb = mariadb.BuildClient(o)
-	client.media = media.BuildClient(o)
-	client.mysql = mysql.BuildClient(o)
-	client.msi = msi.BuildClient(o)
-	client.managementGroups = managementgroup.BuildClient(o)
-	client.notificationHubs = notificationhub.BuildClient(o)
-	client.policy = policy.BuildClient(o)
-	client.postgres = postgres.BuildClient(o)
-	client.privateDns = privatedns.BuildClient(o)
-	client.recoveryServices = recoveryservices.BuildClient(o)
-	client.redis = redis.BuildClient(o)
-	client.relay = relay.BuildClient(o)
-	client.search = search.BuildClient(o)
-	client.securityCenter = securitycenter.BuildClient(o)
-	client.servicebus = servicebus.BuildClient(o)
-	client.serviceFabric = servicefabric.BuildClient(o)
-	client.scheduler = scheduler.BuildClient(o)
-	client.signalr = signalr.BuildClient(o)
-	client.streamanalytics = streamanalytics.BuildClient(o)
-	client.trafficManager = trafficmanager.BuildClient(o)
-	client.web = web.BuildClient(o)
-
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth, o)
+	client.buildClients(o, c.TenantID, sender)
 
 	return &client, nil
 }
 
+// buildClients builds each of the Service Clients used by the Provider from the specified ClientOptions.
+// This is split out from `getArmClient` so that the clients can be pointed at another Resource Manager
+// endpoint (for example the in-process fake in the `mockarm` package) without needing credentials.
+func (c *ArmClient) buildClients(o *common.ClientOptions, tenantId string, sender autorest.Sender) {
+	c.analysisservices = analysisservices.BuildClient(o)
+	c.apiManagement = apimanagement.BuildClient(o)
+	c.appInsights = applicationinsights.BuildClient(o)
+	c.automation = automation.BuildClient(o)
+	c.authorization = authorization.BuildClient(o)
+	c.cdn = cdn.BuildClient(o)
+	c.cognitive = cognitive.BuildClient(o)
+	c.containers = containers.BuildClient(o)
+	c.cosmos = cosmos.BuildClient(o)
+	c.databricks = databricks.BuildClient(o)
+	c.dataFactory = datafactory.BuildClient(o)
+	c.devSpace = devspace.BuildClient(o)
+	c.devTestLabs = devtestlabs.BuildClient(o)
+	c.dns = dns.BuildClient(o)
+	c.eventGrid = eventgrid.BuildClient(o)
+	c.eventhub = eventhub.BuildClient(o)
+	c.hdinsight = hdinsight.BuildClient(o)
+	c.iothub = iothub.BuildClient(o)
+	c.keyvault = keyvault.BuildClient(o)
+	c.logic = logic.BuildClient(o)
+	c.logAnalytics = loganalytics.BuildClient(o)
+	c.maps = maps.BuildClient(o)
+	c.mariadb = mariadb.BuildClient(o)
+	c.media = media.BuildClient(o)
+	c.mysql = mysql.BuildClient(o)
+	c.msi = msi.BuildClient(o)
+	c.managementGroups = managementgroup.BuildClient(o)
+	c.notificationHubs = notificationhub.BuildClient(o)
+	c.policy = policy.BuildClient(o)
+	c.postgres = postgres.BuildClient(o)
+	c.privateDns = privatedns.BuildClient(o)
+	c.recoveryServices = recoveryservices.BuildClient(o)
+	c.redis = redis.BuildClient(o)
+	c.relay = relay.BuildClient(o)
+	c.resourceGraph = resourcegraph.BuildClient(o)
+	c.search = search.BuildClient(o)
+	c.securityCenter = securitycenter.BuildClient(o)
+	c.servicebus = servicebus.BuildClient(o)
+	c.serviceFabric = servicefabric.BuildClient(o)
+	c.scheduler = scheduler.BuildClient(o)
+	c.signalr = signalr.BuildClient(o)
+	c.streamanalytics = streamanalytics.BuildClient(o)
+	c.trafficManager = trafficmanager.BuildClient(o)
+	c.web = web.BuildClient(o)
+
+	c.registerAuthentication(o.ResourceManagerEndpoint, o.GraphEndpoint, o.SubscriptionId, tenantId, o.ResourceManagerAuthorizer, o.GraphAuthorizer)
+	c.registerComputeClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer)
+	c.registerDatabases(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer, sender)
+	c.registerDataLakeStoreClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer)
+	c.registerMonitorClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer)
+	c.registerNetworkingClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer)
+	c.registerResourcesClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer)
+	c.registerStorageClients(o.ResourceManagerEndpoint, o.SubscriptionId, o.ResourceManagerAuthorizer, o)
+}
+
 func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
 
 	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(graphEndpoint, tenantId)
@@ -408,14 +442,30 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&availabilitySetsClient.Client, auth)
 	c.availSetClient = availabilitySetsClient
 
+	dedicatedHostGroupsClient := compute.NewDedicatedHostGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dedicatedHostGroupsClient.Client, auth)
+	c.dedicatedHostGroupsClient = dedicatedHostGroupsClient
+
+	dedicatedHostsClient := compute.NewDedicatedHostsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dedicatedHostsClient.Client, auth)
+	c.dedicatedHostsClient = dedicatedHostsClient
+
 	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&diskClient.Client, auth)
 	c.diskClient = diskClient
 
+	diskEncryptionSetsClient := compute.NewDiskEncryptionSetsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&diskEncryptionSetsClient.Client, auth)
+	c.diskEncryptionSetsClient = diskEncryptionSetsClient
+
 	imagesClient := compute.NewImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&imagesClient.Client, auth)
 	c.imageClient = imagesClient
 
+	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&proximityPlacementGroupsClient.Client, auth)
+	c.proximityPlacementGroupsClient = proximityPlacementGroupsClient
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
 	c.snapshotsClient = snapshotsClient
@@ -440,6 +490,14 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetRollingUpgradesClient.Client, auth)
+	c.vmScaleSetRollingUpgradesClient = scaleSetRollingUpgradesClient
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -692,72 +750,3 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 
 	c.storage = intStor.BuildClient(accountsClient, options)
 }
-
-var (
-	storageKeyCacheMu sync.RWMutex
-	storageKeyCache   = make(map[string]string)
-)
-
-func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (string, bool, error) {
-	cacheIndex := resourceGroupName + "/" + storageAccountName
-	storageKeyCacheMu.RLock()
-	key, ok := storageKeyCache[cacheIndex]
-	storageKeyCacheMu.RUnlock()
-
-	if ok {
-		return key, true, nil
-	}
-
-	storageKeyCacheMu.Lock()
-	defer storageKeyCacheMu.Unlock()
-	key, ok = storageKeyCache[cacheIndex]
-	if !ok {
-		accountKeys, err := c.storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
-		if utils.ResponseWasNotFound(accountKeys.Response) {
-			return "", false, nil
-		}
-		if err != nil {
-			// We assume this is a transient error rather than a 404 (which is caught above),  so assume the
-			// storeAccount still exists.
-			return "", true, fmt.Errorf("Error retrieving keys for storage storeAccount %q: %s", storageAccountName, err)
-		}
-
-		if accountKeys.Keys == nil {
-			return "", false, fmt.Errorf("Nil key returned for storage storeAccount %q", storageAccountName)
-		}
-
-		keys := *accountKeys.Keys
-		if len(keys) <= 0 {
-			return "", false, fmt.Errorf("No keys returned for storage storeAccount %q", storageAccountName)
-		}
-
-		keyPtr := keys[0].Value
-		if keyPtr == nil {
-			return "", false, fmt.Errorf("The first key returned is nil for storage storeAccount %q", storageAccountName)
-		}
-
-		key = *keyPtr
-		storageKeyCache[cacheIndex] = key
-	}
-
-	return key, true, nil
-}
-
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
-		return nil, accountExists, err
-	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
-
-	blobClient := storageClient.GetBlobService()
-	return &blobClient, true, nil
-}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
@@ -147,6 +147,11 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+
+						"orchestrator_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -197,6 +202,8 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"exec":     kubernetesClusterKubeConfigExecSchema(),
+						"contexts": kubernetesClusterKubeConfigContextsSchema(),
 					},
 				},
 			},
@@ -238,6 +245,8 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"exec":     kubernetesClusterKubeConfigExecSchema(),
+						"contexts": kubernetesClusterKubeConfigContextsSchema(),
 					},
 				},
 			},
@@ -370,6 +379,48 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"kubelet_identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_assigned_identity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"service_principal": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -458,6 +509,11 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
 		}
 
+		kubeletIdentity := flattenKubernetesClusterKubeletIdentity(props.IdentityProfile)
+		if err := d.Set("kubelet_identity", kubeletIdentity); err != nil {
+			return fmt.Errorf("Error setting `kubelet_identity`: %+v", err)
+		}
+
 		// adminProfile is only available for RBAC enabled clusters with AAD
 		if props.AadProfile != nil {
 			adminProfile, err := client.GetAccessProfile(ctx, resourceGroup, name, "clusterAdmin")
@@ -482,6 +538,10 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
+	if err := d.Set("identity", flattenKubernetesClusterManagedClusterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -659,6 +719,10 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 			agentPoolProfile["node_taints"] = *profile.NodeTaints
 		}
 
+		if profile.OrchestratorVersion != nil {
+			agentPoolProfile["orchestrator_version"] = *profile.OrchestratorVersion
+		}
+
 		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
 	}
 
@@ -742,6 +806,11 @@ func flattenKubernetesClusterDataSourceServicePrincipalProfile(profile *containe
 		return []interface{}{}
 	}
 
+	// clusters using a Managed Identity don't have a Service Principal
+	if profile.ClientID != nil && strings.EqualFold(*profile.ClientID, kubernetesClusterManagedIdentityClientId) {
+		return []interface{}{}
+	}
+
 	values := make(map[string]interface{})
 
 	if clientId := profile.ClientID; clientId != nil {
@@ -754,9 +823,10 @@ func flattenKubernetesClusterDataSourceServicePrincipalProfile(profile *containe
 func flattenKubernetesClusterDataSourceKubeConfig(config kubernetes.KubeConfig) []interface{} {
 	values := make(map[string]interface{})
 
-	cluster := config.Clusters[0].Cluster
-	user := config.Users[0].User
-	name := config.Users[0].Name
+	clusterIndex, userIndex := config.ContextIndices(config.CurrentContext, config.UserNames())
+	cluster := config.Clusters[clusterIndex].Cluster
+	user := config.Users[userIndex].User
+	name := config.Users[userIndex].Name
 
 	values["host"] = cluster.Server
 	values["username"] = name
@@ -764,6 +834,8 @@ func flattenKubernetesClusterDataSourceKubeConfig(config kubernetes.KubeConfig)
 	values["client_certificate"] = user.ClientCertificteData
 	values["client_key"] = user.ClientKeyData
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(user.Exec)
+	values["contexts"] = flattenKubernetesClusterKubeConfigContexts(config.KubeConfigBase)
 
 	return []interface{}{values}
 }
@@ -771,8 +843,10 @@ func flattenKubernetesClusterDataSourceKubeConfig(config kubernetes.KubeConfig)
 func flattenKubernetesClusterDataSourceKubeConfigAAD(config kubernetes.KubeConfigAAD) []interface{} {
 	values := make(map[string]interface{})
 
-	cluster := config.Clusters[0].Cluster
-	name := config.Users[0].Name
+	clusterIndex, userIndex := config.ContextIndices(config.CurrentContext, config.UserNames())
+	cluster := config.Clusters[clusterIndex].Cluster
+	user := config.Users[userIndex].User
+	name := config.Users[userIndex].Name
 
 	values["host"] = cluster.Server
 	values["username"] = name
@@ -783,5 +857,9 @@ func flattenKubernetesClusterDataSourceKubeConfigAAD(config kubernetes.KubeConfi
 
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
 
+	// the `azure` auth-provider has been removed from newer clients, so the equivalent `kubelogin` plugin is exposed
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(user.AuthProvider.KubeLoginExec())
+	values["contexts"] = flattenKubernetesClusterKubeConfigContexts(config.KubeConfigBase)
+
 	return []interface{}{values}
 }
@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -43,12 +45,9 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
-	listResp, err := client.ListOrchestrators(ctx, location, "managedClusters")
+	id, orchestrators, err := kubernetesServiceOrchestratorVersions(ctx, client, location)
 	if err != nil {
-		if utils.ResponseWasNotFound(listResp.Response) {
-			return fmt.Errorf("Error: No Kubernetes Service versions found for location %q", location)
-		}
-		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: %+v", location, err)
+		return err
 	}
 
 	lv, err := version.NewVersion("0.0.0")
@@ -59,43 +58,103 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 	var versions []string
 	versionPrefix := d.Get("version_prefix").(string)
 
-	if props := listResp.OrchestratorVersionProfileProperties; props != nil {
-		if orchestrators := props.Orchestrators; orchestrators != nil {
-			for _, rawV := range *orchestrators {
-				if rawV.OrchestratorType == nil || rawV.OrchestratorVersion == nil {
-					continue
-				}
+	for _, rawV := range orchestrators {
+		kubeVersion := *rawV.OrchestratorVersion
 
-				orchestratorType := *rawV.OrchestratorType
-				kubeVersion := *rawV.OrchestratorVersion
+		if versionPrefix != "" && !strings.HasPrefix(kubeVersion, versionPrefix) {
+			log.Printf("[DEBUG] Version %q doesn't match the prefix %q", kubeVersion, versionPrefix)
+			continue
+		}
 
-				if !strings.EqualFold(orchestratorType, "Kubernetes") {
-					log.Printf("[DEBUG] Orchestrator %q was not Kubernetes", orchestratorType)
-					continue
-				}
+		versions = append(versions, kubeVersion)
+		v, err := version.NewVersion(kubeVersion)
+		if err != nil {
+			log.Printf("[WARN] Cannot parse orchestrator version %q - skipping: %s", kubeVersion, err)
+			continue
+		}
 
-				if versionPrefix != "" && !strings.HasPrefix(kubeVersion, versionPrefix) {
-					log.Printf("[DEBUG] Version %q doesn't match the prefix %q", kubeVersion, versionPrefix)
-					continue
-				}
+		if v.GreaterThan(lv) {
+			lv = v
+		}
+	}
+
+	d.SetId(id)
+	d.Set("versions", versions)
+	d.Set("latest_version", lv.Original())
+
+	return nil
+}
 
-				versions = append(versions, kubeVersion)
-				v, err := version.NewVersion(kubeVersion)
-				if err != nil {
-					log.Printf("[WARN] Cannot parse orchestrator version %q - skipping: %s", kubeVersion, err)
+// kubernetesServiceOrchestratorVersions returns the ID of the list of Orchestrators available in the specified location,
+// along with each of the Kubernetes Orchestrator Versions within it
+func kubernetesServiceOrchestratorVersions(ctx context.Context, client containerservice.ContainerServicesClient, location string) (string, []containerservice.OrchestratorVersionProfile, error) {
+	listResp, err := client.ListOrchestrators(ctx, location, "managedClusters")
+	if err != nil {
+		if utils.ResponseWasNotFound(listResp.Response) {
+			return "", nil, fmt.Errorf("Error: No Kubernetes Service versions found for location %q", location)
+		}
+		return "", nil, fmt.Errorf("Error retrieving Kubernetes Versions in %q: %+v", location, err)
+	}
+
+	if listResp.ID == nil {
+		return "", nil, fmt.Errorf("Error retrieving Kubernetes Versions in %q: `id` was nil", location)
+	}
+
+	versions := make([]containerservice.OrchestratorVersionProfile, 0)
+	if props := listResp.OrchestratorVersionProfileProperties; props != nil && props.Orchestrators != nil {
+		for _, rawV := range *props.Orchestrators {
+			if rawV.OrchestratorType == nil || rawV.OrchestratorVersion == nil {
+				continue
+			}
+
+			if !strings.EqualFold(*rawV.OrchestratorType, "Kubernetes") {
+				log.Printf("[DEBUG] Orchestrator %q was not Kubernetes", *rawV.OrchestratorType)
+				continue
+			}
+
+			versions = append(versions, rawV)
+		}
+	}
+
+	return *listResp.ID, versions, nil
+}
+
+// validateKubernetesServiceUpgradeIsAvailable ensures that the target version is listed as an available upgrade
+// for the current version within the specified Kubernetes Orchestrator Versions
+func validateKubernetesServiceUpgradeIsAvailable(orchestrators []containerservice.OrchestratorVersionProfile, currentVersion string, targetVersion string) error {
+	if currentVersion == targetVersion {
+		return nil
+	}
+
+	for _, orchestrator := range orchestrators {
+		if orchestrator.OrchestratorVersion == nil || *orchestrator.OrchestratorVersion != currentVersion {
+			continue
+		}
+
+		available := make([]string, 0)
+		if upgrades := orchestrator.Upgrades; upgrades != nil {
+			for _, upgrade := range *upgrades {
+				if upgrade.OrchestratorVersion == nil {
 					continue
 				}
 
-				if v.GreaterThan(lv) {
-					lv = v
+				if *upgrade.OrchestratorVersion == targetVersion {
+					return nil
 				}
+
+				available = append(available, *upgrade.OrchestratorVersion)
 			}
 		}
+
+		return fmt.Errorf("Kubernetes Version %q is not an available upgrade from %q - available upgrades are: %s", targetVersion, currentVersion, strings.Join(available, ", "))
 	}
 
-	d.SetId(*listResp.ID)
-	d.Set("versions", versions)
-	d.Set("latest_version", lv.Original())
+	// the current version may no longer be offered, in which case the target version needs to be
+	for _, orchestrator := range orchestrators {
+		if orchestrator.OrchestratorVersion != nil && *orchestrator.OrchestratorVersion == targetVersion {
+			return nil
+		}
+	}
 
-	return nil
+	return fmt.Errorf("Kubernetes Version %q is not available in this location", targetVersion)
 }
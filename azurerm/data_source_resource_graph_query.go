@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resourcegraph/mgmt/2018-09-01/resourcegraph"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmResourceGraphQuery() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceGraphQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"subscription_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+
+			"results_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"total_records": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceGraphQueryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourceGraph.QueryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	query := d.Get("query").(string)
+	subscriptionIds := *utils.ExpandStringSlice(d.Get("subscription_ids").([]interface{}))
+	if len(subscriptionIds) == 0 {
+		subscriptionIds = []string{meta.(*ArmClient).subscriptionId}
+	}
+
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &subscriptionIds,
+		Query:         utils.String(query),
+		Options:       &resourcegraph.QueryRequestOptions{},
+	}
+
+	rows := make([]interface{}, 0)
+	var totalRecords int64
+	for {
+		log.Printf("[DEBUG] Running Resource Graph Query %q (%d rows retrieved so far)", query, len(rows))
+		resp, err := client.Resources(ctx, request)
+		if err != nil {
+			return fmt.Errorf("Error running Resource Graph Query %q: %+v", query, err)
+		}
+
+		if resp.TotalRecords != nil {
+			totalRecords = *resp.TotalRecords
+		}
+
+		data := expandResourceGraphQueryTable(resp.Data)
+		rows = append(rows, data...)
+
+		if resp.SkipToken == nil || *resp.SkipToken == "" || len(data) == 0 {
+			break
+		}
+		request.Options.SkipToken = resp.SkipToken
+	}
+
+	resultsJson, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("Error serializing the results of Resource Graph Query %q: %+v", query, err)
+	}
+
+	results, err := flattenResourceGraphQueryResults(rows)
+	if err != nil {
+		return fmt.Errorf("Error flattening the results of Resource Graph Query %q: %+v", query, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s/%v", query, subscriptionIds))))
+	d.Set("results_json", string(resultsJson))
+	d.Set("total_records", int(totalRecords))
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("Error setting `results`: %+v", err)
+	}
+
+	return nil
+}
+
+// expandResourceGraphQueryTable converts the tabular results of a query into a list of rows, where each
+// row is an object keyed by the column name
+func expandResourceGraphQueryTable(input *resourcegraph.Table) []interface{} {
+	rows := make([]interface{}, 0)
+	if input == nil || input.Columns == nil || input.Rows == nil {
+		return rows
+	}
+
+	columns := *input.Columns
+	for _, values := range *input.Rows {
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if column.Name == nil || i >= len(values) {
+				continue
+			}
+			row[*column.Name] = values[i]
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// flattenResourceGraphQueryResults converts each row into a map of strings - where the value for a column
+// isn't a string (for example a number, or an object such as `tags`) it's JSON encoded
+func flattenResourceGraphQueryResults(rows []interface{}) ([]interface{}, error) {
+	results := make([]interface{}, 0, len(rows))
+
+	for _, row := range rows {
+		columns, ok := row.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected each row to be an object but got %T", row)
+		}
+
+		output := make(map[string]interface{}, len(columns))
+		for k, v := range columns {
+			switch value := v.(type) {
+			case nil:
+				output[k] = ""
+			case string:
+				output[k] = value
+			default:
+				encoded, err := json.Marshal(value)
+				if err != nil {
+					return nil, fmt.Errorf("serializing the value for %q: %+v", k, err)
+				}
+				output[k] = string(encoded)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,101 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resourcegraph/mgmt/2018-09-01/resourcegraph"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandResourceGraphQueryTable(t *testing.T) {
+	table := &resourcegraph.Table{
+		Columns: &[]resourcegraph.Column{
+			{Name: utils.String("name"), Type: resourcegraph.String},
+			{Name: utils.String("count"), Type: resourcegraph.Integer},
+			{Name: utils.String("tags"), Type: resourcegraph.Object},
+		},
+		Rows: &[][]interface{}{
+			{"first", 1.0, map[string]interface{}{"environment": "Production"}},
+			{"second", 2.0, nil},
+		},
+	}
+
+	results, err := flattenResourceGraphQueryResults(expandResourceGraphQueryTable(table))
+	if err != nil {
+		t.Fatalf("Error flattening the results: %+v", err)
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "first", "count": "1", "tags": `{"environment":"Production"}`},
+		{"name": "second", "count": "2", "tags": ""},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d rows but got %d: %+v", len(expected), len(results), results)
+	}
+	for i, row := range results {
+		for k, v := range expected[i] {
+			if actual := row.(map[string]interface{})[k]; actual != v {
+				t.Fatalf("Expected %q for row %d to be %q but got %q", k, i, v, actual)
+			}
+		}
+	}
+
+	if rows := expandResourceGraphQueryTable(nil); len(rows) != 0 {
+		t.Fatalf("Expected no rows for an empty table but got %+v", rows)
+	}
+}
+
+func TestAccDataSourceAzureRMResourceGraphQuery_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_resource_graph_query.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResourceGraphQuery_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResourceGraphQuery_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.name", fmt.Sprintf("acctestvnet-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.type", "microsoft.network/virtualnetworks"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResourceGraphQuery_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccDataSourceAzureRMResourceGraphQuery_basic(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResourceGraphQuery_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query = "Resources | where resourceGroup =~ '${azurerm_resource_group.test.name}' | project name, type, location"
+}
+`, template)
+}
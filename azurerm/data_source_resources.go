@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"required_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	resourceType := d.Get("type").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	// the API only supports filtering on the type (tags can't be combined with other filters)
+	// so the remaining filters are applied once the results have been retrieved
+	filter := resourcesTypeFilter(resourceType)
+
+	var iterator resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		log.Printf("[DEBUG] Listing Resources in Resource Group %q (Filter %q)", resourceGroup, filter)
+		iterator, err = client.ListByResourceGroupComplete(ctx, resourceGroup, filter, "", nil)
+	} else {
+		log.Printf("[DEBUG] Listing Resources in the Subscription (Filter %q)", filter)
+		iterator, err = client.ListComplete(ctx, filter, "", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Resources (Resource Group %q / Filter %q): %+v", resourceGroup, filter, err)
+	}
+
+	results := make([]interface{}, 0)
+	for iterator.NotDone() {
+		res := iterator.Value()
+		if res.ID != nil && res.Name != nil {
+			if (nameRegex == nil || nameRegex.MatchString(*res.Name)) && resourceHasTags(res.Tags, requiredTags) {
+				results = append(results, flattenDataSourceResource(res))
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Resources (Resource Group %q / Filter %q): %+v", resourceGroup, filter, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", results); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+// resourcesTypeFilter returns the OData filter for the specified Resource Type, escaping any
+// single quotes so that the value can't terminate the string literal early
+func resourcesTypeFilter(resourceType string) string {
+	if resourceType == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("resourceType eq '%s'", strings.Replace(resourceType, "'", "''", -1))
+}
+
+// resourceHasTags returns whether the specified tags contain each of the tags specified in the `required_tags`
+// argument of this Data Source - as with the `default_tags` and `required_tag_keys` in the Provider block,
+// the keys are compared case-insensitively
+func resourceHasTags(tags map[string]*string, requiredTags map[string]interface{}) bool {
+	for k, v := range requiredTags {
+		found := false
+		for key, value := range tags {
+			if strings.EqualFold(key, k) && value != nil && *value == v.(string) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDataSourceResource(input resources.GenericResourceExpanded) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":                  *input.ID,
+		"name":                *input.Name,
+		"type":                "",
+		"resource_group_name": "",
+		"location":            "",
+	}
+
+	if input.Type != nil {
+		output["type"] = *input.Type
+	}
+
+	if id, err := parseAzureResourceID(*input.ID); err == nil {
+		output["resource_group_name"] = id.ResourceGroup
+	}
+
+	if input.Location != nil {
+		output["location"] = azure.NormalizeLocation(*input.Location)
+	}
+
+	tags := make(map[string]interface{}, len(input.Tags))
+	for k, v := range input.Tags {
+		if v != nil {
+			tags[k] = *v
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
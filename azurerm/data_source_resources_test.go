@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMResources_byType(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_byType(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.resource_group_name", fmt.Sprintf("acctestRG-%d", ri)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_nameRegexAndTags(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_nameRegexAndTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestvnet-second-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcesTypeFilter(t *testing.T) {
+	testData := []struct {
+		resourceType string
+		expected     string
+	}{
+		{
+			resourceType: "",
+			expected:     "",
+		},
+		{
+			resourceType: "Microsoft.Network/virtualNetworks",
+			expected:     "resourceType eq 'Microsoft.Network/virtualNetworks'",
+		},
+		{
+			resourceType: "Microsoft.Network/virtualNetworks' or name eq 'other",
+			expected:     "resourceType eq 'Microsoft.Network/virtualNetworks'' or name eq ''other'",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.resourceType)
+
+		actual := resourcesTypeFilter(v.resourceType)
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestResourceHasTags(t *testing.T) {
+	tags := map[string]*string{
+		"Environment": utils.String("Production"),
+		"cost_center": utils.String("MSFT"),
+	}
+
+	testData := []struct {
+		requiredTags map[string]interface{}
+		expected     bool
+	}{
+		{
+			requiredTags: map[string]interface{}{},
+			expected:     true,
+		},
+		{
+			requiredTags: map[string]interface{}{
+				"Environment": "Production",
+			},
+			expected: true,
+		},
+		{
+			// keys are compared case-insensitively
+			requiredTags: map[string]interface{}{
+				"environment": "Production",
+				"COST_CENTER": "MSFT",
+			},
+			expected: true,
+		},
+		{
+			// values are compared case-sensitively
+			requiredTags: map[string]interface{}{
+				"environment": "production",
+			},
+			expected: false,
+		},
+		{
+			requiredTags: map[string]interface{}{
+				"owner": "someone",
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.requiredTags)
+
+		actual := resourceHasTags(tags, v.requiredTags)
+		if actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "first" {
+  name                = "acctestvnet-first-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    environment = "Development"
+  }
+}
+
+resource "azurerm_virtual_network" "second" {
+  name                = "acctestvnet-second-%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    environment = "Production"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMResources_byType(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/virtualNetworks"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_nameRegexAndTags(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  name_regex          = "^acctestvnet-"
+
+  required_tags = {
+    environment = "Production"
+  }
+}
+`, template)
+}
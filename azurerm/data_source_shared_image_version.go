@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -109,11 +109,11 @@ func dataSourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{
 			if err := d.Set("target_region", flattenedRegions); err != nil {
 				return fmt.Errorf("Error setting `target_region`: %+v", err)
 			}
+		}
 
+		if profile := props.StorageProfile; profile != nil {
 			if source := profile.Source; source != nil {
-				if image := source.ManagedImage; image != nil {
-					d.Set("managed_image_id", image.ID)
-				}
+				d.Set("managed_image_id", source.ID)
 			}
 		}
 	}
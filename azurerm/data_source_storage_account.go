@@ -272,7 +272,7 @@ func dataSourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*resp.ID)
 
-	keys, err := client.ListKeys(ctx, resourceGroup, name)
+	keys, err := client.ListKeys(ctx, resourceGroup, name, "")
 	if err != nil {
 		return err
 	}
@@ -1,24 +1,25 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func extractResourceGroupAndErcName(resourceId string) (resourceGroup string, name string, e error) {
-	id, err := parseAzureResourceID(resourceId)
+	id, err := resourceid.ParseExpressRouteCircuitID(resourceId)
 	if err != nil {
 		return "", "", err
 	}
 
-	return id.ResourceGroup, id.Path["expressRouteCircuits"], err
+	return id.ResourceGroup, id.Name, err
 }
 
-func retrieveErcByResourceId(resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
+func retrieveErcByResourceId(ctx context.Context, resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
 	ercClient := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
 
 	resGroup, name, err := extractResourceGroupAndErcName(resourceId)
 	if err != nil {
@@ -0,0 +1,130 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"resource_group": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prevent_deletion_if_contains_resources": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine_scale_set": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"roll_instances_when_required": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features.UserFeatures {
+	// these are the defaults if omitted from the config
+	output := features.Default()
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			keyVaultRaw := items[0].(map[string]interface{})
+			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+				output.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["resource_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceGroupRaw := items[0].(map[string]interface{})
+			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
+				output.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			virtualMachinesRaw := items[0].(map[string]interface{})
+			if v, ok := virtualMachinesRaw["delete_os_disk_on_deletion"]; ok {
+				output.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine_scale_set"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			scaleSetRaw := items[0].(map[string]interface{})
+			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
+				output.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: features.Default(),
+		},
+		{
+			Name: "Empty Nested Blocks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault":                 []interface{}{},
+					"resource_group":            []interface{}{},
+					"virtual_machine":           []interface{}{},
+					"virtual_machine_scale_set": []interface{}{},
+				},
+			},
+			Expected: features.Default(),
+		},
+		{
+			Name: "Complete Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+						},
+					},
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+				},
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired: true,
+				},
+			},
+		},
+		{
+			Name: "Partially Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
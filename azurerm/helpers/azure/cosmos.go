@@ -2,6 +2,7 @@ package azure
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
 )
@@ -133,3 +134,64 @@ func ParseCosmosTableID(id string) (*CosmosTableID, error) {
 		Table:           table,
 	}, nil
 }
+
+// ValidateCosmosCassandraKeyspaceID validates that the specified value is the ID of a Cosmos Cassandra Keyspace
+func ValidateCosmosCassandraKeyspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateCosmosChildID(i, k, "cassandra", "keyspaces")
+}
+
+// ValidateCosmosMongoCollectionID validates that the specified value is the ID of a Cosmos Mongo Collection
+func ValidateCosmosMongoCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateCosmosChildID(i, k, "mongodb", "databases", "collections")
+}
+
+// ValidateCosmosMongoDatabaseID validates that the specified value is the ID of a Cosmos Mongo Database
+func ValidateCosmosMongoDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateCosmosChildID(i, k, "mongodb", "databases")
+}
+
+// ValidateCosmosSQLDatabaseID validates that the specified value is the ID of a Cosmos SQL Database
+func ValidateCosmosSQLDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateCosmosChildID(i, k, "sql", "databases")
+}
+
+// ValidateCosmosTableID validates that the specified value is the ID of a Cosmos Table
+func ValidateCosmosTableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateCosmosChildID(i, k, "table", "tables")
+}
+
+// validateCosmosChildID validates that the specified value is the ID of a resource within a Cosmos Account
+// using the specified API (e.g. `mongodb`) which contains only the specified segments beneath the API
+func validateCosmosChildID(i interface{}, k string, api string, segments ...string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	id, err := ParseCosmosAccountID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Error parsing %q as a Cosmos ID: %+v", k, err))
+		return warnings, errors
+	}
+
+	if !strings.EqualFold(id.Path["apis"], api) {
+		errors = append(errors, fmt.Errorf("expected %q to be a Cosmos %q API ID but got %q", k, api, v))
+		return warnings, errors
+	}
+
+	// `databaseAccounts` and `apis` make up the Cosmos Account and API
+	if len(id.Path) != len(segments)+2 {
+		errors = append(errors, fmt.Errorf("expected %q to contain the segments %q beneath the Cosmos API but got %q", k, strings.Join(segments, "/"), v))
+		return warnings, errors
+	}
+
+	for _, segment := range segments {
+		if _, ok := id.Path[segment]; !ok {
+			errors = append(errors, fmt.Errorf("expected %q to contain the segments %q beneath the Cosmos API but got %q", k, strings.Join(segments, "/"), v))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
@@ -120,3 +120,44 @@ func KeyVaultExists(ctx context.Context, client keyvault.VaultsClient, keyVaultI
 
 	return true, nil
 }
+
+// KeyVaultIsRecoverable ensures that the specified Key Vault has both Soft Delete and Purge Protection enabled,
+// which is required for Keys within it to be used as Customer Managed Keys
+func KeyVaultIsRecoverable(ctx context.Context, client keyvault.VaultsClient, keyVaultId string) error {
+	id, err := ParseAzureResourceID(keyVaultId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+
+	vaultName, ok := id.Path["vaults"]
+	if !ok {
+		return fmt.Errorf("resource id does not contain `vaults`: %q", keyVaultId)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error unable to find KeyVault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+		return fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	return validateKeyVaultIsRecoverable(vaultName, resp.Properties)
+}
+
+func validateKeyVaultIsRecoverable(vaultName string, props *keyvault.VaultProperties) error {
+	if props == nil {
+		return fmt.Errorf("`properties` was nil for KeyVault %q", vaultName)
+	}
+
+	if props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return fmt.Errorf("KeyVault %q must have Soft Delete enabled to be used for Customer Managed Keys", vaultName)
+	}
+
+	if props.EnablePurgeProtection == nil || !*props.EnablePurgeProtection {
+		return fmt.Errorf("KeyVault %q must have Purge Protection enabled to be used for Customer Managed Keys", vaultName)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateKeyVaultIsRecoverable(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *keyvault.VaultProperties
+		Expected bool
+	}{
+		{
+			Name:     "Nil Properties",
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Name:     "Neither Enabled",
+			Input:    &keyvault.VaultProperties{},
+			Expected: false,
+		},
+		{
+			Name: "Soft Delete Disabled",
+			Input: &keyvault.VaultProperties{
+				EnableSoftDelete:      utils.Bool(false),
+				EnablePurgeProtection: utils.Bool(true),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Purge Protection Not Set",
+			Input: &keyvault.VaultProperties{
+				EnableSoftDelete: utils.Bool(true),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Both Enabled",
+			Input: &keyvault.VaultProperties{
+				EnableSoftDelete:      utils.Bool(true),
+				EnablePurgeProtection: utils.Bool(true),
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateKeyVaultIsRecoverable("vault1", v.Input)
+		if actual := err == nil; actual != v.Expected {
+			t.Fatalf("Expected %t but got %t (error: %+v)", v.Expected, actual, err)
+		}
+	}
+}
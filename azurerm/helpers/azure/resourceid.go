@@ -3,7 +3,10 @@ package azure
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 // ResourceID represents a parsed long-form Azure Resource Manager ID
@@ -94,3 +97,97 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 	return idObj, nil
 }
+
+// PopSegment retrieves a segment from the Path and returns it - removing it from
+// the Path so that any remaining segments can be validated afterwards.
+// The segment name is compared case-insensitively since some API's return these in
+// a different casing (e.g. `metricalerts` rather than `metricAlerts`), however an exact
+// match is preferred. An error is returned if the segment doesn't exist or is empty.
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	key := name
+	if _, ok := id.Path[key]; !ok {
+		for k := range id.Path {
+			if strings.EqualFold(k, name) {
+				key = k
+				break
+			}
+		}
+	}
+
+	value, ok := id.Path[key]
+	if !ok || value == "" {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
+	}
+
+	delete(id.Path, key)
+	return value, nil
+}
+
+// ValidateNoEmptySegments validates that all of the segments in the Path
+// have been consumed (via PopSegment) - which ensures that an ID for a
+// different (e.g. nested) resource type can't be parsed by mistake.
+func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	remaining := make([]string, 0, len(id.Path))
+	for key := range id.Path {
+		remaining = append(remaining, key)
+	}
+	sort.Strings(remaining)
+
+	return fmt.Errorf("ID contained more segments than required (%s): %q", strings.Join(remaining, ", "), sourceId)
+}
+
+// ScopedResourceID represents a Resource ID for a Resource (such as a Management Lock or a
+// Role Assignment) which can be created beneath an arbitrary scope - for example a Subscription,
+// a Resource Group, a Management Group or another Resource.
+type ScopedResourceID struct {
+	Scope string
+	Name  string
+}
+
+// ParseScopedResourceID parses the specified ID into a ScopedResourceID, returning an error if
+// it isn't for a Resource of the specified type (e.g. `Microsoft.Authorization/locks`). The
+// resource type is compared case-insensitively since some API's return these in lower-case.
+func ParseScopedResourceID(input string, resourceType string) (*ScopedResourceID, error) {
+	separator := fmt.Sprintf("/providers/%s/", resourceType)
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(separator))
+	if index == -1 {
+		return nil, fmt.Errorf("Expected ID to be in the format `{scope}%s{name}` but got %q", separator, input)
+	}
+
+	scope := input[:index]
+	if scope != "" && !strings.HasPrefix(scope, "/") {
+		return nil, fmt.Errorf("Expected the scope of the ID %q to start with a `/` but got %q", input, scope)
+	}
+
+	name := input[index+len(separator):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("Expected ID to be in the format `{scope}%s{name}` but got %q", separator, input)
+	}
+
+	return &ScopedResourceID{
+		Scope: scope,
+		Name:  name,
+	}, nil
+}
+
+// ValidateScopedResourceID returns a SchemaValidateFunc which validates that the specified value is
+// the ID of a Resource of the specified type beneath an arbitrary scope (see `ParseScopedResourceID`)
+func ValidateScopedResourceID(resourceType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return warnings, errors
+		}
+
+		if _, err := ParseScopedResourceID(v, resourceType); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid %s ID: %+v", k, resourceType, err))
+		}
+
+		return warnings, errors
+	}
+}
@@ -156,3 +156,179 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	testCases := []struct {
+		id            string
+		segment       string
+		expectedValue string
+		expectError   bool
+	}{
+		{
+			id:          "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			segment:     "subnets",
+			expectError: true,
+		},
+		{
+			id:            "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			segment:       "virtualNetworks",
+			expectedValue: "network1",
+		},
+		{
+			id:            "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualnetworks/network1",
+			segment:       "virtualNetworks",
+			expectedValue: "network1",
+		},
+	}
+
+	for _, test := range testCases {
+		id, err := ParseAzureResourceID(test.id)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", test.id, err)
+		}
+
+		value, err := id.PopSegment(test.segment)
+		if test.expectError {
+			if err == nil {
+				t.Fatalf("Expected an error popping %q from %q but didn't get one", test.segment, test.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if value != test.expectedValue {
+			t.Fatalf("Expected %q but got %q", test.expectedValue, value)
+		}
+
+		if len(id.Path) != 0 {
+			t.Fatalf("Expected %q to have been removed from the Path", test.segment)
+		}
+	}
+}
+
+func TestResourceIDValidateNoEmptySegments(t *testing.T) {
+	testCases := []struct {
+		id          string
+		pop         []string
+		expectError bool
+	}{
+		{
+			id:  "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			pop: []string{},
+		},
+		{
+			id:  "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			pop: []string{"virtualNetworks"},
+		},
+		{
+			id:          "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			pop:         []string{"virtualNetworks"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		id, err := ParseAzureResourceID(test.id)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", test.id, err)
+		}
+
+		for _, segment := range test.pop {
+			if _, err := id.PopSegment(segment); err != nil {
+				t.Fatalf("Unexpected error popping %q: %s", segment, err)
+			}
+		}
+
+		err = id.ValidateNoEmptySegments(test.id)
+		if test.expectError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", test.id)
+		}
+		if !test.expectError && err != nil {
+			t.Fatalf("Unexpected error for %q: %s", test.id, err)
+		}
+	}
+}
+
+func TestParseScopedResourceID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *ScopedResourceID
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+		},
+		{
+			Name:  "Resource Group",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+		},
+		{
+			Name:  "Different Resource Type",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Name:  "Missing Name",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/",
+		},
+		{
+			Name:  "Nested Resource",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1/nested/nested1",
+		},
+		{
+			Name:  "Subscription Scope",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedResourceID{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "lock1",
+			},
+		},
+		{
+			Name:  "Resource Scope",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/ip1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedResourceID{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/ip1",
+				Name:  "lock1",
+			},
+		},
+		{
+			Name:  "Lower-cased Resource Type",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/microsoft.authorization/locks/lock1",
+			Expected: &ScopedResourceID{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "lock1",
+			},
+		},
+		{
+			Name:  "Tenant Scope",
+			Input: "/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedResourceID{
+				Scope: "",
+				Name:  "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseScopedResourceID(v.Input, "Microsoft.Authorization/locks")
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error for %q: %+v", v.Input, err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -22,9 +22,23 @@ type userItem struct {
 }
 
 type user struct {
-	ClientCertificteData string `yaml:"client-certificate-data"`
-	Token                string `yaml:"token"`
-	ClientKeyData        string `yaml:"client-key-data"`
+	ClientCertificteData string      `yaml:"client-certificate-data"`
+	Token                string      `yaml:"token"`
+	ClientKeyData        string      `yaml:"client-key-data"`
+	Exec                 *ExecConfig `yaml:"exec,omitempty"`
+}
+
+// ExecConfig is an exec-based Credential Plugin (e.g. `kubelogin`) which retrieves a token for the user
+type ExecConfig struct {
+	APIVersion string       `yaml:"apiVersion"`
+	Command    string       `yaml:"command"`
+	Args       []string     `yaml:"args,omitempty"`
+	Env        []ExecEnvVar `yaml:"env,omitempty"`
+}
+
+type ExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
 }
 
 type userItemAAD struct {
@@ -45,8 +59,15 @@ type configAzureAD struct {
 	APIServerID string `yaml:"apiserver-id,omitempty"`
 	ClientID    string `yaml:"client-id,omitempty"`
 	TenantID    string `yaml:"tenant-id,omitempty"`
+	Environment string `yaml:"environment,omitempty"`
 }
 
+// KubeLoginAPIVersion is the Client Authentication API Version used by `kubelogin`
+const KubeLoginAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+// defaultKubeLoginEnvironment is the Azure Environment assumed by `kubelogin` when the `auth-provider` doesn't specify one
+const defaultKubeLoginEnvironment = "AzurePublicCloud"
+
 type contextItem struct {
 	Name    string  `yaml:"name"`
 	Context context `yaml:"context"`
@@ -90,13 +111,23 @@ func ParseKubeConfig(config string) (*KubeConfig, error) {
 	if len(kubeConfig.Clusters) <= 0 || len(kubeConfig.Users) <= 0 {
 		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", kubeConfig)
 	}
-	u := kubeConfig.Users[0].User
-	if u.Token == "" && (u.ClientCertificteData == "" || u.ClientKeyData == "") {
-		return nil, fmt.Errorf("Config requires either token or certificate auth for user %+v", u)
+
+	userNames := make([]string, 0)
+	for _, item := range kubeConfig.Users {
+		u := item.User
+		if u.Exec != nil {
+			if u.Exec.Command == "" {
+				return nil, fmt.Errorf("Config requires a command for the exec credential plugin for user %q", item.Name)
+			}
+		} else if u.Token == "" && (u.ClientCertificteData == "" || u.ClientKeyData == "") {
+			return nil, fmt.Errorf("Config requires either token, certificate or exec auth for user %+v", u)
+		}
+
+		userNames = append(userNames, item.Name)
 	}
-	c := kubeConfig.Clusters[0].Cluster
-	if c.Server == "" {
-		return nil, fmt.Errorf("Config has invalid or non existent server for cluster %+v", c)
+
+	if err := kubeConfig.KubeConfigBase.validate(userNames); err != nil {
+		return nil, err
 	}
 
 	return &kubeConfig, nil
@@ -115,10 +146,129 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", kubeConfig)
 	}
 
-	c := kubeConfig.Clusters[0].Cluster
-	if c.Server == "" {
-		return nil, fmt.Errorf("Config has invalid or non existent server for cluster %+v", c)
+	userNames := make([]string, 0)
+	for _, item := range kubeConfig.Users {
+		userNames = append(userNames, item.Name)
+	}
+
+	if err := kubeConfig.KubeConfigBase.validate(userNames); err != nil {
+		return nil, err
 	}
 
 	return &kubeConfig, nil
 }
+
+// validate ensures each cluster has a server, and that each context references a cluster and user which exist
+func (c KubeConfigBase) validate(userNames []string) error {
+	clusterNames := make(map[string]struct{})
+	for _, item := range c.Clusters {
+		if item.Cluster.Server == "" {
+			return fmt.Errorf("Config has invalid or non existent server for cluster %+v", item.Cluster)
+		}
+
+		clusterNames[item.Name] = struct{}{}
+	}
+
+	users := make(map[string]struct{})
+	for _, name := range userNames {
+		users[name] = struct{}{}
+	}
+
+	contextNames := make(map[string]struct{})
+	for _, item := range c.Contexts {
+		if _, ok := clusterNames[item.Context.Cluster]; !ok {
+			return fmt.Errorf("Config context %q references the cluster %q which doesn't exist", item.Name, item.Context.Cluster)
+		}
+
+		if _, ok := users[item.Context.User]; !ok {
+			return fmt.Errorf("Config context %q references the user %q which doesn't exist", item.Name, item.Context.User)
+		}
+
+		contextNames[item.Name] = struct{}{}
+	}
+
+	if c.CurrentContext != "" && len(c.Contexts) > 0 {
+		if _, ok := contextNames[c.CurrentContext]; !ok {
+			return fmt.Errorf("Config current-context %q doesn't exist", c.CurrentContext)
+		}
+	}
+
+	return nil
+}
+
+// ContextIndices returns the index of the Cluster and User referenced by the specified context. When the
+// context doesn't exist (for example a config without any contexts) the first Cluster and User are returned.
+func (c KubeConfigBase) ContextIndices(name string, userNames []string) (int, int) {
+	for _, item := range c.Contexts {
+		if item.Name != name {
+			continue
+		}
+
+		clusterIndex := 0
+		for i, cluster := range c.Clusters {
+			if cluster.Name == item.Context.Cluster {
+				clusterIndex = i
+				break
+			}
+		}
+
+		userIndex := 0
+		for i, userName := range userNames {
+			if userName == item.Context.User {
+				userIndex = i
+				break
+			}
+		}
+
+		return clusterIndex, userIndex
+	}
+
+	return 0, 0
+}
+
+// UserNames returns the name of each User within the config, in order
+func (c KubeConfig) UserNames() []string {
+	names := make([]string, 0)
+	for _, item := range c.Users {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// UserNames returns the name of each User within the config, in order
+func (c KubeConfigAAD) UserNames() []string {
+	names := make([]string, 0)
+	for _, item := range c.Users {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// KubeLoginExec returns the equivalent `kubelogin` exec Credential Plugin for an Azure `auth-provider`,
+// which allows clients that no longer support the `azure` auth-provider to authenticate
+func (p authProvider) KubeLoginExec() *ExecConfig {
+	if p.Config.APIServerID == "" {
+		return nil
+	}
+
+	environment := p.Config.Environment
+	if environment == "" {
+		environment = defaultKubeLoginEnvironment
+	}
+
+	return &ExecConfig{
+		APIVersion: KubeLoginAPIVersion,
+		Command:    "kubelogin",
+		Args: []string{
+			"get-token",
+			"--environment",
+			environment,
+			"--server-id",
+			p.Config.APIServerID,
+			"--client-id",
+			p.Config.ClientID,
+			"--tenant-id",
+			p.Config.TenantID,
+		},
+	}
+}
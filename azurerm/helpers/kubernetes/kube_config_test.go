@@ -123,6 +123,121 @@ func TestParseKubeConfig(t *testing.T) {
 			},
 			isValidConfig,
 		},
+		{
+			"user_with_exec.yml",
+			KubeConfig{
+				KubeConfigBase: KubeConfigBase{
+					APIVersion: "v1",
+					Clusters: []clusterItem{
+						{
+							Name: "test-cluster",
+							Cluster: cluster{
+								ClusterAuthorityData: "test-cluster-authority-data",
+								Server:               "https://testcluster.org:443",
+							},
+						},
+					},
+					Contexts: []contextItem{
+						{
+							Name: "test-cluster",
+							Context: context{
+								Cluster: "test-cluster",
+								User:    "test-user",
+							},
+						},
+					},
+					CurrentContext: "test-cluster",
+					Kind:           "Config",
+				},
+				Users: []userItem{
+					{
+						Name: "test-user",
+						User: user{
+							Exec: &ExecConfig{
+								APIVersion: "client.authentication.k8s.io/v1beta1",
+								Command:    "kubelogin",
+								Args:       []string{"get-token", "--server-id", "test-server-id"},
+								Env: []ExecEnvVar{
+									{
+										Name:  "AAD_SERVICE_PRINCIPAL_CLIENT_ID",
+										Value: "test-client-id",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			isValidConfig,
+		},
+		{
+			"multiple_contexts.yml",
+			KubeConfig{
+				KubeConfigBase: KubeConfigBase{
+					APIVersion: "v1",
+					Clusters: []clusterItem{
+						{
+							Name: "first-cluster",
+							Cluster: cluster{
+								ClusterAuthorityData: "first-cluster-authority-data",
+								Server:               "https://first.testcluster.org:443",
+							},
+						},
+						{
+							Name: "second-cluster",
+							Cluster: cluster{
+								ClusterAuthorityData: "second-cluster-authority-data",
+								Server:               "https://second.testcluster.org:443",
+							},
+						},
+					},
+					Contexts: []contextItem{
+						{
+							Name: "first-context",
+							Context: context{
+								Cluster: "first-cluster",
+								User:    "first-user",
+							},
+						},
+						{
+							Name: "second-context",
+							Context: context{
+								Cluster:   "second-cluster",
+								User:      "second-user",
+								Namespace: "test-namespace",
+							},
+						},
+					},
+					CurrentContext: "second-context",
+					Kind:           "Config",
+				},
+				Users: []userItem{
+					{
+						Name: "first-user",
+						User: user{
+							Token: "first-token",
+						},
+					},
+					{
+						Name: "second-user",
+						User: user{
+							Token: "second-token",
+						},
+					},
+				},
+			},
+			isValidConfig,
+		},
+		{
+			"user_with_exec_no_command.yml",
+			KubeConfig{},
+			isInvalidConfig,
+		},
+		{
+			"context_with_unknown_cluster.yml",
+			KubeConfig{},
+			isInvalidConfig,
+		},
 		{
 			"user_with_no_auth.yml",
 			KubeConfig{},
@@ -163,6 +278,70 @@ func TestParseKubeConfig(t *testing.T) {
 	}
 }
 
+func TestKubeConfigContextIndices(t *testing.T) {
+	config, err := ParseKubeConfig(LoadConfig("multiple_contexts.yml"))
+	if err != nil {
+		t.Fatalf("Error parsing config: %+v", err)
+	}
+
+	testCases := []struct {
+		context      string
+		clusterIndex int
+		userIndex    int
+	}{
+		{
+			context:      "first-context",
+			clusterIndex: 0,
+			userIndex:    0,
+		},
+		{
+			context:      "second-context",
+			clusterIndex: 1,
+			userIndex:    1,
+		},
+		{
+			context:      "unknown-context",
+			clusterIndex: 0,
+			userIndex:    0,
+		},
+	}
+
+	for _, test := range testCases {
+		clusterIndex, userIndex := config.ContextIndices(test.context, config.UserNames())
+		if clusterIndex != test.clusterIndex || userIndex != test.userIndex {
+			t.Fatalf("Expected context %q to use cluster %d and user %d but got cluster %d and user %d", test.context, test.clusterIndex, test.userIndex, clusterIndex, userIndex)
+		}
+	}
+}
+
+func TestParseKubeConfigAADKubeLoginExec(t *testing.T) {
+	config, err := ParseKubeConfigAAD(LoadConfig("user_with_auth_provider.yml"))
+	if err != nil {
+		t.Fatalf("Error parsing config: %+v", err)
+	}
+
+	expected := &ExecConfig{
+		APIVersion: KubeLoginAPIVersion,
+		Command:    "kubelogin",
+		Args: []string{
+			"get-token",
+			"--environment",
+			"AzureUSGovernmentCloud",
+			"--server-id",
+			"test-server-id",
+			"--client-id",
+			"test-client-id",
+			"--tenant-id",
+			"test-tenant-id",
+		},
+	}
+
+	actual := config.Users[0].User.AuthProvider.KubeLoginExec()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func isValidConfig(expected KubeConfig, encodedConfig string) (bool, error) {
 	result, err := ParseKubeConfig(encodedConfig)
 	if err != nil {
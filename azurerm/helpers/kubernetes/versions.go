@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+)
+
+// MaxNodePoolMinorVersionSkew is the number of minor versions which a Node Pool is allowed to be behind the Control Plane
+const MaxNodePoolMinorVersionSkew = 2
+
+// ValidateNodePoolVersionSkew ensures that a Node Pool running the specified version is supported by a
+// Control Plane running the specified version - that is the Node Pool cannot be newer than the Control Plane
+// and can be at most `MaxNodePoolMinorVersionSkew` minor versions older than it
+func ValidateNodePoolVersionSkew(controlPlaneVersion string, nodePoolVersion string) error {
+	controlPlane, err := version.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", controlPlaneVersion, err)
+	}
+
+	nodePool, err := version.NewVersion(nodePoolVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Orchestrator Version %q: %+v", nodePoolVersion, err)
+	}
+
+	if nodePool.GreaterThan(controlPlane) {
+		return fmt.Errorf("the Node Pool version (%q) cannot be newer than the Control Plane version (%q)", nodePoolVersion, controlPlaneVersion)
+	}
+
+	cpSegments := controlPlane.Segments()
+	npSegments := nodePool.Segments()
+	if cpSegments[0] != npSegments[0] {
+		return fmt.Errorf("the Node Pool version (%q) must have the same major version as the Control Plane version (%q)", nodePoolVersion, controlPlaneVersion)
+	}
+
+	if skew := cpSegments[1] - npSegments[1]; skew > MaxNodePoolMinorVersionSkew {
+		return fmt.Errorf("the Node Pool version (%q) is %d minor versions behind the Control Plane version (%q) but at most %d are supported - upgrade the Node Pool first", nodePoolVersion, skew, controlPlaneVersion, MaxNodePoolMinorVersionSkew)
+	}
+
+	return nil
+}
+
+// ValidateControlPlaneUpgrade ensures that upgrading the Control Plane between the specified versions is possible,
+// which requires that the version isn't downgraded and that no minor versions are skipped
+func ValidateControlPlaneUpgrade(currentVersion string, targetVersion string) error {
+	current, err := version.NewVersion(currentVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", currentVersion, err)
+	}
+
+	target, err := version.NewVersion(targetVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", targetVersion, err)
+	}
+
+	if target.LessThan(current) {
+		return fmt.Errorf("the Kubernetes Version cannot be downgraded from %q to %q", currentVersion, targetVersion)
+	}
+
+	currentSegments := current.Segments()
+	targetSegments := target.Segments()
+	if currentSegments[0] != targetSegments[0] {
+		return fmt.Errorf("the Kubernetes Version cannot be upgraded between major versions (from %q to %q)", currentVersion, targetVersion)
+	}
+
+	if targetSegments[1]-currentSegments[1] > 1 {
+		return fmt.Errorf("the Kubernetes Version can only be upgraded one minor version at a time (from %q to %q) - upgrade to an intermediate version first", currentVersion, targetVersion)
+	}
+
+	return nil
+}
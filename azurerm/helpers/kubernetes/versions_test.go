@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"testing"
+)
+
+func TestValidateNodePoolVersionSkew(t *testing.T) {
+	testCases := []struct {
+		ControlPlane string
+		NodePool     string
+		Valid        bool
+	}{
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.14.6",
+			Valid:        true,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.14.5",
+			Valid:        true,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.12.8",
+			Valid:        true,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.11.10",
+			Valid:        false,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.14.7",
+			Valid:        false,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "1.15.3",
+			Valid:        false,
+		},
+		{
+			ControlPlane: "2.1.0",
+			NodePool:     "1.14.6",
+			Valid:        false,
+		},
+		{
+			ControlPlane: "1.14.6",
+			NodePool:     "latest",
+			Valid:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Control Plane %q / Node Pool %q", tc.ControlPlane, tc.NodePool)
+
+		err := ValidateNodePoolVersionSkew(tc.ControlPlane, tc.NodePool)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t (error: %+v)", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestValidateControlPlaneUpgrade(t *testing.T) {
+	testCases := []struct {
+		Current string
+		Target  string
+		Valid   bool
+	}{
+		{
+			Current: "1.13.10",
+			Target:  "1.13.10",
+			Valid:   true,
+		},
+		{
+			Current: "1.13.10",
+			Target:  "1.13.11",
+			Valid:   true,
+		},
+		{
+			Current: "1.13.10",
+			Target:  "1.14.6",
+			Valid:   true,
+		},
+		{
+			Current: "1.13.10",
+			Target:  "1.15.3",
+			Valid:   false,
+		},
+		{
+			Current: "1.14.6",
+			Target:  "1.13.10",
+			Valid:   false,
+		},
+		{
+			Current: "1.14.6",
+			Target:  "2.0.0",
+			Valid:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing upgrading from %q to %q", tc.Current, tc.Target)
+
+		err := ValidateControlPlaneUpgrade(tc.Current, tc.Target)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t (error: %+v)", tc.Valid, valid, err)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"unicode"
 )
 
 func SharedImageGalleryName(v interface{}, k string) (warnings []string, errors []error) {
@@ -48,3 +50,195 @@ func SharedImageVersionName(v interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+// virtualMachineComputerNameInvalidCharacters are the characters which aren't allowed within
+// the Computer Name (hostname) of a Virtual Machine on either Linux or Windows
+const virtualMachineComputerNameInvalidCharacters = "\\/\"[]:|<>+=;,?*@&~!#$%^()_{}'"
+
+// virtualMachineAdminUsernameDisallowedValues are the usernames which Azure rejects for the
+// Administrator account of a Virtual Machine
+var virtualMachineAdminUsernameDisallowedValues = []string{
+	" ", "1", "123", "a", "actuser", "adm", "admin", "admin1", "admin2", "administrator",
+	"aspnet", "backup", "console", "david", "guest", "john", "owner", "root", "server",
+	"sql", "support", "support_388945a0", "sys", "test", "test1", "test2", "test3",
+	"user", "user1", "user2", "user3", "user4", "user5",
+}
+
+func LinuxComputerName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 64 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 64 characters, got %d.", k, len(value)))
+	}
+
+	errors = append(errors, validateVirtualMachineComputerNameCharacters(value, k)...)
+	return warnings, errors
+}
+
+func WindowsComputerName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 15 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 15 characters, got %d.", k, len(value)))
+	}
+
+	if regexp.MustCompile(`^[0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s cannot be entirely numeric. Got %q.", k, value))
+	}
+
+	errors = append(errors, validateVirtualMachineComputerNameCharacters(value, k)...)
+	return warnings, errors
+}
+
+// LinuxComputerNamePrefix validates the prefix used for the Computer Names of the instances within
+// a Linux Virtual Machine Scale Set, which has a suffix appended and so can end with a dash
+func LinuxComputerNamePrefix(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 58 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 58 characters, got %d.", k, len(value)))
+	}
+
+	errors = append(errors, validateVirtualMachineComputerNamePrefixCharacters(value, k)...)
+	return warnings, errors
+}
+
+// WindowsComputerNamePrefix validates the prefix used for the Computer Names of the instances within
+// a Windows Virtual Machine Scale Set, which has a suffix appended and so can end with a dash
+func WindowsComputerNamePrefix(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 9 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 9 characters, got %d.", k, len(value)))
+	}
+
+	errors = append(errors, validateVirtualMachineComputerNamePrefixCharacters(value, k)...)
+	return warnings, errors
+}
+
+func validateVirtualMachineComputerNamePrefixCharacters(value string, k string) (errors []error) {
+	if strings.HasPrefix(value, "_") {
+		errors = append(errors, fmt.Errorf("%s cannot begin with an underscore. Got %q.", k, value))
+	}
+
+	if strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%s cannot end with a period. Got %q.", k, value))
+	}
+
+	if strings.ContainsAny(value, virtualMachineComputerNameInvalidCharacters) {
+		errors = append(errors, fmt.Errorf("%s cannot contain the special characters: `%s`. Got %q.", k, virtualMachineComputerNameInvalidCharacters, value))
+	}
+
+	return errors
+}
+
+func validateVirtualMachineComputerNameCharacters(value string, k string) (errors []error) {
+	if strings.HasPrefix(value, "_") {
+		errors = append(errors, fmt.Errorf("%s cannot begin with an underscore. Got %q.", k, value))
+	}
+
+	if strings.HasSuffix(value, ".") || strings.HasSuffix(value, "-") {
+		errors = append(errors, fmt.Errorf("%s cannot end with a period or a dash. Got %q.", k, value))
+	}
+
+	if strings.ContainsAny(value, virtualMachineComputerNameInvalidCharacters) {
+		errors = append(errors, fmt.Errorf("%s cannot contain the special characters: `%s`. Got %q.", k, virtualMachineComputerNameInvalidCharacters, value))
+	}
+
+	return errors
+}
+
+func LinuxAdminUsername(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 64 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 64 characters, got %d.", k, len(value)))
+	}
+
+	errors = append(errors, validateVirtualMachineAdminUsernameAllowed(value, k)...)
+	return warnings, errors
+}
+
+func WindowsAdminUsername(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 || len(value) > 20 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 20 characters, got %d.", k, len(value)))
+	}
+
+	if strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%s cannot end with a period. Got %q.", k, value))
+	}
+
+	errors = append(errors, validateVirtualMachineAdminUsernameAllowed(value, k)...)
+	return warnings, errors
+}
+
+func validateVirtualMachineAdminUsernameAllowed(value string, k string) (errors []error) {
+	for _, disallowed := range virtualMachineAdminUsernameDisallowedValues {
+		if strings.EqualFold(value, disallowed) {
+			errors = append(errors, fmt.Errorf("%q is not an allowed value for %s.", value, k))
+			break
+		}
+	}
+
+	return errors
+}
+
+func LinuxAdminPassword(v interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineAdminPassword(v.(string), k, 6, 72)
+}
+
+func WindowsAdminPassword(v interface{}, k string) (warnings []string, errors []error) {
+	return validateVirtualMachineAdminPassword(v.(string), k, 8, 123)
+}
+
+func validateVirtualMachineAdminPassword(value string, k string, minLength int, maxLength int) (warnings []string, errors []error) {
+	if len(value) < minLength || len(value) > maxLength {
+		// the value is sensitive, so only the length is output
+		errors = append(errors, fmt.Errorf("%s must be between %d and %d characters, got %d.", k, minLength, maxLength, len(value)))
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSpecial bool
+	for _, c := range value {
+		switch {
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+
+	complexity := 0
+	for _, v := range []bool{hasLower, hasUpper, hasDigit, hasSpecial} {
+		if v {
+			complexity++
+		}
+	}
+
+	if complexity < 3 {
+		errors = append(errors, fmt.Errorf("%s must contain at least 3 of: a lowercase character, an uppercase character, a digit and a special character.", k))
+	}
+
+	return warnings, errors
+}
+
+// VirtualMachineMaxBidPrice validates the maximum price (in US Dollars) which should be paid for a Spot
+// Virtual Machine, which is either -1 (paying up to the on-demand price) or a price greater than zero
+func VirtualMachineMaxBidPrice(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(float64)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be float64", k))
+		return warnings, errors
+	}
+
+	if value != -1 && value <= 0 {
+		errors = append(errors, fmt.Errorf("%s must be either -1 or greater than 0, got %f", k, value))
+	}
+
+	return warnings, errors
+}
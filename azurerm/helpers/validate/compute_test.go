@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -174,3 +175,474 @@ func TestSharedImageVersionName(t *testing.T) {
 		})
 	}
 }
+
+func TestLinuxComputerName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-world",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.world",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "_hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello_world",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello@world",
+			ShouldError: true,
+		},
+		{
+			Input:       "12345",
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(64),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(65),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := LinuxComputerName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsComputerName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-world",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-",
+			ShouldError: true,
+		},
+		{
+			Input:       "12345",
+			ShouldError: true,
+		},
+		{
+			Input:       "abc123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello!",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(15),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(16),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsComputerName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestLinuxComputerNamePrefix(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "_hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello!",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(58),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(59),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := LinuxComputerNamePrefix(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsComputerNamePrefix(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "_hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello!",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(9),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(10),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsComputerNamePrefix(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestLinuxAdminUsername(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "adminuser",
+			ShouldError: false,
+		},
+		{
+			Input:       "admin",
+			ShouldError: true,
+		},
+		{
+			Input:       "ROOT",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(64),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(65),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := LinuxAdminUsername(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsAdminUsername(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "adminuser",
+			ShouldError: false,
+		},
+		{
+			Input:       "Administrator",
+			ShouldError: true,
+		},
+		{
+			Input:       "adminuser.",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(20),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(21),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsAdminUsername(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestLinuxAdminPassword(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "Pa55",
+			ShouldError: true,
+		},
+		{
+			Input:       "Pa55w0rd",
+			ShouldError: false,
+		},
+		{
+			Input:       "password",
+			ShouldError: true,
+		},
+		{
+			Input:       "passw0rd",
+			ShouldError: true,
+		},
+		{
+			Input:       "passw0rd!",
+			ShouldError: false,
+		},
+		{
+			Input:       "PASSWORD1!",
+			ShouldError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := LinuxAdminPassword(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsAdminPassword(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "Pa55w0",
+			ShouldError: true,
+		},
+		{
+			Input:       "Pa55w0rd",
+			ShouldError: false,
+		},
+		{
+			Input:       "password123",
+			ShouldError: true,
+		},
+		{
+			Input:       "P@ssw0rd1234!",
+			ShouldError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsAdminPassword(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestVirtualMachineMaxBidPrice(t *testing.T) {
+	cases := []struct {
+		Input       float64
+		ShouldError bool
+	}{
+		{
+			Input:       -2,
+			ShouldError: true,
+		},
+		{
+			Input:       -1,
+			ShouldError: false,
+		},
+		{
+			Input:       -0.5,
+			ShouldError: true,
+		},
+		{
+			Input:       0,
+			ShouldError: true,
+		},
+		{
+			Input:       0.00001,
+			ShouldError: false,
+		},
+		{
+			Input:       1.5,
+			ShouldError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%f", tc.Input), func(t *testing.T) {
+			_, errors := VirtualMachineMaxBidPrice(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %f", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %f but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
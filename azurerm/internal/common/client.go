@@ -9,7 +9,6 @@ import (
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -27,6 +26,8 @@ type ClientOptions struct {
 	SkipProviderReg            bool
 	EnableCorrelationRequestID bool
 	Environment                azure.Environment
+	HTTPTraceSink              *HTTPTraceSink
+	RetryPolicy                *RetryPolicy
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
@@ -35,7 +36,7 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = autorest.DecorateSender(BuildSender(o.HTTPTraceSink), WithRetryPolicy(o.RetryPolicy))
 	c.PollingDuration = o.PollingDuration
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if o.EnableCorrelationRequestID {
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// HeaderRequestID is the Azure header containing the ID assigned to a request by the service
+	HeaderRequestID = "x-ms-request-id"
+
+	// maxHTTPTraceBodyLength is the number of characters of a (redacted) request/response body which are traced
+	maxHTTPTraceBodyLength = 64 * 1024
+)
+
+// HTTPTraceEntry is a single request/response pair written to an HTTPTraceSink. All values are redacted
+// prior to being written, such that the trace can be shared without leaking credentials or secrets.
+type HTTPTraceEntry struct {
+	Timestamp            time.Time         `json:"timestamp"`
+	CorrelationRequestID string            `json:"correlation_request_id,omitempty"`
+	RequestID            string            `json:"request_id,omitempty"`
+	Method               string            `json:"method"`
+	URL                  string            `json:"url"`
+	RequestHeaders       map[string]string `json:"request_headers,omitempty"`
+	RequestBody          string            `json:"request_body,omitempty"`
+	StatusCode           int               `json:"status_code,omitempty"`
+	ResponseHeaders      map[string]string `json:"response_headers,omitempty"`
+	ResponseBody         string            `json:"response_body,omitempty"`
+	DurationMs           int64             `json:"duration_ms"`
+	Error                string            `json:"error,omitempty"`
+}
+
+// HTTPTraceSink writes HTTPTraceEntry's as JSON Lines (one JSON object per line) to the underlying Writer
+type HTTPTraceSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewHTTPTraceSink returns an HTTPTraceSink which writes to the specified Writer
+func NewHTTPTraceSink(writer io.Writer) *HTTPTraceSink {
+	return &HTTPTraceSink{
+		writer: writer,
+	}
+}
+
+// OpenHTTPTraceSink returns an HTTPTraceSink which appends to the file at the specified path, creating it if necessary
+func OpenHTTPTraceSink(path string) (*HTTPTraceSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening HTTP Trace file %q: %+v", path, err)
+	}
+
+	return NewHTTPTraceSink(file), nil
+}
+
+// Close closes the underlying Writer, if it supports being closed (e.g. the file opened by OpenHTTPTraceSink)
+func (s *HTTPTraceSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if closer, ok := s.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Write writes the specified HTTPTraceEntry to the Sink as a single line of JSON
+func (s *HTTPTraceSink) Write(entry HTTPTraceEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("Error serializing HTTP Trace Entry: %+v", err)
+	}
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.writer.Write(line); err != nil {
+		return fmt.Errorf("Error writing HTTP Trace Entry: %+v", err)
+	}
+	return nil
+}
+
+func truncateHTTPTraceBody(input string) string {
+	if len(input) <= maxHTTPTraceBodyLength {
+		return input
+	}
+
+	return fmt.Sprintf("%s... (truncated %d characters)", input[:maxHTTPTraceBodyLength], len(input)-maxHTTPTraceBodyLength)
+}
@@ -0,0 +1,202 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const redactedValue = "REDACTED"
+
+var (
+	// sensitiveHeaderNamePattern matches the names of HTTP Headers whose values should never be written out
+	sensitiveHeaderNamePattern = regexp.MustCompile(`(?i)(authorization|secret|password|token|signature|-key$|^key$)`)
+
+	// sensitiveQueryParameterPattern matches the names of Query String parameters whose values should never be written out
+	sensitiveQueryParameterPattern = regexp.MustCompile(`(?i)^(sig|code|.*secret.*|.*password.*|.*token.*)$`)
+
+	// sensitiveFieldNamePattern matches the names of JSON fields whose values (including any values nested
+	// beneath them, e.g. `{"adminPassword":{"value":"..."}}`) should never be written out
+	sensitiveFieldNamePattern = regexp.MustCompile(`(?i)(secret|password|passwd|token|connectionstring|accountkey|accesskey|primarykey|secondarykey|masterkey|sharedkey|^key[0-9]*$)`)
+
+	sensitiveStringPatterns = []*regexp.Regexp{
+		// bearer tokens, e.g. `Authorization: Bearer eyJ0eXAi...`
+		regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`),
+		// SAS signatures, e.g. `https://account.blob.core.windows.net/container?sv=2018-11-09&sig=abc123`
+		regexp.MustCompile(`(?i)((?:^|[?&"'\s])sig=)[^&"'\s]+`),
+		// Storage Account / Service Bus connection strings
+		regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|Password|Pwd)=)[^;"'\s]+`),
+		// form-encoded OAuth requests & responses
+		regexp.MustCompile(`(?i)((?:^|&)(?:client_secret|client_assertion|assertion|password|refresh_token|access_token)=)[^&]+`),
+	}
+)
+
+// redactHeaders returns a flattened copy of the specified HTTP Headers with any sensitive values redacted
+func redactHeaders(headers http.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	output := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if sensitiveHeaderNamePattern.MatchString(name) {
+			output[name] = redactedValue
+			continue
+		}
+
+		output[name] = redactString(strings.Join(values, ", "))
+	}
+	return output
+}
+
+// redactURL returns the specified URL with the values of any sensitive Query String parameters redacted
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	output.User = nil
+
+	query := output.Query()
+	if len(query) > 0 {
+		for name := range query {
+			if sensitiveQueryParameterPattern.MatchString(name) {
+				query.Set(name, redactedValue)
+			}
+		}
+		output.RawQuery = query.Encode()
+	}
+
+	return output.String()
+}
+
+// redactBody returns a copy of the specified HTTP Body with any sensitive values redacted. JSON bodies
+// are redacted field-by-field; any other content has well-known secret formats (bearer tokens, SAS
+// signatures, connection strings and form-encoded credentials) redacted.
+func redactBody(body []byte, requestURL *url.URL) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.UseNumber()
+
+		var parsed interface{}
+		if err := decoder.Decode(&parsed); err == nil {
+			redactValues := false
+			if requestURL != nil {
+				// Key Vault Secrets and the `listKeys` API's return the sensitive value in a field named `value`
+				path := strings.ToLower(requestURL.Path)
+				redactValues = strings.Contains(path, "/secrets") || strings.Contains(path, "/listkeys") || strings.Contains(path, "/regeneratekey")
+			}
+
+			// HTML escaping is disabled so that URL's (e.g. containing `&`) remain readable
+			redacted := &bytes.Buffer{}
+			encoder := json.NewEncoder(redacted)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(redactJSON(parsed, redactValues)); err == nil {
+				return strings.TrimSuffix(redacted.String(), "\n")
+			}
+		}
+	}
+
+	return redactString(string(body))
+}
+
+func redactJSON(input interface{}, redactValues bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if sensitiveFieldNamePattern.MatchString(key) {
+				output[key] = redactAllJSONValues(value)
+				continue
+			}
+
+			if isScalarJSONValue(value) && redactValues && strings.EqualFold(key, "value") {
+				output[key] = redactedValue
+				continue
+			}
+
+			output[key] = redactJSON(value, redactValues)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			output = append(output, redactJSON(value, redactValues))
+		}
+		return output
+
+	case string:
+		return redactString(v)
+	}
+
+	return input
+}
+
+// redactAllJSONValues redacts every scalar value within the specified JSON value, regardless of the
+// names of the fields containing them - used for the values beneath a sensitive field
+func redactAllJSONValues(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			output[key] = redactAllJSONValues(value)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			output = append(output, redactAllJSONValues(value))
+		}
+		return output
+	}
+
+	if isScalarJSONValue(input) {
+		return redactedValue
+	}
+
+	return input
+}
+
+func isScalarJSONValue(input interface{}) bool {
+	switch v := input.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+
+	return true
+}
+
+// redactString redacts any well-known secret formats found within the specified string
+func redactString(input string) string {
+	output := input
+	for _, pattern := range sensitiveStringPatterns {
+		output = pattern.ReplaceAllString(output, "${1}"+redactedValue)
+	}
+	return output
+}
+
+// sortedHeaderNames returns the names of the specified (flattened) HTTP Headers in a stable order
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenHTTPTraceSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "http-trace")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "trace.jsonl")
+	sink, err := OpenHTTPTraceSink(path)
+	if err != nil {
+		t.Fatalf("Error opening HTTP Trace Sink: %+v", err)
+	}
+
+	if err := sink.Write(HTTPTraceEntry{Method: "GET", URL: "https://example.com"}); err != nil {
+		t.Fatalf("Error writing HTTP Trace Entry: %+v", err)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Error closing HTTP Trace Sink: %+v", err)
+	}
+
+	if err := sink.Write(HTTPTraceEntry{Method: "GET", URL: "https://example.com"}); err == nil {
+		t.Fatalf("Expected an error writing to a closed HTTP Trace Sink but didn't get one")
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading HTTP Trace file: %+v", err)
+	}
+
+	if lines := strings.Count(string(contents), "\n"); lines != 1 {
+		t.Fatalf("Expected 1 line in the HTTP Trace file but got %d", lines)
+	}
+}
@@ -0,0 +1,303 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// headerRetryAfter is the header used by Resource Manager to specify how long to wait before retrying a request
+	headerRetryAfter = "Retry-After"
+
+	// headerRateLimitRemainingPrefix is the prefix of the headers used by Resource Manager to specify the
+	// number of requests remaining for the current window, e.g. `x-ms-ratelimit-remaining-subscription-reads`
+	headerRateLimitRemainingPrefix = "x-ms-ratelimit-remaining-"
+)
+
+var subscriptionIdFromPathRegex = regexp.MustCompile(`(?i)/subscriptions/([^/?]+)`)
+
+// RetryPolicy determines how requests to Azure are retried when they're throttled (that is, an HTTP 429 is returned)
+// and how quickly requests are sent to each Subscription. A single RetryPolicy is shared between all of the Clients
+// built from a ClientOptions, such that the client-side rate limit applies across all clients.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a throttled request is retried before an error is returned
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry when Azure doesn't return a `Retry-After` header,
+	// which is doubled for each subsequent retry (up to MaxBackoff)
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries
+	MaxBackoff time.Duration
+
+	// RequestsPerSecond is the rate at which requests can be sent to each Subscription, 0 disables the rate limit
+	RequestsPerSecond float64
+
+	// Burst is the number of requests which can be sent to a Subscription at once, before the rate limit applies
+	Burst int
+
+	// RemainingRequestsThreshold is the number of requests remaining (as returned in the `x-ms-ratelimit-remaining-*`
+	// headers) below which requests to a Subscription are proportionally slowed down
+	RemainingRequestsThreshold int
+
+	bucketsLock sync.Mutex
+	buckets     map[string]*tokenBucket
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when one isn't configured
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:                 10,
+		MinBackoff:                 5 * time.Second,
+		MaxBackoff:                 2 * time.Minute,
+		RequestsPerSecond:          10,
+		Burst:                      50,
+		RemainingRequestsThreshold: 100,
+	}
+}
+
+// WithRetryPolicy returns a SendDecorator which rate limits requests per Subscription and retries throttled requests,
+// honouring the `Retry-After` header returned by Azure.
+func WithRetryPolicy(policy *RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if policy == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			bucket := policy.bucketForRequest(r)
+			rr := autorest.NewRetriableRequest(r)
+
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				if bucket != nil {
+					if err := bucket.wait(r.Context().Done()); err != nil {
+						return nil, err
+					}
+				}
+
+				resp, err := s.Do(rr.Request())
+				if resp != nil && bucket != nil {
+					bucket.observeRemainingRequests(resp.Header)
+				}
+				if err != nil || resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+					return resp, err
+				}
+
+				if attempt >= policy.MaxRetries {
+					// returning an error (rather than the response) prevents the autorest retry logic from retrying the request indefinitely
+					drainAndClose(resp.Body)
+					return nil, fmt.Errorf("the request to %s was throttled by Azure (HTTP 429) and retrying %d times didn't succeed - consider lowering the `requests_per_second` in the `retry` block of the Provider", redactURL(r.URL), policy.MaxRetries)
+				}
+
+				delay := policy.delayForAttempt(attempt, resp)
+				log.Printf("[DEBUG] Request to %s was throttled (attempt %d/%d) - retrying in %s", redactURL(r.URL), attempt+1, policy.MaxRetries, delay)
+				drainAndClose(resp.Body)
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// delayForAttempt returns how long to wait before retrying a throttled request - which is the `Retry-After`
+// returned by Azure where present, otherwise an exponential backoff (with jitter) between MinBackoff and MaxBackoff
+func (p *RetryPolicy) delayForAttempt(attempt int, resp *http.Response) time.Duration {
+	if delay, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now()); ok {
+		if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return delay
+	}
+
+	backoff := float64(p.MinBackoff) * math.Pow(2, float64(attempt))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	// add up to 20% jitter so that parallel requests don't all retry at the same time
+	jitter := backoff * 0.2 * rand.Float64()
+	return time.Duration(backoff + jitter)
+}
+
+func (p *RetryPolicy) bucketForRequest(r *http.Request) *tokenBucket {
+	if p.RequestsPerSecond <= 0 || r.URL == nil {
+		return nil
+	}
+
+	match := subscriptionIdFromPathRegex.FindStringSubmatch(r.URL.Path)
+	if len(match) != 2 {
+		return nil
+	}
+	subscriptionId := strings.ToLower(match[1])
+
+	p.bucketsLock.Lock()
+	defer p.bucketsLock.Unlock()
+
+	if p.buckets == nil {
+		p.buckets = make(map[string]*tokenBucket)
+	}
+
+	bucket, ok := p.buckets[subscriptionId]
+	if !ok {
+		bucket = newTokenBucket(p.RequestsPerSecond, p.Burst, p.RemainingRequestsThreshold)
+		p.buckets[subscriptionId] = bucket
+	}
+	return bucket
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which can either be a number of seconds or an HTTP Date
+func parseRetryAfter(input string, now time.Time) (time.Duration, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(input); err == nil {
+		delay := t.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+
+	io.Copy(ioutil.Discard, body) // nolint: errcheck
+	body.Close()
+}
+
+// tokenBucket is a client-side rate limiter for the requests sent to a single Subscription
+type tokenBucket struct {
+	lock sync.Mutex
+
+	rate      float64
+	burst     float64
+	threshold int
+
+	tokens     float64
+	lastRefill time.Time
+
+	// remaining is the lowest number of requests remaining most recently returned by Azure, or -1 if unknown
+	remaining int
+}
+
+func newTokenBucket(rate float64, burst int, threshold int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:       rate,
+		burst:      float64(burst),
+		threshold:  threshold,
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+		remaining:  -1,
+	}
+}
+
+// wait blocks until a token is available (or the cancel channel is closed), then consumes it
+func (b *tokenBucket) wait(cancel <-chan struct{}) error {
+	for {
+		delay := b.take(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-cancel:
+			return fmt.Errorf("cancelled whilst waiting for the client-side rate limit")
+		}
+	}
+}
+
+// take consumes a token if one is available (returning 0), otherwise returns how long until one will be
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	rate := b.effectiveRate()
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*rate)
+		b.lastRefill = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / rate * float64(time.Second))
+}
+
+// effectiveRate returns the rate at which tokens are added to the bucket - which is reduced proportionally
+// once the number of requests remaining (according to Azure) drops below the threshold, to a minimum of 10%
+func (b *tokenBucket) effectiveRate() float64 {
+	if b.remaining < 0 || b.threshold <= 0 || b.remaining >= b.threshold {
+		return b.rate
+	}
+
+	return b.rate * math.Max(0.1, float64(b.remaining)/float64(b.threshold))
+}
+
+// observeRemainingRequests records the lowest of the `x-ms-ratelimit-remaining-*` headers returned by Azure
+func (b *tokenBucket) observeRemainingRequests(headers http.Header) {
+	remaining := -1
+	for name, values := range headers {
+		if !strings.HasPrefix(strings.ToLower(name), headerRateLimitRemainingPrefix) || len(values) == 0 {
+			continue
+		}
+
+		v, err := strconv.Atoi(strings.TrimSpace(values[0]))
+		if err != nil {
+			continue
+		}
+
+		if remaining == -1 || v < remaining {
+			remaining = v
+		}
+	}
+
+	if remaining == -1 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.remaining = remaining
+}
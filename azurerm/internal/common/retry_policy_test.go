@@ -0,0 +1,244 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetryPolicyRetriesThrottledRequests(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}
+	sender := autorest.DecorateSender(&http.Client{}, WithRetryPolicy(policy))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups", nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a %d but got a %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests but got %d", requests)
+	}
+}
+
+func TestWithRetryPolicyReturnsErrorWhenRetriesAreExhausted(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}
+	sender := autorest.DecorateSender(&http.Client{}, WithRetryPolicy(policy))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups", nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests but got %d", requests)
+	}
+}
+
+func TestRetryPolicyDelayForAttempt(t *testing.T) {
+	policy := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	testCases := []struct {
+		attempt    int
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{
+			attempt:    0,
+			retryAfter: "3",
+			min:        3 * time.Second,
+			max:        3 * time.Second,
+		},
+		{
+			// the Retry-After is capped at the MaxBackoff
+			attempt:    0,
+			retryAfter: "60",
+			min:        10 * time.Second,
+			max:        10 * time.Second,
+		},
+		{
+			attempt: 0,
+			min:     time.Second,
+			max:     1200 * time.Millisecond,
+		},
+		{
+			attempt: 2,
+			min:     4 * time.Second,
+			max:     4800 * time.Millisecond,
+		},
+		{
+			attempt: 10,
+			min:     10 * time.Second,
+			max:     12 * time.Second,
+		},
+	}
+
+	for _, test := range testCases {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if test.retryAfter != "" {
+			resp.Header.Set("Retry-After", test.retryAfter)
+		}
+
+		actual := policy.delayForAttempt(test.attempt, resp)
+		if actual < test.min || actual > test.max {
+			t.Fatalf("Expected the delay for attempt %d (Retry-After %q) to be between %s and %s but got %s", test.attempt, test.retryAfter, test.min, test.max, actual)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		input    string
+		expected time.Duration
+		valid    bool
+	}{
+		{
+			input: "",
+		},
+		{
+			input: "soon",
+		},
+		{
+			input: "-1",
+		},
+		{
+			input:    "0",
+			expected: 0,
+			valid:    true,
+		},
+		{
+			input:    "17",
+			expected: 17 * time.Second,
+			valid:    true,
+		},
+		{
+			input:    "Tue, 01 Oct 2019 12:00:30 GMT",
+			expected: 30 * time.Second,
+			valid:    true,
+		},
+		{
+			// dates in the past mean retry immediately
+			input:    "Tue, 01 Oct 2019 11:00:00 GMT",
+			expected: 0,
+			valid:    true,
+		},
+	}
+
+	for _, test := range testCases {
+		actual, valid := parseRetryAfter(test.input, now)
+		if valid != test.valid {
+			t.Fatalf("Expected %q to be valid %t but got %t", test.input, test.valid, valid)
+		}
+		if actual != test.expected {
+			t.Fatalf("Expected %q to be %s but got %s", test.input, test.expected, actual)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, 2, 0)
+	bucket.lastRefill = now
+
+	// the burst is available immediately
+	for i := 0; i < 2; i++ {
+		if delay := bucket.take(now); delay != 0 {
+			t.Fatalf("Expected request %d to be allowed immediately but got a delay of %s", i, delay)
+		}
+	}
+
+	// then a token is added every 500ms
+	if delay := bucket.take(now); delay != 500*time.Millisecond {
+		t.Fatalf("Expected a delay of 500ms but got %s", delay)
+	}
+	if delay := bucket.take(now.Add(500 * time.Millisecond)); delay != 0 {
+		t.Fatalf("Expected the request to be allowed after 500ms but got a delay of %s", delay)
+	}
+}
+
+func TestTokenBucketSlowsDownWhenFewRequestsRemain(t *testing.T) {
+	bucket := newTokenBucket(10, 1, 100)
+
+	if rate := bucket.effectiveRate(); rate != 10 {
+		t.Fatalf("Expected the rate to be 10 when the remaining requests are unknown but got %f", rate)
+	}
+
+	headers := http.Header{}
+	headers.Set("x-ms-ratelimit-remaining-subscription-reads", "11999")
+	headers.Set("x-ms-ratelimit-remaining-subscription-writes", "50")
+	bucket.observeRemainingRequests(headers)
+	if rate := bucket.effectiveRate(); rate != 5 {
+		t.Fatalf("Expected the rate to be 5 when 50 requests remain but got %f", rate)
+	}
+
+	headers.Set("x-ms-ratelimit-remaining-subscription-writes", "0")
+	bucket.observeRemainingRequests(headers)
+	if rate := bucket.effectiveRate(); rate != 1 {
+		t.Fatalf("Expected the rate to be 1 when no requests remain but got %f", rate)
+	}
+}
+
+func TestRetryPolicyBucketForRequest(t *testing.T) {
+	policy := &RetryPolicy{
+		RequestsPerSecond: 1,
+		Burst:             1,
+	}
+
+	first, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups?api-version=2018-05-01", nil)
+	second, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/providers?api-version=2018-05-01", nil)
+	other, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups?api-version=2018-05-01", nil)
+	dataPlane, _ := http.NewRequest(http.MethodGet, "https://vault1.vault.azure.net/secrets/secret1", nil)
+
+	if policy.bucketForRequest(first) != policy.bucketForRequest(second) {
+		t.Fatalf("Expected requests to the same Subscription to share a bucket")
+	}
+	if policy.bucketForRequest(first) == policy.bucketForRequest(other) {
+		t.Fatalf("Expected requests to different Subscriptions not to share a bucket")
+	}
+	if policy.bucketForRequest(dataPlane) != nil {
+		t.Fatalf("Expected requests which aren't to a Subscription not to be rate limited")
+	}
+}
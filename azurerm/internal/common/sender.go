@@ -0,0 +1,99 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// BuildSender returns the Sender used for requests to Azure. Each request and response is logged at DEBUG with any
+// credentials and secrets redacted - and when an HTTPTraceSink is specified, is also written to it as JSON Lines.
+func BuildSender(traceSink *HTTPTraceSink) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRedactedRequestLogging(traceSink))
+}
+
+func withRedactedRequestLogging(traceSink *HTTPTraceSink) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			entry := HTTPTraceEntry{
+				Timestamp:            time.Now().UTC(),
+				CorrelationRequestID: r.Header.Get(HeaderCorrelationRequestID),
+				Method:               r.Method,
+				URL:                  redactURL(r.URL),
+				RequestHeaders:       redactHeaders(r.Header),
+			}
+
+			requestBody, readErr := readAndRestoreBody(&r.Body)
+			if readErr != nil {
+				log.Printf("[DEBUG] Error reading the body of the AzureRM Request to %s: %+v", entry.URL, readErr)
+			}
+			entry.RequestBody = truncateHTTPTraceBody(redactBody(requestBody, r.URL))
+			log.Printf("[DEBUG] AzureRM Request: \n%s\n", dumpHTTPTraceMessage(fmt.Sprintf("%s %s", entry.Method, entry.URL), entry.RequestHeaders, entry.RequestBody))
+
+			resp, err := s.Do(r)
+			entry.DurationMs = int64(time.Since(entry.Timestamp) / time.Millisecond)
+			if err != nil {
+				entry.Error = redactString(err.Error())
+			}
+
+			if resp != nil {
+				entry.StatusCode = resp.StatusCode
+				entry.RequestID = resp.Header.Get(HeaderRequestID)
+				entry.ResponseHeaders = redactHeaders(resp.Header)
+
+				responseBody, readErr := readAndRestoreBody(&resp.Body)
+				if readErr != nil {
+					log.Printf("[DEBUG] Error reading the body of the AzureRM Response for %s: %+v", entry.URL, readErr)
+				}
+				entry.ResponseBody = truncateHTTPTraceBody(redactBody(responseBody, r.URL))
+				log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", entry.URL, dumpHTTPTraceMessage(resp.Status, entry.ResponseHeaders, entry.ResponseBody))
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", entry.URL)
+			}
+
+			if traceSink != nil {
+				if writeErr := traceSink.Write(entry); writeErr != nil {
+					log.Printf("[WARN] %+v", writeErr)
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// readAndRestoreBody reads the specified HTTP Body in full, replacing it with an identical copy so that it can be read again
+func readAndRestoreBody(body *io.ReadCloser) ([]byte, error) {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+	return contents, err
+}
+
+func dumpHTTPTraceMessage(firstLine string, headers map[string]string, body string) string {
+	lines := []string{firstLine}
+	for _, name := range sortedHeaderNames(headers) {
+		lines = append(lines, fmt.Sprintf("%s: %s", name, headers[name]))
+	}
+
+	if body != "" {
+		lines = append(lines, "", body)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestBuildSenderWritesRedactedHTTPTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), "Password1234!") {
+			t.Errorf("Expected the request body sent to the server to be unredacted but got %q", string(body))
+		}
+
+		w.Header().Set(HeaderRequestID, "22222222-2222-2222-2222-222222222222")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"vm1","properties":{"osProfile":{"adminPassword":"Password1234!"}}}`))
+	}))
+	defer server.Close()
+
+	trace := &bytes.Buffer{}
+	sender := BuildSender(NewHTTPTraceSink(trace))
+
+	correlationId := CorrelationRequestID()
+	req, err := autorest.Prepare(&http.Request{},
+		autorest.AsPut(),
+		autorest.WithBaseURL(server.URL),
+		autorest.WithPath("/virtualMachines/vm1"),
+		autorest.WithHeader("Authorization", "Bearer eyJ0eXAiOiJKV1Qi"),
+		autorest.WithJSON(map[string]interface{}{
+			"adminPassword": "Password1234!",
+		}),
+		WithCorrelationRequestID(correlationId))
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	// the response body must still be readable by the caller
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "Password1234!") {
+		t.Fatalf("Expected the response body returned to the caller to be unredacted but got %q", string(body))
+	}
+
+	if strings.Contains(trace.String(), "Password1234!") || strings.Contains(trace.String(), "eyJ0eXAiOiJKV1Qi") {
+		t.Fatalf("Expected the trace to be redacted but got %q", trace.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(trace.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 trace entry but got %d", len(lines))
+	}
+
+	var entry HTTPTraceEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Error parsing trace entry %q: %+v", lines[0], err)
+	}
+
+	if entry.CorrelationRequestID != correlationId {
+		t.Fatalf("Expected the Correlation Request ID to be %q but got %q", correlationId, entry.CorrelationRequestID)
+	}
+	if entry.RequestID != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("Expected the Request ID to be %q but got %q", "22222222-2222-2222-2222-222222222222", entry.RequestID)
+	}
+	if entry.Method != http.MethodPut {
+		t.Fatalf("Expected the Method to be %q but got %q", http.MethodPut, entry.Method)
+	}
+	if entry.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusOK, entry.StatusCode)
+	}
+	if entry.RequestHeaders["Authorization"] != redactedValue {
+		t.Fatalf("Expected the Authorization header to be redacted but got %q", entry.RequestHeaders["Authorization"])
+	}
+	if !strings.Contains(entry.ResponseBody, `"name":"vm1"`) {
+		t.Fatalf("Expected the Response Body to contain the name but got %q", entry.ResponseBody)
+	}
+}
@@ -0,0 +1,20 @@
+package features
+
+// Default returns the features which are used when the `features` block
+// isn't specified - these match the behaviour of earlier versions of the Provider.
+func Default() UserFeatures {
+	return UserFeatures{
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy: false,
+		},
+		ResourceGroup: ResourceGroupFeatures{
+			PreventDeletionIfContainsResources: false,
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion: false,
+		},
+		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+			RollInstancesWhenRequired: false,
+		},
+	}
+}
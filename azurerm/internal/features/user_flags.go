@@ -0,0 +1,34 @@
+package features
+
+// UserFeatures contains the behaviours which can be toggled by the user
+// within the `features` block of the Provider configuration.
+type UserFeatures struct {
+	KeyVault               KeyVaultFeatures
+	ResourceGroup          ResourceGroupFeatures
+	VirtualMachine         VirtualMachineFeatures
+	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
+}
+
+type KeyVaultFeatures struct {
+	// PurgeSoftDeleteOnDestroy determines if a Key Vault with Soft Delete enabled
+	// should be purged when it's destroyed, rather than being left in a recoverable state
+	PurgeSoftDeleteOnDestroy bool
+}
+
+type ResourceGroupFeatures struct {
+	// PreventDeletionIfContainsResources determines if the deletion of a Resource Group
+	// should be refused when it still contains Resources
+	PreventDeletionIfContainsResources bool
+}
+
+type VirtualMachineFeatures struct {
+	// DeleteOSDiskOnDeletion determines if the OS Disk attached to a Virtual Machine
+	// should be deleted when the Virtual Machine is destroyed
+	DeleteOSDiskOnDeletion bool
+}
+
+type VirtualMachineScaleSetFeatures struct {
+	// RollInstancesWhenRequired determines if the instances of a Virtual Machine Scale Set using
+	// the Manual Upgrade Mode should be upgraded when the Scale Set's model changes
+	RollInstancesWhenRequired bool
+}
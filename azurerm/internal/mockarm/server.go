@@ -0,0 +1,362 @@
+package mockarm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const operationsPath = "/mockarm/operations/"
+
+// Server is an in-process fake of the Azure Resource Manager API, which stores resources in-memory
+// and understands PUT/PATCH/GET/DELETE requests (including listing resources) - with PUT and DELETE
+// requests completing as Long Running Operations, polled via the `Azure-AsyncOperation` header.
+//
+// This allows the Create/Read/Update/Delete functions for a resource to be exercised in `go test`
+// without credentials, by using the URL of this Server as the `ResourceManagerEndpoint` within the
+// `common.ClientOptions` used to build the Service Clients.
+type Server struct {
+	// PollingAttempts is the number of times a Long Running Operation is reported as `InProgress`
+	// before it completes. When this is zero PUT and DELETE requests complete synchronously.
+	PollingAttempts int
+
+	server *httptest.Server
+
+	mu             sync.Mutex
+	resources      map[string]map[string]interface{}
+	operations     map[string]int
+	operationCount int
+	requests       []Request
+}
+
+// Request is a record of an HTTP Request which has been made to the Server
+type Request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// NewServer starts and returns a new Server, which should be closed (via `Close`) once finished with
+func NewServer() *Server {
+	s := &Server{
+		PollingAttempts: 1,
+		resources:       make(map[string]map[string]interface{}),
+		operations:      make(map[string]int),
+		requests:        make([]Request, 0),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// URL returns the base URL for this Server, for use as the Resource Manager Endpoint
+func (s *Server) URL() string {
+	return fmt.Sprintf("%s/", s.server.URL)
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Requests returns each of the Requests which have been made to the Server (excluding polling
+// the status of Long Running Operations), in the order they were made
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+// Resource returns the Resource with the specified ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource, ok := s.resources[resourceKey(id)]
+	return resource, ok
+}
+
+// SetResource creates (or replaces) the Resource with the specified ID, allowing existing resources
+// to be seeded prior to a test
+func (s *Server) SetResource(id string, resource map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources[resourceKey(id)] = normalizeResource(id, resource, "Succeeded")
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, operationsPath) {
+		s.handleOperation(w, r)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error reading request body: %+v", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Body:   string(body),
+	})
+
+	id := strings.TrimSuffix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodGet:
+		s.handleGet(w, id)
+	case http.MethodPut:
+		s.handlePut(w, id, body)
+	case http.MethodPatch:
+		s.handlePatch(w, id, body)
+	case http.MethodDelete:
+		s.handleDelete(w, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "UnsupportedMethod", fmt.Sprintf("The mock Resource Manager doesn't support %q requests", r.Method))
+	}
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, id string) {
+	if resource, ok := s.resources[resourceKey(id)]; ok {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	if !isCollection(id) {
+		code := "ResourceNotFound"
+		if isResourceGroup(id) {
+			code = "ResourceGroupNotFound"
+		}
+		writeError(w, http.StatusNotFound, code, fmt.Sprintf("The Resource %q was not found.", id))
+		return
+	}
+
+	// `/resourceGroups/{name}/resources` lists every resource within the Resource Group, otherwise
+	// this is a list of the direct children of the parent resource
+	prefix := resourceKey(id) + "/"
+	depth := 1
+	if strings.HasSuffix(prefix, "/resources/") {
+		prefix = strings.TrimSuffix(prefix, "resources/") + "providers/"
+		depth = -1
+	}
+
+	keys := make([]string, 0)
+	for key := range s.resources {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		segments := strings.Split(strings.TrimPrefix(key, prefix), "/")
+		if depth == -1 || len(segments) == depth {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, s.resources[key])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) handlePut(w http.ResponseWriter, id string, body []byte) {
+	if isCollection(id) {
+		writeError(w, http.StatusBadRequest, "InvalidResourceId", fmt.Sprintf("%q is not a valid Resource ID", id))
+		return
+	}
+
+	resource := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &resource); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error parsing request body: %+v", err))
+			return
+		}
+	}
+
+	stored := normalizeResource(id, resource, "Succeeded")
+	s.resources[resourceKey(id)] = stored
+
+	if s.PollingAttempts == 0 {
+		writeJSON(w, http.StatusOK, stored)
+		return
+	}
+
+	s.startOperation(w)
+	writeJSON(w, http.StatusCreated, normalizeResource(id, resource, "Accepted"))
+}
+
+func (s *Server) handlePatch(w http.ResponseWriter, id string, body []byte) {
+	existing, ok := s.resources[resourceKey(id)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+		return
+	}
+
+	update := make(map[string]interface{})
+	if err := json.Unmarshal(body, &update); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error parsing request body: %+v", err))
+		return
+	}
+
+	for key, value := range update {
+		existingProps, existingOk := existing[key].(map[string]interface{})
+		updatedProps, updatedOk := value.(map[string]interface{})
+		if key == "properties" && existingOk && updatedOk {
+			for k, v := range updatedProps {
+				existingProps[k] = v
+			}
+			continue
+		}
+
+		existing[key] = value
+	}
+
+	writeJSON(w, http.StatusOK, existing)
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, id string) {
+	key := resourceKey(id)
+	if _, ok := s.resources[key]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a resource also deletes any nested resources
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+
+	if s.PollingAttempts == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	s.startOperation(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// startOperation registers a new Long Running Operation and sets the headers used to poll it
+func (s *Server) startOperation(w http.ResponseWriter) {
+	s.operationCount++
+	operationId := fmt.Sprintf("operation%d", s.operationCount)
+	s.operations[operationId] = s.PollingAttempts
+
+	w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s%s%s", s.server.URL, operationsPath, operationId))
+	w.Header().Set("Retry-After", "0")
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	operationId := strings.TrimPrefix(r.URL.Path, operationsPath)
+	remaining, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The Operation %q was not found.", operationId))
+		return
+	}
+
+	status := "Succeeded"
+	if remaining > 0 {
+		s.operations[operationId] = remaining - 1
+		status = "InProgress"
+	}
+
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// normalizeResource returns a copy of the specified resource with the fields which are computed by
+// the Resource Manager (such as the `id`, `name`, `type` and `provisioningState`) populated
+func normalizeResource(id string, resource map[string]interface{}, provisioningState string) map[string]interface{} {
+	output := make(map[string]interface{}, len(resource))
+	for k, v := range resource {
+		output[k] = v
+	}
+
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	output["id"] = id
+	output["name"] = segments[len(segments)-1]
+	if _, ok := output["type"]; !ok {
+		output["type"] = resourceType(segments)
+	}
+
+	properties := make(map[string]interface{})
+	if existing, ok := output["properties"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			properties[k] = v
+		}
+	}
+	properties["provisioningState"] = provisioningState
+	output["properties"] = properties
+
+	return output
+}
+
+// resourceType returns the Resource Type for the specified segments, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func resourceType(segments []string) string {
+	providerIndex := -1
+	for i := 0; i < len(segments)-1; i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			providerIndex = i
+		}
+	}
+
+	if providerIndex == -1 {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	types := []string{segments[providerIndex+1]}
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+func resourceKey(id string) string {
+	return strings.ToLower(strings.TrimSuffix(id, "/"))
+}
+
+func isCollection(id string) bool {
+	return len(strings.Split(strings.Trim(id, "/"), "/"))%2 != 0
+}
+
+func isResourceGroup(id string) bool {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	return len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups")
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body) // nolint: errcheck
+}
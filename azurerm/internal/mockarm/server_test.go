@@ -0,0 +1,158 @@
+package mockarm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func TestServerResourceGroupLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.TODO()
+	client := resources.NewGroupsClientWithBaseURI(server.URL(), testSubscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+	client.SkipResourceProviderRegistration = true
+
+	resp, err := client.Get(ctx, "group1")
+	if err == nil {
+		t.Fatalf("Expected an error retrieving a Resource Group which doesn't exist but didn't get one")
+	}
+	if !utils.ResponseWasNotFound(resp.Response) {
+		t.Fatalf("Expected a 404 retrieving a Resource Group which doesn't exist but got %d", resp.StatusCode)
+	}
+
+	parameters := resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"hello": utils.String("world"),
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, "group1", parameters); err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+
+	group, err := client.Get(ctx, "group1")
+	if err != nil {
+		t.Fatalf("Error retrieving Resource Group: %+v", err)
+	}
+	expectedId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1"
+	if group.ID == nil || *group.ID != expectedId {
+		t.Fatalf("Expected the ID to be %q but got %v", expectedId, group.ID)
+	}
+	if group.Location == nil || *group.Location != "westeurope" {
+		t.Fatalf("Expected the Location to be `westeurope` but got %v", group.Location)
+	}
+	if group.Properties == nil || group.Properties.ProvisioningState == nil || *group.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("Expected the Provisioning State to be `Succeeded`")
+	}
+	if v := group.Tags["hello"]; v == nil || *v != "world" {
+		t.Fatalf("Expected the tag `hello` to be `world` but got %v", v)
+	}
+
+	future, err := client.Delete(ctx, "group1")
+	if err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("Error waiting for deletion of Resource Group: %+v", err)
+	}
+
+	if _, ok := server.Resource(expectedId); ok {
+		t.Fatalf("Expected the Resource Group to have been deleted")
+	}
+}
+
+func TestServerLongRunningOperations(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PollingAttempts = 3
+
+	ctx := context.TODO()
+	client := network.NewVirtualNetworksClientWithBaseURI(server.URL(), testSubscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+	client.SkipResourceProviderRegistration = true
+
+	for _, name := range []string{"network1", "network2"} {
+		parameters := network.VirtualNetwork{
+			Location: utils.String("westeurope"),
+			VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+				AddressSpace: &network.AddressSpace{
+					AddressPrefixes: &[]string{"10.0.0.0/16"},
+				},
+			},
+		}
+		future, err := client.CreateOrUpdate(ctx, "group1", name, parameters)
+		if err != nil {
+			t.Fatalf("Error creating Virtual Network %q: %+v", name, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			t.Fatalf("Error waiting for creation of Virtual Network %q: %+v", name, err)
+		}
+
+		vnet, err := future.Result(client)
+		if err != nil {
+			t.Fatalf("Error retrieving result of Virtual Network %q: %+v", name, err)
+		}
+		if vnet.Name == nil || *vnet.Name != name {
+			t.Fatalf("Expected the Name to be %q but got %v", name, vnet.Name)
+		}
+		if vnet.Type == nil || *vnet.Type != "Microsoft.Network/virtualNetworks" {
+			t.Fatalf("Expected the Type to be `Microsoft.Network/virtualNetworks` but got %v", vnet.Type)
+		}
+	}
+
+	page, err := client.List(ctx, "group1")
+	if err != nil {
+		t.Fatalf("Error listing Virtual Networks: %+v", err)
+	}
+	if len(page.Values()) != 2 {
+		t.Fatalf("Expected 2 Virtual Networks but got %d", len(page.Values()))
+	}
+
+	puts := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPut {
+			puts++
+		}
+	}
+	if puts != 2 {
+		t.Fatalf("Expected 2 PUT requests but got %d", puts)
+	}
+}
+
+func TestServerSetResource(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	server.SetResource(id, map[string]interface{}{
+		"properties": map[string]interface{}{
+			"addressPrefix": "10.0.1.0/24",
+		},
+	})
+
+	ctx := context.TODO()
+	client := network.NewSubnetsClientWithBaseURI(server.URL(), testSubscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+	client.SkipResourceProviderRegistration = true
+
+	subnet, err := client.Get(ctx, "group1", "network1", "subnet1", "")
+	if err != nil {
+		t.Fatalf("Error retrieving Subnet: %+v", err)
+	}
+	if subnet.SubnetPropertiesFormat == nil || subnet.AddressPrefix == nil || *subnet.AddressPrefix != "10.0.1.0/24" {
+		t.Fatalf("Expected the Address Prefix to be `10.0.1.0/24`")
+	}
+	if subnet.ProvisioningState == nil || *subnet.ProvisioningState != "Succeeded" {
+		t.Fatalf("Expected the Provisioning State to be `Succeeded`")
+	}
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AnalysisServicesServerID is a parsed Resource ID for a Analysis Services Server
+type AnalysisServicesServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAnalysisServicesServerID returns a new AnalysisServicesServerID from the specified components
+func NewAnalysisServicesServerID(subscriptionId, resourceGroup, name string) AnalysisServicesServerID {
+	return AnalysisServicesServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Analysis Services Server
+func (id AnalysisServicesServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AnalysisServices/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAnalysisServicesServerID parses the specified Resource ID into a AnalysisServicesServerID, returning an error
+// if the Resource ID isn't for a Analysis Services Server
+func ParseAnalysisServicesServerID(input string) (*AnalysisServicesServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Analysis Services Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.AnalysisServices") {
+		return nil, fmt.Errorf("Error parsing Analysis Services Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.AnalysisServices", id.Provider)
+	}
+
+	resourceId := AnalysisServicesServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Analysis Services Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Analysis Services Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAnalysisServicesServerID validates that the specified value is a Analysis Services Server ID
+func ValidateAnalysisServicesServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAnalysisServicesServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Analysis Services Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementID is a parsed Resource ID for a Api Management
+type ApiManagementID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApiManagementID returns a new ApiManagementID from the specified components
+func NewApiManagementID(subscriptionId, resourceGroup, name string) ApiManagementID {
+	return ApiManagementID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management
+func (id ApiManagementID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApiManagementID parses the specified Resource ID into a ApiManagementID, returning an error
+// if the Resource ID isn't for a Api Management
+func ParseApiManagementID(input string) (*ApiManagementID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementID validates that the specified value is a Api Management ID
+func ValidateApiManagementID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementApiID is a parsed Resource ID for a Api Management Api
+type ApiManagementApiID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementApiID returns a new ApiManagementApiID from the specified components
+func NewApiManagementApiID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementApiID {
+	return ApiManagementApiID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Api
+func (id ApiManagementApiID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementApiID parses the specified Resource ID into a ApiManagementApiID, returning an error
+// if the Resource ID isn't for a Api Management Api
+func ParseApiManagementApiID(input string) (*ApiManagementApiID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Api ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementApiID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("apis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementApiID validates that the specified value is a Api Management Api ID
+func ValidateApiManagementApiID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementApiID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Api ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
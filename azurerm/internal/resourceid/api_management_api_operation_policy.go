@@ -0,0 +1,93 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementApiOperationPolicyID is a parsed Resource ID for a Api Management Api Operation Policy
+type ApiManagementApiOperationPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ApisName       string
+	OperationName  string
+	Name           string
+}
+
+// NewApiManagementApiOperationPolicyID returns a new ApiManagementApiOperationPolicyID from the specified components
+func NewApiManagementApiOperationPolicyID(subscriptionId, resourceGroup, serviceName, apisName, operationName, name string) ApiManagementApiOperationPolicyID {
+	return ApiManagementApiOperationPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ApisName:       apisName,
+		OperationName:  operationName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Api Operation Policy
+func (id ApiManagementApiOperationPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApisName, id.OperationName, id.Name)
+}
+
+// ParseApiManagementApiOperationPolicyID parses the specified Resource ID into a ApiManagementApiOperationPolicyID, returning an error
+// if the Resource ID isn't for a Api Management Api Operation Policy
+func ParseApiManagementApiOperationPolicyID(input string) (*ApiManagementApiOperationPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementApiOperationPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.ApisName, err = id.PopSegment("apis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("policies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Operation Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementApiOperationPolicyID validates that the specified value is a Api Management Api Operation Policy ID
+func ValidateApiManagementApiOperationPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementApiOperationPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Api Operation Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
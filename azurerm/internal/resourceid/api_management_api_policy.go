@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementApiPolicyID is a parsed Resource ID for a Api Management Api Policy
+type ApiManagementApiPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ApisName       string
+	Name           string
+}
+
+// NewApiManagementApiPolicyID returns a new ApiManagementApiPolicyID from the specified components
+func NewApiManagementApiPolicyID(subscriptionId, resourceGroup, serviceName, apisName, name string) ApiManagementApiPolicyID {
+	return ApiManagementApiPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ApisName:       apisName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Api Policy
+func (id ApiManagementApiPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApisName, id.Name)
+}
+
+// ParseApiManagementApiPolicyID parses the specified Resource ID into a ApiManagementApiPolicyID, returning an error
+// if the Resource ID isn't for a Api Management Api Policy
+func ParseApiManagementApiPolicyID(input string) (*ApiManagementApiPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementApiPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.ApisName, err = id.PopSegment("apis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("policies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementApiPolicyID validates that the specified value is a Api Management Api Policy ID
+func ValidateApiManagementApiPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementApiPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Api Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementApiVersionSetID is a parsed Resource ID for a Api Management Api Version Set
+type ApiManagementApiVersionSetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementApiVersionSetID returns a new ApiManagementApiVersionSetID from the specified components
+func NewApiManagementApiVersionSetID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementApiVersionSetID {
+	return ApiManagementApiVersionSetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Api Version Set
+func (id ApiManagementApiVersionSetID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/api-version-sets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementApiVersionSetID parses the specified Resource ID into a ApiManagementApiVersionSetID, returning an error
+// if the Resource ID isn't for a Api Management Api Version Set
+func ParseApiManagementApiVersionSetID(input string) (*ApiManagementApiVersionSetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Version Set ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Api Version Set ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementApiVersionSetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Version Set ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("api-version-sets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Version Set ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Api Version Set ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementApiVersionSetID validates that the specified value is a Api Management Api Version Set ID
+func ValidateApiManagementApiVersionSetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementApiVersionSetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Api Version Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementAuthorizationServerID is a parsed Resource ID for a Api Management Authorization Server
+type ApiManagementAuthorizationServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementAuthorizationServerID returns a new ApiManagementAuthorizationServerID from the specified components
+func NewApiManagementAuthorizationServerID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementAuthorizationServerID {
+	return ApiManagementAuthorizationServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Authorization Server
+func (id ApiManagementAuthorizationServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/authorizationServers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementAuthorizationServerID parses the specified Resource ID into a ApiManagementAuthorizationServerID, returning an error
+// if the Resource ID isn't for a Api Management Authorization Server
+func ParseApiManagementAuthorizationServerID(input string) (*ApiManagementAuthorizationServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Authorization Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Authorization Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementAuthorizationServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Authorization Server ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationServers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Authorization Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Authorization Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementAuthorizationServerID validates that the specified value is a Api Management Authorization Server ID
+func ValidateApiManagementAuthorizationServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementAuthorizationServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Authorization Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
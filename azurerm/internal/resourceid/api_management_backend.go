@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementBackendID is a parsed Resource ID for a Api Management Backend
+type ApiManagementBackendID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementBackendID returns a new ApiManagementBackendID from the specified components
+func NewApiManagementBackendID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementBackendID {
+	return ApiManagementBackendID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Backend
+func (id ApiManagementBackendID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/backends/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementBackendID parses the specified Resource ID into a ApiManagementBackendID, returning an error
+// if the Resource ID isn't for a Api Management Backend
+func ParseApiManagementBackendID(input string) (*ApiManagementBackendID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Backend ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Backend ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementBackendID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Backend ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backends"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Backend ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Backend ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementBackendID validates that the specified value is a Api Management Backend ID
+func ValidateApiManagementBackendID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementBackendID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Backend ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementCertificateID is a parsed Resource ID for a Api Management Certificate
+type ApiManagementCertificateID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementCertificateID returns a new ApiManagementCertificateID from the specified components
+func NewApiManagementCertificateID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementCertificateID {
+	return ApiManagementCertificateID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Certificate
+func (id ApiManagementCertificateID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/certificates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementCertificateID parses the specified Resource ID into a ApiManagementCertificateID, returning an error
+// if the Resource ID isn't for a Api Management Certificate
+func ParseApiManagementCertificateID(input string) (*ApiManagementCertificateID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Certificate ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Certificate ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementCertificateID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Certificate ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Certificate ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Certificate ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementCertificateID validates that the specified value is a Api Management Certificate ID
+func ValidateApiManagementCertificateID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementCertificateID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Certificate ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementGroupUserID is a parsed Resource ID for a Api Management Group User
+type ApiManagementGroupUserID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	GroupName      string
+	Name           string
+}
+
+// NewApiManagementGroupUserID returns a new ApiManagementGroupUserID from the specified components
+func NewApiManagementGroupUserID(subscriptionId, resourceGroup, serviceName, groupName, name string) ApiManagementGroupUserID {
+	return ApiManagementGroupUserID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		GroupName:      groupName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Group User
+func (id ApiManagementGroupUserID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/groups/%s/users/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GroupName, id.Name)
+}
+
+// ParseApiManagementGroupUserID parses the specified Resource ID into a ApiManagementGroupUserID, returning an error
+// if the Resource ID isn't for a Api Management Group User
+func ParseApiManagementGroupUserID(input string) (*ApiManagementGroupUserID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementGroupUserID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: %+v", input, err)
+	}
+
+	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("users"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Group User ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementGroupUserID validates that the specified value is a Api Management Group User ID
+func ValidateApiManagementGroupUserID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementGroupUserID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Group User ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
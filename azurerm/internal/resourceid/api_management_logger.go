@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementLoggerID is a parsed Resource ID for a Api Management Logger
+type ApiManagementLoggerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementLoggerID returns a new ApiManagementLoggerID from the specified components
+func NewApiManagementLoggerID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementLoggerID {
+	return ApiManagementLoggerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Logger
+func (id ApiManagementLoggerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/loggers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementLoggerID parses the specified Resource ID into a ApiManagementLoggerID, returning an error
+// if the Resource ID isn't for a Api Management Logger
+func ParseApiManagementLoggerID(input string) (*ApiManagementLoggerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Logger ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Logger ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementLoggerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Logger ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("loggers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Logger ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Logger ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementLoggerID validates that the specified value is a Api Management Logger ID
+func ValidateApiManagementLoggerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementLoggerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Logger ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementOpenIDConnectProviderID is a parsed Resource ID for a Api Management OpenID Connect Provider
+type ApiManagementOpenIDConnectProviderID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementOpenIDConnectProviderID returns a new ApiManagementOpenIDConnectProviderID from the specified components
+func NewApiManagementOpenIDConnectProviderID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementOpenIDConnectProviderID {
+	return ApiManagementOpenIDConnectProviderID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management OpenID Connect Provider
+func (id ApiManagementOpenIDConnectProviderID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/openidConnectProviders/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementOpenIDConnectProviderID parses the specified Resource ID into a ApiManagementOpenIDConnectProviderID, returning an error
+// if the Resource ID isn't for a Api Management OpenID Connect Provider
+func ParseApiManagementOpenIDConnectProviderID(input string) (*ApiManagementOpenIDConnectProviderID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management OpenID Connect Provider ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management OpenID Connect Provider ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementOpenIDConnectProviderID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management OpenID Connect Provider ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("openidConnectProviders"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management OpenID Connect Provider ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management OpenID Connect Provider ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementOpenIDConnectProviderID validates that the specified value is a Api Management OpenID Connect Provider ID
+func ValidateApiManagementOpenIDConnectProviderID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementOpenIDConnectProviderID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management OpenID Connect Provider ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementProductApiID is a parsed Resource ID for a Api Management Product Api
+type ApiManagementProductApiID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ProductName    string
+	Name           string
+}
+
+// NewApiManagementProductApiID returns a new ApiManagementProductApiID from the specified components
+func NewApiManagementProductApiID(subscriptionId, resourceGroup, serviceName, productName, name string) ApiManagementProductApiID {
+	return ApiManagementProductApiID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ProductName:    productName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Product Api
+func (id ApiManagementProductApiID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/apis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+}
+
+// ParseApiManagementProductApiID parses the specified Resource ID into a ApiManagementProductApiID, returning an error
+// if the Resource ID isn't for a Api Management Product Api
+func ParseApiManagementProductApiID(input string) (*ApiManagementProductApiID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementProductApiID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: %+v", input, err)
+	}
+
+	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("apis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Api ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementProductApiID validates that the specified value is a Api Management Product Api ID
+func ValidateApiManagementProductApiID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementProductApiID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Product Api ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
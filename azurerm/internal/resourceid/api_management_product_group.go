@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementProductGroupID is a parsed Resource ID for a Api Management Product Group
+type ApiManagementProductGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ProductName    string
+	Name           string
+}
+
+// NewApiManagementProductGroupID returns a new ApiManagementProductGroupID from the specified components
+func NewApiManagementProductGroupID(subscriptionId, resourceGroup, serviceName, productName, name string) ApiManagementProductGroupID {
+	return ApiManagementProductGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ProductName:    productName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Product Group
+func (id ApiManagementProductGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/groups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+}
+
+// ParseApiManagementProductGroupID parses the specified Resource ID into a ApiManagementProductGroupID, returning an error
+// if the Resource ID isn't for a Api Management Product Group
+func ParseApiManagementProductGroupID(input string) (*ApiManagementProductGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementProductGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("groups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Product Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementProductGroupID validates that the specified value is a Api Management Product Group ID
+func ValidateApiManagementProductGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementProductGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Product Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementPropertyID is a parsed Resource ID for a Api Management Property
+type ApiManagementPropertyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementPropertyID returns a new ApiManagementPropertyID from the specified components
+func NewApiManagementPropertyID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementPropertyID {
+	return ApiManagementPropertyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Property
+func (id ApiManagementPropertyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/properties/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementPropertyID parses the specified Resource ID into a ApiManagementPropertyID, returning an error
+// if the Resource ID isn't for a Api Management Property
+func ParseApiManagementPropertyID(input string) (*ApiManagementPropertyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Property ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Property ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementPropertyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Property ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("properties"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Property ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Property ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementPropertyID validates that the specified value is a Api Management Property ID
+func ValidateApiManagementPropertyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementPropertyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Property ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementSubscriptionID is a parsed Resource ID for a Api Management Subscription
+type ApiManagementSubscriptionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+// NewApiManagementSubscriptionID returns a new ApiManagementSubscriptionID from the specified components
+func NewApiManagementSubscriptionID(subscriptionId, resourceGroup, serviceName, name string) ApiManagementSubscriptionID {
+	return ApiManagementSubscriptionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Api Management Subscription
+func (id ApiManagementSubscriptionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ParseApiManagementSubscriptionID parses the specified Resource ID into a ApiManagementSubscriptionID, returning an error
+// if the Resource ID isn't for a Api Management Subscription
+func ParseApiManagementSubscriptionID(input string) (*ApiManagementSubscriptionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Subscription ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing Api Management Subscription ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementSubscriptionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Subscription ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Subscription ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Api Management Subscription ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementSubscriptionID validates that the specified value is a Api Management Subscription ID
+func ValidateApiManagementSubscriptionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApiManagementSubscriptionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Api Management Subscription ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceID is a parsed Resource ID for a App Service
+type AppServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServiceID returns a new AppServiceID from the specified components
+func NewAppServiceID(subscriptionId, resourceGroup, name string) AppServiceID {
+	return AppServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this App Service
+func (id AppServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServiceID parses the specified Resource ID into a AppServiceID, returning an error
+// if the Resource ID isn't for a App Service
+func ParseAppServiceID(input string) (*AppServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing App Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing App Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceID validates that the specified value is a App Service ID
+func ValidateAppServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAppServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid App Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceCustomHostnameBindingID is a parsed Resource ID for a App Service Custom Hostname Binding
+type AppServiceCustomHostnameBindingID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	Name           string
+}
+
+// NewAppServiceCustomHostnameBindingID returns a new AppServiceCustomHostnameBindingID from the specified components
+func NewAppServiceCustomHostnameBindingID(subscriptionId, resourceGroup, siteName, name string) AppServiceCustomHostnameBindingID {
+	return AppServiceCustomHostnameBindingID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this App Service Custom Hostname Binding
+func (id AppServiceCustomHostnameBindingID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/hostNameBindings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.Name)
+}
+
+// ParseAppServiceCustomHostnameBindingID parses the specified Resource ID into a AppServiceCustomHostnameBindingID, returning an error
+// if the Resource ID isn't for a App Service Custom Hostname Binding
+func ParseAppServiceCustomHostnameBindingID(input string) (*AppServiceCustomHostnameBindingID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Custom Hostname Binding ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing App Service Custom Hostname Binding ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceCustomHostnameBindingID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Custom Hostname Binding ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("hostNameBindings"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Custom Hostname Binding ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Custom Hostname Binding ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceCustomHostnameBindingID validates that the specified value is a App Service Custom Hostname Binding ID
+func ValidateAppServiceCustomHostnameBindingID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAppServiceCustomHostnameBindingID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid App Service Custom Hostname Binding ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
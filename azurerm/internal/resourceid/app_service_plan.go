@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServicePlanID is a parsed Resource ID for a App Service Plan
+type AppServicePlanID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServicePlanID returns a new AppServicePlanID from the specified components
+func NewAppServicePlanID(subscriptionId, resourceGroup, name string) AppServicePlanID {
+	return AppServicePlanID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this App Service Plan
+func (id AppServicePlanID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServicePlanID parses the specified Resource ID into a AppServicePlanID, returning an error
+// if the Resource ID isn't for a App Service Plan
+func ParseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Plan ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing App Service Plan ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServicePlanID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("serverfarms"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Plan ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Plan ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServicePlanID validates that the specified value is a App Service Plan ID
+func ValidateAppServicePlanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAppServicePlanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid App Service Plan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceSlotID is a parsed Resource ID for a App Service Slot
+type AppServiceSlotID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	Name           string
+}
+
+// NewAppServiceSlotID returns a new AppServiceSlotID from the specified components
+func NewAppServiceSlotID(subscriptionId, resourceGroup, siteName, name string) AppServiceSlotID {
+	return AppServiceSlotID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this App Service Slot
+func (id AppServiceSlotID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.Name)
+}
+
+// ParseAppServiceSlotID parses the specified Resource ID into a AppServiceSlotID, returning an error
+// if the Resource ID isn't for a App Service Slot
+func ParseAppServiceSlotID(input string) (*AppServiceSlotID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Slot ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing App Service Slot ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceSlotID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Slot ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("slots"); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Slot ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing App Service Slot ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceSlotID validates that the specified value is a App Service Slot ID
+func ValidateAppServiceSlotID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAppServiceSlotID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid App Service Slot ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationGatewayID is a parsed Resource ID for a Application Gateway
+type ApplicationGatewayID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationGatewayID returns a new ApplicationGatewayID from the specified components
+func NewApplicationGatewayID(subscriptionId, resourceGroup, name string) ApplicationGatewayID {
+	return ApplicationGatewayID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Gateway
+func (id ApplicationGatewayID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationGatewayID parses the specified Resource ID into a ApplicationGatewayID, returning an error
+// if the Resource ID isn't for a Application Gateway
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationGatewayID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationGatewayID validates that the specified value is a Application Gateway ID
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
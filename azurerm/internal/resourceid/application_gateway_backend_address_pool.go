@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationGatewayBackendAddressPoolID is a parsed Resource ID for a Application Gateway Backend Address Pool
+type ApplicationGatewayBackendAddressPoolID struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	Name                   string
+}
+
+// NewApplicationGatewayBackendAddressPoolID returns a new ApplicationGatewayBackendAddressPoolID from the specified components
+func NewApplicationGatewayBackendAddressPoolID(subscriptionId, resourceGroup, applicationGatewayName, name string) ApplicationGatewayBackendAddressPoolID {
+	return ApplicationGatewayBackendAddressPoolID{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		Name:                   name,
+	}
+}
+
+// String returns the Resource ID for this Application Gateway Backend Address Pool
+func (id ApplicationGatewayBackendAddressPoolID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/backendAddressPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.Name)
+}
+
+// ParseApplicationGatewayBackendAddressPoolID parses the specified Resource ID into a ApplicationGatewayBackendAddressPoolID, returning an error
+// if the Resource ID isn't for a Application Gateway Backend Address Pool
+func ParseApplicationGatewayBackendAddressPoolID(input string) (*ApplicationGatewayBackendAddressPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Application Gateway Backend Address Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationGatewayBackendAddressPoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backendAddressPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Gateway Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationGatewayBackendAddressPoolID validates that the specified value is a Application Gateway Backend Address Pool ID
+func ValidateApplicationGatewayBackendAddressPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationGatewayBackendAddressPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Gateway Backend Address Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
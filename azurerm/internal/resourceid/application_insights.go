@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationInsightsID is a parsed Resource ID for a Application Insights
+type ApplicationInsightsID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationInsightsID returns a new ApplicationInsightsID from the specified components
+func NewApplicationInsightsID(subscriptionId, resourceGroup, name string) ApplicationInsightsID {
+	return ApplicationInsightsID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Insights
+func (id ApplicationInsightsID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/components/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationInsightsID parses the specified Resource ID into a ApplicationInsightsID, returning an error
+// if the Resource ID isn't for a Application Insights
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Application Insights ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := ApplicationInsightsID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("components"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationInsightsID validates that the specified value is a Application Insights ID
+func ValidateApplicationInsightsID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationInsightsID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Insights ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationInsightsAPIKeyID is a parsed Resource ID for a Application Insights API Key
+type ApplicationInsightsAPIKeyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ComponentName  string
+	Name           string
+}
+
+// NewApplicationInsightsAPIKeyID returns a new ApplicationInsightsAPIKeyID from the specified components
+func NewApplicationInsightsAPIKeyID(subscriptionId, resourceGroup, componentName, name string) ApplicationInsightsAPIKeyID {
+	return ApplicationInsightsAPIKeyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ComponentName:  componentName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Insights API Key
+func (id ApplicationInsightsAPIKeyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/components/%s/apikeys/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.Name)
+}
+
+// ParseApplicationInsightsAPIKeyID parses the specified Resource ID into a ApplicationInsightsAPIKeyID, returning an error
+// if the Resource ID isn't for a Application Insights API Key
+func ParseApplicationInsightsAPIKeyID(input string) (*ApplicationInsightsAPIKeyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights API Key ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Application Insights API Key ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := ApplicationInsightsAPIKeyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ComponentName, err = id.PopSegment("components"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights API Key ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("apikeys"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights API Key ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights API Key ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationInsightsAPIKeyID validates that the specified value is a Application Insights API Key ID
+func ValidateApplicationInsightsAPIKeyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationInsightsAPIKeyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Insights API Key ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
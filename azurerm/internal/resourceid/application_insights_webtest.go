@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationInsightsWebtestID is a parsed Resource ID for a Application Insights Webtest
+type ApplicationInsightsWebtestID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationInsightsWebtestID returns a new ApplicationInsightsWebtestID from the specified components
+func NewApplicationInsightsWebtestID(subscriptionId, resourceGroup, name string) ApplicationInsightsWebtestID {
+	return ApplicationInsightsWebtestID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Insights Webtest
+func (id ApplicationInsightsWebtestID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/webtests/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationInsightsWebtestID parses the specified Resource ID into a ApplicationInsightsWebtestID, returning an error
+// if the Resource ID isn't for a Application Insights Webtest
+func ParseApplicationInsightsWebtestID(input string) (*ApplicationInsightsWebtestID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights Webtest ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Application Insights Webtest ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := ApplicationInsightsWebtestID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("webtests"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights Webtest ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Insights Webtest ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationInsightsWebtestID validates that the specified value is a Application Insights Webtest ID
+func ValidateApplicationInsightsWebtestID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationInsightsWebtestID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Insights Webtest ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
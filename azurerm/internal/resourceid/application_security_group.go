@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationSecurityGroupID is a parsed Resource ID for a Application Security Group
+type ApplicationSecurityGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationSecurityGroupID returns a new ApplicationSecurityGroupID from the specified components
+func NewApplicationSecurityGroupID(subscriptionId, resourceGroup, name string) ApplicationSecurityGroupID {
+	return ApplicationSecurityGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Application Security Group
+func (id ApplicationSecurityGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationSecurityGroupID parses the specified Resource ID into a ApplicationSecurityGroupID, returning an error
+// if the Resource ID isn't for a Application Security Group
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Application Security Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Application Security Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationSecurityGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Security Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Application Security Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationSecurityGroupID validates that the specified value is a Application Security Group ID
+func ValidateApplicationSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseApplicationSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Application Security Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationAccountID is a parsed Resource ID for a Automation Account
+type AutomationAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAutomationAccountID returns a new AutomationAccountID from the specified components
+func NewAutomationAccountID(subscriptionId, resourceGroup, name string) AutomationAccountID {
+	return AutomationAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Automation Account
+func (id AutomationAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAutomationAccountID parses the specified Resource ID into a AutomationAccountID, returning an error
+// if the Resource ID isn't for a Automation Account
+func ParseAutomationAccountID(input string) (*AutomationAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationAccountID validates that the specified value is a Automation Account ID
+func ValidateAutomationAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
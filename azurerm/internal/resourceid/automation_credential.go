@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationCredentialID is a parsed Resource ID for a Automation Credential
+type AutomationCredentialID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationCredentialID returns a new AutomationCredentialID from the specified components
+func NewAutomationCredentialID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationCredentialID {
+	return AutomationCredentialID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Credential
+func (id AutomationCredentialID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/credentials/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationCredentialID parses the specified Resource ID into a AutomationCredentialID, returning an error
+// if the Resource ID isn't for a Automation Credential
+func ParseAutomationCredentialID(input string) (*AutomationCredentialID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Credential ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Credential ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationCredentialID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Credential ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("credentials"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Credential ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Credential ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationCredentialID validates that the specified value is a Automation Credential ID
+func ValidateAutomationCredentialID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationCredentialID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Credential ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationDscConfigurationID is a parsed Resource ID for a Automation Dsc Configuration
+type AutomationDscConfigurationID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationDscConfigurationID returns a new AutomationDscConfigurationID from the specified components
+func NewAutomationDscConfigurationID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationDscConfigurationID {
+	return AutomationDscConfigurationID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Dsc Configuration
+func (id AutomationDscConfigurationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationDscConfigurationID parses the specified Resource ID into a AutomationDscConfigurationID, returning an error
+// if the Resource ID isn't for a Automation Dsc Configuration
+func ParseAutomationDscConfigurationID(input string) (*AutomationDscConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationDscConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationDscConfigurationID validates that the specified value is a Automation Dsc Configuration ID
+func ValidateAutomationDscConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationDscConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Dsc Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationDscNodeConfigurationID is a parsed Resource ID for a Automation Dsc Node Configuration
+type AutomationDscNodeConfigurationID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationDscNodeConfigurationID returns a new AutomationDscNodeConfigurationID from the specified components
+func NewAutomationDscNodeConfigurationID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationDscNodeConfigurationID {
+	return AutomationDscNodeConfigurationID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Dsc Node Configuration
+func (id AutomationDscNodeConfigurationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/nodeConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationDscNodeConfigurationID parses the specified Resource ID into a AutomationDscNodeConfigurationID, returning an error
+// if the Resource ID isn't for a Automation Dsc Node Configuration
+func ParseAutomationDscNodeConfigurationID(input string) (*AutomationDscNodeConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Node Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Node Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationDscNodeConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Node Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("nodeConfigurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Node Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Dsc Node Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationDscNodeConfigurationID validates that the specified value is a Automation Dsc Node Configuration ID
+func ValidateAutomationDscNodeConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationDscNodeConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Dsc Node Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationModuleID is a parsed Resource ID for a Automation Module
+type AutomationModuleID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationModuleID returns a new AutomationModuleID from the specified components
+func NewAutomationModuleID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationModuleID {
+	return AutomationModuleID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Module
+func (id AutomationModuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/modules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationModuleID parses the specified Resource ID into a AutomationModuleID, returning an error
+// if the Resource ID isn't for a Automation Module
+func ParseAutomationModuleID(input string) (*AutomationModuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Module ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Module ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationModuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Module ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("modules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Module ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Module ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationModuleID validates that the specified value is a Automation Module ID
+func ValidateAutomationModuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationModuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Module ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationRunbookID is a parsed Resource ID for a Automation Runbook
+type AutomationRunbookID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationRunbookID returns a new AutomationRunbookID from the specified components
+func NewAutomationRunbookID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationRunbookID {
+	return AutomationRunbookID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Runbook
+func (id AutomationRunbookID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/runbooks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationRunbookID parses the specified Resource ID into a AutomationRunbookID, returning an error
+// if the Resource ID isn't for a Automation Runbook
+func ParseAutomationRunbookID(input string) (*AutomationRunbookID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Runbook ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Runbook ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationRunbookID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Runbook ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("runbooks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Runbook ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Runbook ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationRunbookID validates that the specified value is a Automation Runbook ID
+func ValidateAutomationRunbookID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationRunbookID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Runbook ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
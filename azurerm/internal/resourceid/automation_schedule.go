@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationScheduleID is a parsed Resource ID for a Automation Schedule
+type AutomationScheduleID struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationScheduleID returns a new AutomationScheduleID from the specified components
+func NewAutomationScheduleID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationScheduleID {
+	return AutomationScheduleID{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// String returns the Resource ID for this Automation Schedule
+func (id AutomationScheduleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/schedules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationScheduleID parses the specified Resource ID into a AutomationScheduleID, returning an error
+// if the Resource ID isn't for a Automation Schedule
+func ParseAutomationScheduleID(input string) (*AutomationScheduleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Schedule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing Automation Schedule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationScheduleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Schedule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("schedules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Schedule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Automation Schedule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationScheduleID validates that the specified value is a Automation Schedule ID
+func ValidateAutomationScheduleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAutomationScheduleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Automation Schedule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
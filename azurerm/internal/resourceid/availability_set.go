@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AvailabilitySetID is a parsed Resource ID for a Availability Set
+type AvailabilitySetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAvailabilitySetID returns a new AvailabilitySetID from the specified components
+func NewAvailabilitySetID(subscriptionId, resourceGroup, name string) AvailabilitySetID {
+	return AvailabilitySetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Availability Set
+func (id AvailabilitySetID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAvailabilitySetID parses the specified Resource ID into a AvailabilitySetID, returning an error
+// if the Resource ID isn't for a Availability Set
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Availability Set ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Availability Set ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := AvailabilitySetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("availabilitySets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Availability Set ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Availability Set ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAvailabilitySetID validates that the specified value is a Availability Set ID
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAvailabilitySetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Availability Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
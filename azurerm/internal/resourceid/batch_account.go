@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BatchAccountID is a parsed Resource ID for a Batch Account
+type BatchAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewBatchAccountID returns a new BatchAccountID from the specified components
+func NewBatchAccountID(subscriptionId, resourceGroup, name string) BatchAccountID {
+	return BatchAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Batch Account
+func (id BatchAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseBatchAccountID parses the specified Resource ID into a BatchAccountID, returning an error
+// if the Resource ID isn't for a Batch Account
+func ParseBatchAccountID(input string) (*BatchAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Batch") {
+		return nil, fmt.Errorf("Error parsing Batch Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Batch", id.Provider)
+	}
+
+	resourceId := BatchAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("batchAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBatchAccountID validates that the specified value is a Batch Account ID
+func ValidateBatchAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseBatchAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Batch Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BatchApplicationID is a parsed Resource ID for a Batch Application
+type BatchApplicationID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	BatchAccountName string
+	Name             string
+}
+
+// NewBatchApplicationID returns a new BatchApplicationID from the specified components
+func NewBatchApplicationID(subscriptionId, resourceGroup, batchAccountName, name string) BatchApplicationID {
+	return BatchApplicationID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		BatchAccountName: batchAccountName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Batch Application
+func (id BatchApplicationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/applications/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
+}
+
+// ParseBatchApplicationID parses the specified Resource ID into a BatchApplicationID, returning an error
+// if the Resource ID isn't for a Batch Application
+func ParseBatchApplicationID(input string) (*BatchApplicationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Application ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Batch") {
+		return nil, fmt.Errorf("Error parsing Batch Application ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Batch", id.Provider)
+	}
+
+	resourceId := BatchApplicationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Application ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("applications"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Application ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Application ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBatchApplicationID validates that the specified value is a Batch Application ID
+func ValidateBatchApplicationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseBatchApplicationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Batch Application ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BatchCertificateID is a parsed Resource ID for a Batch Certificate
+type BatchCertificateID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	BatchAccountName string
+	Name             string
+}
+
+// NewBatchCertificateID returns a new BatchCertificateID from the specified components
+func NewBatchCertificateID(subscriptionId, resourceGroup, batchAccountName, name string) BatchCertificateID {
+	return BatchCertificateID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		BatchAccountName: batchAccountName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Batch Certificate
+func (id BatchCertificateID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/certificates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
+}
+
+// ParseBatchCertificateID parses the specified Resource ID into a BatchCertificateID, returning an error
+// if the Resource ID isn't for a Batch Certificate
+func ParseBatchCertificateID(input string) (*BatchCertificateID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Certificate ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Batch") {
+		return nil, fmt.Errorf("Error parsing Batch Certificate ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Batch", id.Provider)
+	}
+
+	resourceId := BatchCertificateID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Certificate ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Certificate ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Certificate ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBatchCertificateID validates that the specified value is a Batch Certificate ID
+func ValidateBatchCertificateID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseBatchCertificateID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Batch Certificate ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
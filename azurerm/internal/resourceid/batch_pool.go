@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// BatchPoolID is a parsed Resource ID for a Batch Pool
+type BatchPoolID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	BatchAccountName string
+	Name             string
+}
+
+// NewBatchPoolID returns a new BatchPoolID from the specified components
+func NewBatchPoolID(subscriptionId, resourceGroup, batchAccountName, name string) BatchPoolID {
+	return BatchPoolID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		BatchAccountName: batchAccountName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Batch Pool
+func (id BatchPoolID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/pools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
+}
+
+// ParseBatchPoolID parses the specified Resource ID into a BatchPoolID, returning an error
+// if the Resource ID isn't for a Batch Pool
+func ParseBatchPoolID(input string) (*BatchPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Batch") {
+		return nil, fmt.Errorf("Error parsing Batch Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Batch", id.Provider)
+	}
+
+	resourceId := BatchPoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("pools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Batch Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateBatchPoolID validates that the specified value is a Batch Pool ID
+func ValidateBatchPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseBatchPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Batch Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
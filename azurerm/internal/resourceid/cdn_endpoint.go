@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CdnEndpointID is a parsed Resource ID for a Cdn Endpoint
+type CdnEndpointID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProfileName    string
+	Name           string
+}
+
+// NewCdnEndpointID returns a new CdnEndpointID from the specified components
+func NewCdnEndpointID(subscriptionId, resourceGroup, profileName, name string) CdnEndpointID {
+	return CdnEndpointID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Cdn Endpoint
+func (id CdnEndpointID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/endpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
+}
+
+// ParseCdnEndpointID parses the specified Resource ID into a CdnEndpointID, returning an error
+// if the Resource ID isn't for a Cdn Endpoint
+func ParseCdnEndpointID(input string) (*CdnEndpointID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Endpoint ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cdn") {
+		return nil, fmt.Errorf("Error parsing Cdn Endpoint ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Cdn", id.Provider)
+	}
+
+	resourceId := CdnEndpointID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Endpoint ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("endpoints"); err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Endpoint ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Endpoint ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCdnEndpointID validates that the specified value is a Cdn Endpoint ID
+func ValidateCdnEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseCdnEndpointID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Cdn Endpoint ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CdnProfileID is a parsed Resource ID for a Cdn Profile
+type CdnProfileID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCdnProfileID returns a new CdnProfileID from the specified components
+func NewCdnProfileID(subscriptionId, resourceGroup, name string) CdnProfileID {
+	return CdnProfileID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Cdn Profile
+func (id CdnProfileID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseCdnProfileID parses the specified Resource ID into a CdnProfileID, returning an error
+// if the Resource ID isn't for a Cdn Profile
+func ParseCdnProfileID(input string) (*CdnProfileID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Profile ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cdn") {
+		return nil, fmt.Errorf("Error parsing Cdn Profile ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Cdn", id.Provider)
+	}
+
+	resourceId := CdnProfileID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("profiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Profile ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Cdn Profile ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCdnProfileID validates that the specified value is a Cdn Profile ID
+func ValidateCdnProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseCdnProfileID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Cdn Profile ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CognitiveAccountID is a parsed Resource ID for a Cognitive Account
+type CognitiveAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCognitiveAccountID returns a new CognitiveAccountID from the specified components
+func NewCognitiveAccountID(subscriptionId, resourceGroup, name string) CognitiveAccountID {
+	return CognitiveAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Cognitive Account
+func (id CognitiveAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CognitiveServices/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseCognitiveAccountID parses the specified Resource ID into a CognitiveAccountID, returning an error
+// if the Resource ID isn't for a Cognitive Account
+func ParseCognitiveAccountID(input string) (*CognitiveAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Cognitive Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.CognitiveServices") {
+		return nil, fmt.Errorf("Error parsing Cognitive Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.CognitiveServices", id.Provider)
+	}
+
+	resourceId := CognitiveAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Cognitive Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Cognitive Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCognitiveAccountID validates that the specified value is a Cognitive Account ID
+func ValidateCognitiveAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseCognitiveAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Cognitive Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ContainerGroupID is a parsed Resource ID for a Container Group
+type ContainerGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerGroupID returns a new ContainerGroupID from the specified components
+func NewContainerGroupID(subscriptionId, resourceGroup, name string) ContainerGroupID {
+	return ContainerGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Container Group
+func (id ContainerGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerInstance/containerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerGroupID parses the specified Resource ID into a ContainerGroupID, returning an error
+// if the Resource ID isn't for a Container Group
+func ParseContainerGroupID(input string) (*ContainerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Container Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerInstance") {
+		return nil, fmt.Errorf("Error parsing Container Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ContainerInstance", id.Provider)
+	}
+
+	resourceId := ContainerGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("containerGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerGroupID validates that the specified value is a Container Group ID
+func ValidateContainerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseContainerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Container Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
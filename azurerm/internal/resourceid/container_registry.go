@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ContainerRegistryID is a parsed Resource ID for a Container Registry
+type ContainerRegistryID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerRegistryID returns a new ContainerRegistryID from the specified components
+func NewContainerRegistryID(subscriptionId, resourceGroup, name string) ContainerRegistryID {
+	return ContainerRegistryID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Container Registry
+func (id ContainerRegistryID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerRegistryID parses the specified Resource ID into a ContainerRegistryID, returning an error
+// if the Resource ID isn't for a Container Registry
+func ParseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Container Registry ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerRegistry") {
+		return nil, fmt.Errorf("Error parsing Container Registry ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ContainerRegistry", id.Provider)
+	}
+
+	resourceId := ContainerRegistryID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("registries"); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Registry ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Registry ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerRegistryID validates that the specified value is a Container Registry ID
+func ValidateContainerRegistryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseContainerRegistryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Container Registry ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ContainerServiceID is a parsed Resource ID for a Container Service
+type ContainerServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerServiceID returns a new ContainerServiceID from the specified components
+func NewContainerServiceID(subscriptionId, resourceGroup, name string) ContainerServiceID {
+	return ContainerServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Container Service
+func (id ContainerServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/containerServices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerServiceID parses the specified Resource ID into a ContainerServiceID, returning an error
+// if the Resource ID isn't for a Container Service
+func ParseContainerServiceID(input string) (*ContainerServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Container Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("Error parsing Container Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := ContainerServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("containerServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Container Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerServiceID validates that the specified value is a Container Service ID
+func ValidateContainerServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseContainerServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Container Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
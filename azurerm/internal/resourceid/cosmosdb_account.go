@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CosmosDBAccountID is a parsed Resource ID for a CosmosDB Account
+type CosmosDBAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCosmosDBAccountID returns a new CosmosDBAccountID from the specified components
+func NewCosmosDBAccountID(subscriptionId, resourceGroup, name string) CosmosDBAccountID {
+	return CosmosDBAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this CosmosDB Account
+func (id CosmosDBAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseCosmosDBAccountID parses the specified Resource ID into a CosmosDBAccountID, returning an error
+// if the Resource ID isn't for a CosmosDB Account
+func ParseCosmosDBAccountID(input string) (*CosmosDBAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing CosmosDB Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DocumentDB") {
+		return nil, fmt.Errorf("Error parsing CosmosDB Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DocumentDB", id.Provider)
+	}
+
+	resourceId := CosmosDBAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing CosmosDB Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing CosmosDB Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCosmosDBAccountID validates that the specified value is a CosmosDB Account ID
+func ValidateCosmosDBAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseCosmosDBAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid CosmosDB Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
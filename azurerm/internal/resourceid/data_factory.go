@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataFactoryID is a parsed Resource ID for a Data Factory
+type DataFactoryID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDataFactoryID returns a new DataFactoryID from the specified components
+func NewDataFactoryID(subscriptionId, resourceGroup, name string) DataFactoryID {
+	return DataFactoryID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Factory
+func (id DataFactoryID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDataFactoryID parses the specified Resource ID into a DataFactoryID, returning an error
+// if the Resource ID isn't for a Data Factory
+func ParseDataFactoryID(input string) (*DataFactoryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataFactory") {
+		return nil, fmt.Errorf("Error parsing Data Factory ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataFactory", id.Provider)
+	}
+
+	resourceId := DataFactoryID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("factories"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataFactoryID validates that the specified value is a Data Factory ID
+func ValidateDataFactoryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataFactoryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Factory ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataFactoryDatasetID is a parsed Resource ID for a Data Factory Dataset
+type DataFactoryDatasetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+// NewDataFactoryDatasetID returns a new DataFactoryDatasetID from the specified components
+func NewDataFactoryDatasetID(subscriptionId, resourceGroup, factoryName, name string) DataFactoryDatasetID {
+	return DataFactoryDatasetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Factory Dataset
+func (id DataFactoryDatasetID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/datasets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// ParseDataFactoryDatasetID parses the specified Resource ID into a DataFactoryDatasetID, returning an error
+// if the Resource ID isn't for a Data Factory Dataset
+func ParseDataFactoryDatasetID(input string) (*DataFactoryDatasetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Dataset ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataFactory") {
+		return nil, fmt.Errorf("Error parsing Data Factory Dataset ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataFactory", id.Provider)
+	}
+
+	resourceId := DataFactoryDatasetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Dataset ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("datasets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Dataset ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Dataset ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataFactoryDatasetID validates that the specified value is a Data Factory Dataset ID
+func ValidateDataFactoryDatasetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataFactoryDatasetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Factory Dataset ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataFactoryLinkedServiceID is a parsed Resource ID for a Data Factory Linked Service
+type DataFactoryLinkedServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+// NewDataFactoryLinkedServiceID returns a new DataFactoryLinkedServiceID from the specified components
+func NewDataFactoryLinkedServiceID(subscriptionId, resourceGroup, factoryName, name string) DataFactoryLinkedServiceID {
+	return DataFactoryLinkedServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Factory Linked Service
+func (id DataFactoryLinkedServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/linkedservices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// ParseDataFactoryLinkedServiceID parses the specified Resource ID into a DataFactoryLinkedServiceID, returning an error
+// if the Resource ID isn't for a Data Factory Linked Service
+func ParseDataFactoryLinkedServiceID(input string) (*DataFactoryLinkedServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Linked Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataFactory") {
+		return nil, fmt.Errorf("Error parsing Data Factory Linked Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataFactory", id.Provider)
+	}
+
+	resourceId := DataFactoryLinkedServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Linked Service ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("linkedservices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Linked Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Linked Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataFactoryLinkedServiceID validates that the specified value is a Data Factory Linked Service ID
+func ValidateDataFactoryLinkedServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataFactoryLinkedServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Factory Linked Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
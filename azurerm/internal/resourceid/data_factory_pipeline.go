@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataFactoryPipelineID is a parsed Resource ID for a Data Factory Pipeline
+type DataFactoryPipelineID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+// NewDataFactoryPipelineID returns a new DataFactoryPipelineID from the specified components
+func NewDataFactoryPipelineID(subscriptionId, resourceGroup, factoryName, name string) DataFactoryPipelineID {
+	return DataFactoryPipelineID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Factory Pipeline
+func (id DataFactoryPipelineID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/pipelines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// ParseDataFactoryPipelineID parses the specified Resource ID into a DataFactoryPipelineID, returning an error
+// if the Resource ID isn't for a Data Factory Pipeline
+func ParseDataFactoryPipelineID(input string) (*DataFactoryPipelineID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Pipeline ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataFactory") {
+		return nil, fmt.Errorf("Error parsing Data Factory Pipeline ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataFactory", id.Provider)
+	}
+
+	resourceId := DataFactoryPipelineID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Pipeline ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("pipelines"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Pipeline ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Factory Pipeline ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataFactoryPipelineID validates that the specified value is a Data Factory Pipeline ID
+func ValidateDataFactoryPipelineID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataFactoryPipelineID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Factory Pipeline ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
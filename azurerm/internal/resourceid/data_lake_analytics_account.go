@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeAnalyticsAccountID is a parsed Resource ID for a Data Lake Analytics Account
+type DataLakeAnalyticsAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDataLakeAnalyticsAccountID returns a new DataLakeAnalyticsAccountID from the specified components
+func NewDataLakeAnalyticsAccountID(subscriptionId, resourceGroup, name string) DataLakeAnalyticsAccountID {
+	return DataLakeAnalyticsAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Lake Analytics Account
+func (id DataLakeAnalyticsAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDataLakeAnalyticsAccountID parses the specified Resource ID into a DataLakeAnalyticsAccountID, returning an error
+// if the Resource ID isn't for a Data Lake Analytics Account
+func ParseDataLakeAnalyticsAccountID(input string) (*DataLakeAnalyticsAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeAnalytics") {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataLakeAnalytics", id.Provider)
+	}
+
+	resourceId := DataLakeAnalyticsAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeAnalyticsAccountID validates that the specified value is a Data Lake Analytics Account ID
+func ValidateDataLakeAnalyticsAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataLakeAnalyticsAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Lake Analytics Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeAnalyticsFirewallRuleID is a parsed Resource ID for a Data Lake Analytics Firewall Rule
+type DataLakeAnalyticsFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewDataLakeAnalyticsFirewallRuleID returns a new DataLakeAnalyticsFirewallRuleID from the specified components
+func NewDataLakeAnalyticsFirewallRuleID(subscriptionId, resourceGroup, accountName, name string) DataLakeAnalyticsFirewallRuleID {
+	return DataLakeAnalyticsFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Lake Analytics Firewall Rule
+func (id DataLakeAnalyticsFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseDataLakeAnalyticsFirewallRuleID parses the specified Resource ID into a DataLakeAnalyticsFirewallRuleID, returning an error
+// if the Resource ID isn't for a Data Lake Analytics Firewall Rule
+func ParseDataLakeAnalyticsFirewallRuleID(input string) (*DataLakeAnalyticsFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeAnalytics") {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataLakeAnalytics", id.Provider)
+	}
+
+	resourceId := DataLakeAnalyticsFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Analytics Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeAnalyticsFirewallRuleID validates that the specified value is a Data Lake Analytics Firewall Rule ID
+func ValidateDataLakeAnalyticsFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataLakeAnalyticsFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Lake Analytics Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeStoreID is a parsed Resource ID for a Data Lake Store
+type DataLakeStoreID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDataLakeStoreID returns a new DataLakeStoreID from the specified components
+func NewDataLakeStoreID(subscriptionId, resourceGroup, name string) DataLakeStoreID {
+	return DataLakeStoreID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Lake Store
+func (id DataLakeStoreID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDataLakeStoreID parses the specified Resource ID into a DataLakeStoreID, returning an error
+// if the Resource ID isn't for a Data Lake Store
+func ParseDataLakeStoreID(input string) (*DataLakeStoreID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeStore") {
+		return nil, fmt.Errorf("Error parsing Data Lake Store ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataLakeStore", id.Provider)
+	}
+
+	resourceId := DataLakeStoreID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeStoreID validates that the specified value is a Data Lake Store ID
+func ValidateDataLakeStoreID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataLakeStoreID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Lake Store ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeStoreFirewallRuleID is a parsed Resource ID for a Data Lake Store Firewall Rule
+type DataLakeStoreFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewDataLakeStoreFirewallRuleID returns a new DataLakeStoreFirewallRuleID from the specified components
+func NewDataLakeStoreFirewallRuleID(subscriptionId, resourceGroup, accountName, name string) DataLakeStoreFirewallRuleID {
+	return DataLakeStoreFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Data Lake Store Firewall Rule
+func (id DataLakeStoreFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseDataLakeStoreFirewallRuleID parses the specified Resource ID into a DataLakeStoreFirewallRuleID, returning an error
+// if the Resource ID isn't for a Data Lake Store Firewall Rule
+func ParseDataLakeStoreFirewallRuleID(input string) (*DataLakeStoreFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeStore") {
+		return nil, fmt.Errorf("Error parsing Data Lake Store Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DataLakeStore", id.Provider)
+	}
+
+	resourceId := DataLakeStoreFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Data Lake Store Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeStoreFirewallRuleID validates that the specified value is a Data Lake Store Firewall Rule ID
+func ValidateDataLakeStoreFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDataLakeStoreFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Data Lake Store Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DatabricksWorkspaceID is a parsed Resource ID for a Databricks Workspace
+type DatabricksWorkspaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDatabricksWorkspaceID returns a new DatabricksWorkspaceID from the specified components
+func NewDatabricksWorkspaceID(subscriptionId, resourceGroup, name string) DatabricksWorkspaceID {
+	return DatabricksWorkspaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Databricks Workspace
+func (id DatabricksWorkspaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Databricks/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDatabricksWorkspaceID parses the specified Resource ID into a DatabricksWorkspaceID, returning an error
+// if the Resource ID isn't for a Databricks Workspace
+func ParseDatabricksWorkspaceID(input string) (*DatabricksWorkspaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Databricks Workspace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Databricks") {
+		return nil, fmt.Errorf("Error parsing Databricks Workspace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Databricks", id.Provider)
+	}
+
+	resourceId := DatabricksWorkspaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Databricks Workspace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Databricks Workspace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDatabricksWorkspaceID validates that the specified value is a Databricks Workspace ID
+func ValidateDatabricksWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDatabricksWorkspaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Databricks Workspace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
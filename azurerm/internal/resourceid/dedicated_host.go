@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DedicatedHostID is a parsed Resource ID for a Dedicated Host
+type DedicatedHostID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	HostGroupName  string
+	Name           string
+}
+
+// NewDedicatedHostID returns a new DedicatedHostID from the specified components
+func NewDedicatedHostID(subscriptionId, resourceGroup, hostGroupName, name string) DedicatedHostID {
+	return DedicatedHostID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		HostGroupName:  hostGroupName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dedicated Host
+func (id DedicatedHostID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s/hosts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostGroupName, id.Name)
+}
+
+// ParseDedicatedHostID parses the specified Resource ID into a DedicatedHostID, returning an error
+// if the Resource ID isn't for a Dedicated Host
+func ParseDedicatedHostID(input string) (*DedicatedHostID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := DedicatedHostID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("hosts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDedicatedHostID validates that the specified value is a Dedicated Host ID
+func ValidateDedicatedHostID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDedicatedHostID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dedicated Host ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
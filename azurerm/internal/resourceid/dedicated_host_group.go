@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DedicatedHostGroupID is a parsed Resource ID for a Dedicated Host Group
+type DedicatedHostGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDedicatedHostGroupID returns a new DedicatedHostGroupID from the specified components
+func NewDedicatedHostGroupID(subscriptionId, resourceGroup, name string) DedicatedHostGroupID {
+	return DedicatedHostGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dedicated Host Group
+func (id DedicatedHostGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDedicatedHostGroupID parses the specified Resource ID into a DedicatedHostGroupID, returning an error
+// if the Resource ID isn't for a Dedicated Host Group
+func ParseDedicatedHostGroupID(input string) (*DedicatedHostGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := DedicatedHostGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("hostGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dedicated Host Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDedicatedHostGroupID validates that the specified value is a Dedicated Host Group ID
+func ValidateDedicatedHostGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDedicatedHostGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dedicated Host Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevSpaceControllerID is a parsed Resource ID for a Dev Space Controller
+type DevSpaceControllerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDevSpaceControllerID returns a new DevSpaceControllerID from the specified components
+func NewDevSpaceControllerID(subscriptionId, resourceGroup, name string) DevSpaceControllerID {
+	return DevSpaceControllerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Space Controller
+func (id DevSpaceControllerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevSpaces/controllers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDevSpaceControllerID parses the specified Resource ID into a DevSpaceControllerID, returning an error
+// if the Resource ID isn't for a Dev Space Controller
+func ParseDevSpaceControllerID(input string) (*DevSpaceControllerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Space Controller ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevSpaces") {
+		return nil, fmt.Errorf("Error parsing Dev Space Controller ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevSpaces", id.Provider)
+	}
+
+	resourceId := DevSpaceControllerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("controllers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Space Controller ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Space Controller ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevSpaceControllerID validates that the specified value is a Dev Space Controller ID
+func ValidateDevSpaceControllerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevSpaceControllerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Space Controller ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestLabID is a parsed Resource ID for a Dev Test Lab
+type DevTestLabID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDevTestLabID returns a new DevTestLabID from the specified components
+func NewDevTestLabID(subscriptionId, resourceGroup, name string) DevTestLabID {
+	return DevTestLabID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Test Lab
+func (id DevTestLabID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDevTestLabID parses the specified Resource ID into a DevTestLabID, returning an error
+// if the Resource ID isn't for a Dev Test Lab
+func ParseDevTestLabID(input string) (*DevTestLabID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Lab ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing Dev Test Lab ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestLabID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Lab ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Lab ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestLabID validates that the specified value is a Dev Test Lab ID
+func ValidateDevTestLabID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevTestLabID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Test Lab ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestPolicyID is a parsed Resource ID for a Dev Test Policy
+type DevTestPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	PolicysetName  string
+	Name           string
+}
+
+// NewDevTestPolicyID returns a new DevTestPolicyID from the specified components
+func NewDevTestPolicyID(subscriptionId, resourceGroup, labName, policysetName, name string) DevTestPolicyID {
+	return DevTestPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		PolicysetName:  policysetName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Test Policy
+func (id DevTestPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/policysets/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LabName, id.PolicysetName, id.Name)
+}
+
+// ParseDevTestPolicyID parses the specified Resource ID into a DevTestPolicyID, returning an error
+// if the Resource ID isn't for a Dev Test Policy
+func ParseDevTestPolicyID(input string) (*DevTestPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.PolicysetName, err = id.PopSegment("policysets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("policies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestPolicyID validates that the specified value is a Dev Test Policy ID
+func ValidateDevTestPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevTestPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Test Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestScheduleID is a parsed Resource ID for a Dev Test Schedule
+type DevTestScheduleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+// NewDevTestScheduleID returns a new DevTestScheduleID from the specified components
+func NewDevTestScheduleID(subscriptionId, resourceGroup, labName, name string) DevTestScheduleID {
+	return DevTestScheduleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Test Schedule
+func (id DevTestScheduleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/schedules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ParseDevTestScheduleID parses the specified Resource ID into a DevTestScheduleID, returning an error
+// if the Resource ID isn't for a Dev Test Schedule
+func ParseDevTestScheduleID(input string) (*DevTestScheduleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Schedule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing Dev Test Schedule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestScheduleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Schedule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("schedules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Schedule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Schedule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestScheduleID validates that the specified value is a Dev Test Schedule ID
+func ValidateDevTestScheduleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevTestScheduleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Test Schedule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestVirtualMachineID is a parsed Resource ID for a Dev Test Virtual Machine
+type DevTestVirtualMachineID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+// NewDevTestVirtualMachineID returns a new DevTestVirtualMachineID from the specified components
+func NewDevTestVirtualMachineID(subscriptionId, resourceGroup, labName, name string) DevTestVirtualMachineID {
+	return DevTestVirtualMachineID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Test Virtual Machine
+func (id DevTestVirtualMachineID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualmachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ParseDevTestVirtualMachineID parses the specified Resource ID into a DevTestVirtualMachineID, returning an error
+// if the Resource ID isn't for a Dev Test Virtual Machine
+func ParseDevTestVirtualMachineID(input string) (*DevTestVirtualMachineID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Machine ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Machine ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestVirtualMachineID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Machine ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualmachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Machine ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Machine ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestVirtualMachineID validates that the specified value is a Dev Test Virtual Machine ID
+func ValidateDevTestVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevTestVirtualMachineID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Test Virtual Machine ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestVirtualNetworkID is a parsed Resource ID for a Dev Test Virtual Network
+type DevTestVirtualNetworkID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+// NewDevTestVirtualNetworkID returns a new DevTestVirtualNetworkID from the specified components
+func NewDevTestVirtualNetworkID(subscriptionId, resourceGroup, labName, name string) DevTestVirtualNetworkID {
+	return DevTestVirtualNetworkID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dev Test Virtual Network
+func (id DevTestVirtualNetworkID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualnetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ParseDevTestVirtualNetworkID parses the specified Resource ID into a DevTestVirtualNetworkID, returning an error
+// if the Resource ID isn't for a Dev Test Virtual Network
+func ParseDevTestVirtualNetworkID(input string) (*DevTestVirtualNetworkID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Network ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Network ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestVirtualNetworkID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Network ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualnetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Network ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dev Test Virtual Network ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestVirtualNetworkID validates that the specified value is a Dev Test Virtual Network ID
+func ValidateDevTestVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDevTestVirtualNetworkID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dev Test Virtual Network ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DiskEncryptionSetID is a parsed Resource ID for a Disk Encryption Set
+type DiskEncryptionSetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDiskEncryptionSetID returns a new DiskEncryptionSetID from the specified components
+func NewDiskEncryptionSetID(subscriptionId, resourceGroup, name string) DiskEncryptionSetID {
+	return DiskEncryptionSetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Disk Encryption Set
+func (id DiskEncryptionSetID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/diskEncryptionSets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDiskEncryptionSetID parses the specified Resource ID into a DiskEncryptionSetID, returning an error
+// if the Resource ID isn't for a Disk Encryption Set
+func ParseDiskEncryptionSetID(input string) (*DiskEncryptionSetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Disk Encryption Set ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Disk Encryption Set ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := DiskEncryptionSetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("diskEncryptionSets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Disk Encryption Set ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Disk Encryption Set ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDiskEncryptionSetID validates that the specified value is a Disk Encryption Set ID
+func ValidateDiskEncryptionSetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDiskEncryptionSetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Disk Encryption Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
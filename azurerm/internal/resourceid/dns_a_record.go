@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsARecordID is a parsed Resource ID for a Dns A Record
+type DnsARecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsARecordID returns a new DnsARecordID from the specified components
+func NewDnsARecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsARecordID {
+	return DnsARecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns A Record
+func (id DnsARecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/A/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsARecordID parses the specified Resource ID into a DnsARecordID, returning an error
+// if the Resource ID isn't for a Dns A Record
+func ParseDnsARecordID(input string) (*DnsARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns A Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns A Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsARecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns A Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("A"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns A Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns A Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsARecordID validates that the specified value is a Dns A Record ID
+func ValidateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns A Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
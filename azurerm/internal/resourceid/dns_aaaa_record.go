@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsAAAARecordID is a parsed Resource ID for a Dns AAAA Record
+type DnsAAAARecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsAAAARecordID returns a new DnsAAAARecordID from the specified components
+func NewDnsAAAARecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsAAAARecordID {
+	return DnsAAAARecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns AAAA Record
+func (id DnsAAAARecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/AAAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsAAAARecordID parses the specified Resource ID into a DnsAAAARecordID, returning an error
+// if the Resource ID isn't for a Dns AAAA Record
+func ParseDnsAAAARecordID(input string) (*DnsAAAARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns AAAA Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns AAAA Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsAAAARecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns AAAA Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AAAA"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns AAAA Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns AAAA Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsAAAARecordID validates that the specified value is a Dns AAAA Record ID
+func ValidateDnsAAAARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsAAAARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns AAAA Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsCAARecordID is a parsed Resource ID for a Dns CAA Record
+type DnsCAARecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsCAARecordID returns a new DnsCAARecordID from the specified components
+func NewDnsCAARecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsCAARecordID {
+	return DnsCAARecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns CAA Record
+func (id DnsCAARecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsCAARecordID parses the specified Resource ID into a DnsCAARecordID, returning an error
+// if the Resource ID isn't for a Dns CAA Record
+func ParseDnsCAARecordID(input string) (*DnsCAARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns CAA Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns CAA Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsCAARecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns CAA Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("CAA"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns CAA Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns CAA Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsCAARecordID validates that the specified value is a Dns CAA Record ID
+func ValidateDnsCAARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsCAARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns CAA Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsCnameRecordID is a parsed Resource ID for a Dns Cname Record
+type DnsCnameRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsCnameRecordID returns a new DnsCnameRecordID from the specified components
+func NewDnsCnameRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsCnameRecordID {
+	return DnsCnameRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns Cname Record
+func (id DnsCnameRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CNAME/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsCnameRecordID parses the specified Resource ID into a DnsCnameRecordID, returning an error
+// if the Resource ID isn't for a Dns Cname Record
+func ParseDnsCnameRecordID(input string) (*DnsCnameRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns Cname Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns Cname Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsCnameRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns Cname Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("CNAME"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns Cname Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns Cname Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsCnameRecordID validates that the specified value is a Dns Cname Record ID
+func ValidateDnsCnameRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsCnameRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns Cname Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsMXRecordID is a parsed Resource ID for a Dns MX Record
+type DnsMXRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsMXRecordID returns a new DnsMXRecordID from the specified components
+func NewDnsMXRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsMXRecordID {
+	return DnsMXRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns MX Record
+func (id DnsMXRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/MX/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsMXRecordID parses the specified Resource ID into a DnsMXRecordID, returning an error
+// if the Resource ID isn't for a Dns MX Record
+func ParseDnsMXRecordID(input string) (*DnsMXRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns MX Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns MX Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsMXRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns MX Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("MX"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns MX Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns MX Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsMXRecordID validates that the specified value is a Dns MX Record ID
+func ValidateDnsMXRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsMXRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns MX Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
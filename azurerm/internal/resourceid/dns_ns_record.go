@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsNSRecordID is a parsed Resource ID for a Dns NS Record
+type DnsNSRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsNSRecordID returns a new DnsNSRecordID from the specified components
+func NewDnsNSRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsNSRecordID {
+	return DnsNSRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns NS Record
+func (id DnsNSRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/NS/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsNSRecordID parses the specified Resource ID into a DnsNSRecordID, returning an error
+// if the Resource ID isn't for a Dns NS Record
+func ParseDnsNSRecordID(input string) (*DnsNSRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns NS Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns NS Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsNSRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns NS Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("NS"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns NS Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns NS Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsNSRecordID validates that the specified value is a Dns NS Record ID
+func ValidateDnsNSRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsNSRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns NS Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
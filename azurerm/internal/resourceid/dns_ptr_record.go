@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsPTRRecordID is a parsed Resource ID for a Dns PTR Record
+type DnsPTRRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsPTRRecordID returns a new DnsPTRRecordID from the specified components
+func NewDnsPTRRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsPTRRecordID {
+	return DnsPTRRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns PTR Record
+func (id DnsPTRRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/PTR/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsPTRRecordID parses the specified Resource ID into a DnsPTRRecordID, returning an error
+// if the Resource ID isn't for a Dns PTR Record
+func ParseDnsPTRRecordID(input string) (*DnsPTRRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns PTR Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns PTR Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsPTRRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns PTR Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("PTR"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns PTR Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns PTR Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsPTRRecordID validates that the specified value is a Dns PTR Record ID
+func ValidateDnsPTRRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsPTRRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns PTR Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsSRVRecordID is a parsed Resource ID for a Dns SRV Record
+type DnsSRVRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsSRVRecordID returns a new DnsSRVRecordID from the specified components
+func NewDnsSRVRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsSRVRecordID {
+	return DnsSRVRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns SRV Record
+func (id DnsSRVRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/SRV/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsSRVRecordID parses the specified Resource ID into a DnsSRVRecordID, returning an error
+// if the Resource ID isn't for a Dns SRV Record
+func ParseDnsSRVRecordID(input string) (*DnsSRVRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns SRV Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns SRV Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsSRVRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns SRV Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("SRV"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns SRV Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns SRV Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsSRVRecordID validates that the specified value is a Dns SRV Record ID
+func ValidateDnsSRVRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsSRVRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns SRV Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
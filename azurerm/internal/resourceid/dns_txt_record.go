@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsTXTRecordID is a parsed Resource ID for a Dns TXT Record
+type DnsTXTRecordID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	Name           string
+}
+
+// NewDnsTXTRecordID returns a new DnsTXTRecordID from the specified components
+func NewDnsTXTRecordID(subscriptionId, resourceGroup, dnszoneName, name string) DnsTXTRecordID {
+	return DnsTXTRecordID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Dns TXT Record
+func (id DnsTXTRecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/TXT/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.Name)
+}
+
+// ParseDnsTXTRecordID parses the specified Resource ID into a DnsTXTRecordID, returning an error
+// if the Resource ID isn't for a Dns TXT Record
+func ParseDnsTXTRecordID(input string) (*DnsTXTRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Dns TXT Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Dns TXT Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsTXTRecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns TXT Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("TXT"); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns TXT Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Dns TXT Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsTXTRecordID validates that the specified value is a Dns TXT Record ID
+func ValidateDnsTXTRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseDnsTXTRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Dns TXT Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventGridDomainID is a parsed Resource ID for a Event Grid Domain
+type EventGridDomainID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventGridDomainID returns a new EventGridDomainID from the specified components
+func NewEventGridDomainID(subscriptionId, resourceGroup, name string) EventGridDomainID {
+	return EventGridDomainID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Grid Domain
+func (id EventGridDomainID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/domains/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseEventGridDomainID parses the specified Resource ID into a EventGridDomainID, returning an error
+// if the Resource ID isn't for a Event Grid Domain
+func ParseEventGridDomainID(input string) (*EventGridDomainID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Domain ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventGrid") {
+		return nil, fmt.Errorf("Error parsing Event Grid Domain ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventGrid", id.Provider)
+	}
+
+	resourceId := EventGridDomainID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("domains"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Domain ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Domain ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventGridDomainID validates that the specified value is a Event Grid Domain ID
+func ValidateEventGridDomainID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventGridDomainID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Grid Domain ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
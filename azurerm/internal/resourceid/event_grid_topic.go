@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventGridTopicID is a parsed Resource ID for a Event Grid Topic
+type EventGridTopicID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventGridTopicID returns a new EventGridTopicID from the specified components
+func NewEventGridTopicID(subscriptionId, resourceGroup, name string) EventGridTopicID {
+	return EventGridTopicID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Grid Topic
+func (id EventGridTopicID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/topics/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseEventGridTopicID parses the specified Resource ID into a EventGridTopicID, returning an error
+// if the Resource ID isn't for a Event Grid Topic
+func ParseEventGridTopicID(input string) (*EventGridTopicID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Topic ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventGrid") {
+		return nil, fmt.Errorf("Error parsing Event Grid Topic ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventGrid", id.Provider)
+	}
+
+	resourceId := EventGridTopicID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Topic ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Grid Topic ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventGridTopicID validates that the specified value is a Event Grid Topic ID
+func ValidateEventGridTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventGridTopicID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Grid Topic ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
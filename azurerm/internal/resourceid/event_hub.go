@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubID is a parsed Resource ID for a Event Hub
+type EventHubID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubID returns a new EventHubID from the specified components
+func NewEventHubID(subscriptionId, resourceGroup, namespaceName, name string) EventHubID {
+	return EventHubID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Hub
+func (id EventHubID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubID parses the specified Resource ID into a EventHubID, returning an error
+// if the Resource ID isn't for a Event Hub
+func ParseEventHubID(input string) (*EventHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing Event Hub ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubID validates that the specified value is a Event Hub ID
+func ValidateEventHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Hub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
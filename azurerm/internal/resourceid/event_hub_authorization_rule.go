@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubAuthorizationRuleID is a parsed Resource ID for a Event Hub Authorization Rule
+type EventHubAuthorizationRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	EventhubName   string
+	Name           string
+}
+
+// NewEventHubAuthorizationRuleID returns a new EventHubAuthorizationRuleID from the specified components
+func NewEventHubAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, eventhubName, name string) EventHubAuthorizationRuleID {
+	return EventHubAuthorizationRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventhubName:   eventhubName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Hub Authorization Rule
+func (id EventHubAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.Name)
+}
+
+// ParseEventHubAuthorizationRuleID parses the specified Resource ID into a EventHubAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Event Hub Authorization Rule
+func ParseEventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubAuthorizationRuleID validates that the specified value is a Event Hub Authorization Rule ID
+func ValidateEventHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventHubAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Hub Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
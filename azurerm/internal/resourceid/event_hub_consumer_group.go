@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubConsumerGroupID is a parsed Resource ID for a Event Hub Consumer Group
+type EventHubConsumerGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	EventhubName   string
+	Name           string
+}
+
+// NewEventHubConsumerGroupID returns a new EventHubConsumerGroupID from the specified components
+func NewEventHubConsumerGroupID(subscriptionId, resourceGroup, namespaceName, eventhubName, name string) EventHubConsumerGroupID {
+	return EventHubConsumerGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventhubName:   eventhubName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Hub Consumer Group
+func (id EventHubConsumerGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/consumergroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.Name)
+}
+
+// ParseEventHubConsumerGroupID parses the specified Resource ID into a EventHubConsumerGroupID, returning an error
+// if the Resource ID isn't for a Event Hub Consumer Group
+func ParseEventHubConsumerGroupID(input string) (*EventHubConsumerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubConsumerGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("consumergroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubConsumerGroupID validates that the specified value is a Event Hub Consumer Group ID
+func ValidateEventHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventHubConsumerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Hub Consumer Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubNamespaceID is a parsed Resource ID for a Event Hub Namespace
+type EventHubNamespaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventHubNamespaceID returns a new EventHubNamespaceID from the specified components
+func NewEventHubNamespaceID(subscriptionId, resourceGroup, name string) EventHubNamespaceID {
+	return EventHubNamespaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Hub Namespace
+func (id EventHubNamespaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseEventHubNamespaceID parses the specified Resource ID into a EventHubNamespaceID, returning an error
+// if the Resource ID isn't for a Event Hub Namespace
+func ParseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubNamespaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubNamespaceID validates that the specified value is a Event Hub Namespace ID
+func ValidateEventHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventHubNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Hub Namespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
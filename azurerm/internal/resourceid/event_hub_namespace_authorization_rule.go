@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubNamespaceAuthorizationRuleID is a parsed Resource ID for a Event Hub Namespace Authorization Rule
+type EventHubNamespaceAuthorizationRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubNamespaceAuthorizationRuleID returns a new EventHubNamespaceAuthorizationRuleID from the specified components
+func NewEventHubNamespaceAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, name string) EventHubNamespaceAuthorizationRuleID {
+	return EventHubNamespaceAuthorizationRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Event Hub Namespace Authorization Rule
+func (id EventHubNamespaceAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/AuthorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubNamespaceAuthorizationRuleID parses the specified Resource ID into a EventHubNamespaceAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Event Hub Namespace Authorization Rule
+func ParseEventHubNamespaceAuthorizationRuleID(input string) (*EventHubNamespaceAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubNamespaceAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AuthorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Event Hub Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleID validates that the specified value is a Event Hub Namespace Authorization Rule ID
+func ValidateEventHubNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseEventHubNamespaceAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Event Hub Namespace Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
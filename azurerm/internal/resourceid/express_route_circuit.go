@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitID is a parsed Resource ID for a Express Route Circuit
+type ExpressRouteCircuitID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRouteCircuitID returns a new ExpressRouteCircuitID from the specified components
+func NewExpressRouteCircuitID(subscriptionId, resourceGroup, name string) ExpressRouteCircuitID {
+	return ExpressRouteCircuitID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Express Route Circuit
+func (id ExpressRouteCircuitID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRouteCircuitID parses the specified Resource ID into a ExpressRouteCircuitID, returning an error
+// if the Resource ID isn't for a Express Route Circuit
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitID validates that the specified value is a Express Route Circuit ID
+func ValidateExpressRouteCircuitID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseExpressRouteCircuitID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Express Route Circuit ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitAuthorizationID is a parsed Resource ID for a Express Route Circuit Authorization
+type ExpressRouteCircuitAuthorizationID struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	ExpressRouteCircuitName string
+	Name                    string
+}
+
+// NewExpressRouteCircuitAuthorizationID returns a new ExpressRouteCircuitAuthorizationID from the specified components
+func NewExpressRouteCircuitAuthorizationID(subscriptionId, resourceGroup, expressRouteCircuitName, name string) ExpressRouteCircuitAuthorizationID {
+	return ExpressRouteCircuitAuthorizationID{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		ExpressRouteCircuitName: expressRouteCircuitName,
+		Name:                    name,
+	}
+}
+
+// String returns the Resource ID for this Express Route Circuit Authorization
+func (id ExpressRouteCircuitAuthorizationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/authorizations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExpressRouteCircuitName, id.Name)
+}
+
+// ParseExpressRouteCircuitAuthorizationID parses the specified Resource ID into a ExpressRouteCircuitAuthorizationID, returning an error
+// if the Resource ID isn't for a Express Route Circuit Authorization
+func ParseExpressRouteCircuitAuthorizationID(input string) (*ExpressRouteCircuitAuthorizationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Authorization ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Authorization ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitAuthorizationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ExpressRouteCircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Authorization ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizations"); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Authorization ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Express Route Circuit Authorization ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitAuthorizationID validates that the specified value is a Express Route Circuit Authorization ID
+func ValidateExpressRouteCircuitAuthorizationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseExpressRouteCircuitAuthorizationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Express Route Circuit Authorization ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallID is a parsed Resource ID for a Firewall
+type FirewallID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallID returns a new FirewallID from the specified components
+func NewFirewallID(subscriptionId, resourceGroup, name string) FirewallID {
+	return FirewallID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Firewall
+func (id FirewallID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallID parses the specified Resource ID into a FirewallID, returning an error
+// if the Resource ID isn't for a Firewall
+func ParseFirewallID(input string) (*FirewallID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallID validates that the specified value is a Firewall ID
+func ValidateFirewallID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseFirewallID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Firewall ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallApplicationRuleCollectionID is a parsed Resource ID for a Firewall Application Rule Collection
+type FirewallApplicationRuleCollectionID struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	AzureFirewallName string
+	Name              string
+}
+
+// NewFirewallApplicationRuleCollectionID returns a new FirewallApplicationRuleCollectionID from the specified components
+func NewFirewallApplicationRuleCollectionID(subscriptionId, resourceGroup, azureFirewallName, name string) FirewallApplicationRuleCollectionID {
+	return FirewallApplicationRuleCollectionID{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		Name:              name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Application Rule Collection
+func (id FirewallApplicationRuleCollectionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/applicationRuleCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.Name)
+}
+
+// ParseFirewallApplicationRuleCollectionID parses the specified Resource ID into a FirewallApplicationRuleCollectionID, returning an error
+// if the Resource ID isn't for a Firewall Application Rule Collection
+func ParseFirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallApplicationRuleCollectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationRuleCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Application Rule Collection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallApplicationRuleCollectionID validates that the specified value is a Firewall Application Rule Collection ID
+func ValidateFirewallApplicationRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseFirewallApplicationRuleCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Firewall Application Rule Collection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallNatRuleCollectionID is a parsed Resource ID for a Firewall Nat Rule Collection
+type FirewallNatRuleCollectionID struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	AzureFirewallName string
+	Name              string
+}
+
+// NewFirewallNatRuleCollectionID returns a new FirewallNatRuleCollectionID from the specified components
+func NewFirewallNatRuleCollectionID(subscriptionId, resourceGroup, azureFirewallName, name string) FirewallNatRuleCollectionID {
+	return FirewallNatRuleCollectionID{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		Name:              name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Nat Rule Collection
+func (id FirewallNatRuleCollectionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/natRuleCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.Name)
+}
+
+// ParseFirewallNatRuleCollectionID parses the specified Resource ID into a FirewallNatRuleCollectionID, returning an error
+// if the Resource ID isn't for a Firewall Nat Rule Collection
+func ParseFirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Nat Rule Collection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Nat Rule Collection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallNatRuleCollectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Nat Rule Collection ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("natRuleCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Nat Rule Collection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Nat Rule Collection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallNatRuleCollectionID validates that the specified value is a Firewall Nat Rule Collection ID
+func ValidateFirewallNatRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseFirewallNatRuleCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Firewall Nat Rule Collection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
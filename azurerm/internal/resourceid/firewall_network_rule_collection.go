@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallNetworkRuleCollectionID is a parsed Resource ID for a Firewall Network Rule Collection
+type FirewallNetworkRuleCollectionID struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	AzureFirewallName string
+	Name              string
+}
+
+// NewFirewallNetworkRuleCollectionID returns a new FirewallNetworkRuleCollectionID from the specified components
+func NewFirewallNetworkRuleCollectionID(subscriptionId, resourceGroup, azureFirewallName, name string) FirewallNetworkRuleCollectionID {
+	return FirewallNetworkRuleCollectionID{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		Name:              name,
+	}
+}
+
+// String returns the Resource ID for this Firewall Network Rule Collection
+func (id FirewallNetworkRuleCollectionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/networkRuleCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.Name)
+}
+
+// ParseFirewallNetworkRuleCollectionID parses the specified Resource ID into a FirewallNetworkRuleCollectionID, returning an error
+// if the Resource ID isn't for a Firewall Network Rule Collection
+func ParseFirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Network Rule Collection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Firewall Network Rule Collection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallNetworkRuleCollectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Network Rule Collection ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkRuleCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Network Rule Collection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Firewall Network Rule Collection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallNetworkRuleCollectionID validates that the specified value is a Firewall Network Rule Collection ID
+func ValidateFirewallNetworkRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseFirewallNetworkRuleCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Firewall Network Rule Collection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
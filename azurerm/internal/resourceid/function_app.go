@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FunctionAppID is a parsed Resource ID for a Function App
+type FunctionAppID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFunctionAppID returns a new FunctionAppID from the specified components
+func NewFunctionAppID(subscriptionId, resourceGroup, name string) FunctionAppID {
+	return FunctionAppID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Function App
+func (id FunctionAppID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseFunctionAppID parses the specified Resource ID into a FunctionAppID, returning an error
+// if the Resource ID isn't for a Function App
+func ParseFunctionAppID(input string) (*FunctionAppID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Function App ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing Function App ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := FunctionAppID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing Function App ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Function App ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFunctionAppID validates that the specified value is a Function App ID
+func ValidateFunctionAppID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseFunctionAppID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Function App ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
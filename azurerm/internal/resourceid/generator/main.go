@@ -0,0 +1,431 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// This generator is invoked via `go generate` (see `resourceids.go` in the parent directory)
+// and outputs a Resource ID Struct, Parser, Formatter & Validator (alongside Unit Tests)
+// for the specified Resource Type, based on an example Resource ID.
+
+func main() {
+	name := flag.String("name", "", "The name of the Resource Type, e.g. `VirtualNetwork`")
+	id := flag.String("id", "", "An example Resource ID for this Resource Type, e.g. `/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1`")
+	flag.Parse()
+
+	if *name == "" || *id == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	resourceId, err := newResourceIdDefinition(*name, *id)
+	if err != nil {
+		log.Fatalf("Error parsing Resource ID %q: %+v", *id, err)
+	}
+
+	fileName := toSnakeCase(*name)
+	if err := writeTemplate(fmt.Sprintf("%s.go", fileName), idTemplate, resourceId); err != nil {
+		log.Fatalf("Error generating Resource ID: %+v", err)
+	}
+	if err := writeTemplate(fmt.Sprintf("%s_test.go", fileName), testTemplate, resourceId); err != nil {
+		log.Fatalf("Error generating Resource ID Tests: %+v", err)
+	}
+}
+
+type segment struct {
+	// FieldName is the name of the field within the struct, e.g. `VirtualNetworkName`
+	FieldName string
+
+	// SegmentKey is the key for this segment within the Resource ID, e.g. `virtualNetworks`
+	SegmentKey string
+
+	// ExampleValue is the value for this segment from the example Resource ID, e.g. `network1`
+	ExampleValue string
+}
+
+type resourceIdDefinition struct {
+	Name         string
+	FriendlyName string
+	ExampleId    string
+
+	ExampleSubscriptionId string
+	ExampleResourceGroup  string
+
+	// Provider is the Resource Provider namespace, e.g. `Microsoft.Network` - empty for a Resource Group
+	Provider string
+	Segments []segment
+}
+
+func newResourceIdDefinition(name, id string) (*resourceIdDefinition, error) {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	if len(components) < 4 || len(components)%2 != 0 {
+		return nil, fmt.Errorf("expected an even number of segments containing at least a Subscription and Resource Group")
+	}
+	if components[0] != "subscriptions" || components[2] != "resourceGroups" {
+		return nil, fmt.Errorf("expected the ID to start with `/subscriptions/{id}/resourceGroups/{name}`")
+	}
+
+	definition := resourceIdDefinition{
+		Name:                  name,
+		FriendlyName:          toFriendlyName(name),
+		ExampleId:             id,
+		ExampleSubscriptionId: components[1],
+		ExampleResourceGroup:  components[3],
+		Segments:              make([]segment, 0),
+	}
+
+	remaining := components[4:]
+	if len(remaining) == 0 {
+		// this is a Resource Group, so the name is the Resource Group itself
+		return &definition, nil
+	}
+
+	if remaining[0] != "providers" {
+		return nil, fmt.Errorf("expected a `providers` segment after the Resource Group")
+	}
+	definition.Provider = remaining[1]
+	remaining = remaining[2:]
+	if len(remaining) == 0 {
+		return nil, fmt.Errorf("expected at least one segment after the Provider")
+	}
+
+	for i := 0; i < len(remaining); i += 2 {
+		key := remaining[i]
+		fieldName := "Name"
+		if i+2 < len(remaining) {
+			fieldName = fmt.Sprintf("%sName", toSingular(strings.Title(key)))
+		}
+
+		definition.Segments = append(definition.Segments, segment{
+			FieldName:    fieldName,
+			SegmentKey:   key,
+			ExampleValue: remaining[i+1],
+		})
+	}
+
+	return &definition, nil
+}
+
+// IsResourceGroup returns whether this Resource ID represents a Resource Group
+func (d resourceIdDefinition) IsResourceGroup() bool {
+	return d.Provider == ""
+}
+
+// FormatString returns the format string used to build this Resource ID
+func (d resourceIdDefinition) FormatString() string {
+	if d.IsResourceGroup() {
+		return "/subscriptions/%s/resourceGroups/%s"
+	}
+
+	format := fmt.Sprintf("/subscriptions/%%s/resourceGroups/%%s/providers/%s", d.Provider)
+	for _, s := range d.Segments {
+		format += fmt.Sprintf("/%s/%%s", s.SegmentKey)
+	}
+	return format
+}
+
+// Fields returns the names of the fields (other than the Subscription ID and Resource Group) in this Resource ID
+func (d resourceIdDefinition) Fields() []string {
+	if d.IsResourceGroup() {
+		return []string{"Name"}
+	}
+
+	fields := make([]string, 0)
+	for _, s := range d.Segments {
+		fields = append(fields, s.FieldName)
+	}
+	return fields
+}
+
+// ConstructorArguments returns the arguments for the New* function for this Resource ID
+func (d resourceIdDefinition) ConstructorArguments() string {
+	args := []string{"subscriptionId"}
+	if !d.IsResourceGroup() {
+		args = append(args, "resourceGroup")
+	}
+	for _, field := range d.Fields() {
+		args = append(args, toArgumentName(field))
+	}
+	return strings.Join(args, ", ") + " string"
+}
+
+// PartialIds returns each (invalid) partial Resource ID which is built up to form this Resource ID
+func (d resourceIdDefinition) PartialIds() []string {
+	ids := []string{
+		"/subscriptions/",
+		fmt.Sprintf("/subscriptions/%s", d.ExampleSubscriptionId),
+		fmt.Sprintf("/subscriptions/%s/resourceGroups/", d.ExampleSubscriptionId),
+	}
+
+	if d.IsResourceGroup() {
+		return ids
+	}
+
+	current := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s", d.ExampleSubscriptionId, d.ExampleResourceGroup, d.Provider)
+	ids = append(ids, current)
+	for i, s := range d.Segments {
+		ids = append(ids, fmt.Sprintf("%s/%s/", current, s.SegmentKey))
+		current = fmt.Sprintf("%s/%s/%s", current, s.SegmentKey, s.ExampleValue)
+		if i < len(d.Segments)-1 {
+			ids = append(ids, current)
+		}
+	}
+	return ids
+}
+
+func toArgumentName(fieldName string) string {
+	return strings.ToLower(fieldName[0:1]) + fieldName[1:]
+}
+
+func toFriendlyName(name string) string {
+	// e.g. `VirtualNetwork` -> `Virtual Network`, `PublicIPAddress` -> `Public IP Address` and `DnsARecord` -> `Dns A Record`
+	return joinWords(name, " ")
+}
+
+func toSingular(input string) string {
+	switch {
+	case strings.HasSuffix(input, "sses"):
+		return strings.TrimSuffix(input, "es")
+	case strings.HasSuffix(input, "ies"):
+		return strings.TrimSuffix(input, "ies") + "y"
+	case strings.HasSuffix(input, "is"):
+		// e.g. `Redis`
+		return input
+	case strings.HasSuffix(input, "s"):
+		return strings.TrimSuffix(input, "s")
+	}
+	return input
+}
+
+func toSnakeCase(input string) string {
+	return strings.ToLower(joinWords(input, "_"))
+}
+
+// wellKnownNames are product names which shouldn't be split into separate words
+var wellKnownNames = []string{"CosmosDB", "HDInsight", "MariaDB", "MySQL", "OpenID", "PostgreSQL", "SignalR"}
+
+// joinWords splits the PascalCased input into words (e.g. `PublicIPAddress` -> `Public`, `IP` & `Address`)
+// and joins them using the separator, keeping any well-known product names intact
+func joinWords(input, separator string) string {
+	split := func(v string) string {
+		v = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`).ReplaceAllString(v, "${1}"+separator+"${2}")
+		return regexp.MustCompile(`([a-z0-9])([A-Z])`).ReplaceAllString(v, "${1}"+separator+"${2}")
+	}
+
+	output := split(input)
+	for _, name := range wellKnownNames {
+		output = strings.Replace(output, split(name), name, -1)
+	}
+	return output
+}
+
+func writeTemplate(fileName, tmpl string, data interface{}) error {
+	t, err := template.New(fileName).Funcs(template.FuncMap{
+		"toArgumentName": toArgumentName,
+	}).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("parsing template: %+v", err)
+	}
+
+	buf := bytes.Buffer{}
+	if err := t.Execute(&buf, data); err != nil {
+		return fmt.Errorf("executing template: %+v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting output: %+v", err)
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}
+
+const idTemplate = `package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+{{- if not .IsResourceGroup }}
+	"strings"
+{{- end }}
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// {{ .Name }}ID is a parsed Resource ID for a {{ .FriendlyName }}
+type {{ .Name }}ID struct {
+	SubscriptionId string
+{{- if not .IsResourceGroup }}
+	ResourceGroup  string
+{{- end }}
+{{- range .Fields }}
+	{{ . }} string
+{{- end }}
+}
+
+// New{{ .Name }}ID returns a new {{ .Name }}ID from the specified components
+func New{{ .Name }}ID({{ .ConstructorArguments }}) {{ .Name }}ID {
+	return {{ .Name }}ID{
+		SubscriptionId: subscriptionId,
+{{- if not .IsResourceGroup }}
+		ResourceGroup:  resourceGroup,
+{{- end }}
+{{- range .Fields }}
+		{{ . }}: {{ toArgumentName . }},
+{{- end }}
+	}
+}
+
+// String returns the Resource ID for this {{ .FriendlyName }}
+func (id {{ .Name }}ID) String() string {
+	fmtString := "{{ .FormatString }}"
+	return fmt.Sprintf(fmtString, id.SubscriptionId{{ if not .IsResourceGroup }}, id.ResourceGroup{{ end }}{{ range .Fields }}, id.{{ . }}{{ end }})
+}
+
+// Parse{{ .Name }}ID parses the specified Resource ID into a {{ .Name }}ID, returning an error
+// if the Resource ID isn't for a {{ .FriendlyName }}
+func Parse{{ .Name }}ID(input string) (*{{ .Name }}ID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .FriendlyName }} ID %q: %+v", input, err)
+	}
+{{ if .IsResourceGroup }}
+	if id.Provider != "" {
+		return nil, fmt.Errorf("Error parsing {{ .FriendlyName }} ID %q: expected no Resource Provider but got %q", input, id.Provider)
+	}
+
+	resourceId := {{ .Name }}ID{
+		SubscriptionId: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}
+{{ else }}
+	if !strings.EqualFold(id.Provider, "{{ .Provider }}") {
+		return nil, fmt.Errorf("Error parsing {{ .FriendlyName }} ID %q: expected the Resource Provider to be %q but got %q", input, "{{ .Provider }}", id.Provider)
+	}
+
+	resourceId := {{ .Name }}ID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+{{ range .Segments }}
+	if resourceId.{{ .FieldName }}, err = id.PopSegment("{{ .SegmentKey }}"); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ $.FriendlyName }} ID %q: %+v", input, err)
+	}
+{{ end }}
+{{- end }}
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .FriendlyName }} ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// Validate{{ .Name }}ID validates that the specified value is a {{ .FriendlyName }} ID
+func Validate{{ .Name }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := Parse{{ .Name }}ID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid {{ .FriendlyName }} ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+`
+
+const testTemplate = `package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import "testing"
+
+func Test{{ .Name }}IDFormatter(t *testing.T) {
+	actual := New{{ .Name }}ID("{{ .ExampleSubscriptionId }}", "{{ .ExampleResourceGroup }}"{{ range .Segments }}, "{{ .ExampleValue }}"{{ end }}).String()
+	expected := "{{ .ExampleId }}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParse{{ .Name }}ID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *{{ .Name }}ID
+	}{
+		{
+			// empty
+			Input: "",
+		},
+{{- range .PartialIds }}
+		{
+			Input: "{{ . }}",
+		},
+{{- end }}
+		{
+			// valid
+			Input: "{{ .ExampleId }}",
+			Expected: &{{ .Name }}ID{
+				SubscriptionId: "{{ .ExampleSubscriptionId }}",
+{{- if .IsResourceGroup }}
+				Name:           "{{ .ExampleResourceGroup }}",
+{{- else }}
+				ResourceGroup:  "{{ .ExampleResourceGroup }}",
+{{- end }}
+{{- range .Segments }}
+				{{ .FieldName }}: "{{ .ExampleValue }}",
+{{- end }}
+			},
+		},
+		{
+			// the ID of a nested resource
+			Input: "{{ .ExampleId }}{{ if .IsResourceGroup }}/providers/Microsoft.Example/nested/nested1{{ else }}/nested/nested1{{ end }}",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := Parse{{ .Name }}ID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+{{- if not .IsResourceGroup }}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+{{- end }}
+{{- range .Fields }}
+
+		if actual.{{ . }} != v.Expected.{{ . }} {
+			t.Fatalf("Expected %q but got %q for {{ . }}", v.Expected.{{ . }}, actual.{{ . }})
+		}
+{{- end }}
+	}
+}
+`
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// HDInsightClusterID is a parsed Resource ID for a HDInsight Cluster
+type HDInsightClusterID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewHDInsightClusterID returns a new HDInsightClusterID from the specified components
+func NewHDInsightClusterID(subscriptionId, resourceGroup, name string) HDInsightClusterID {
+	return HDInsightClusterID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this HDInsight Cluster
+func (id HDInsightClusterID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HDInsight/clusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseHDInsightClusterID parses the specified Resource ID into a HDInsightClusterID, returning an error
+// if the Resource ID isn't for a HDInsight Cluster
+func ParseHDInsightClusterID(input string) (*HDInsightClusterID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing HDInsight Cluster ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.HDInsight") {
+		return nil, fmt.Errorf("Error parsing HDInsight Cluster ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.HDInsight", id.Provider)
+	}
+
+	resourceId := HDInsightClusterID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("clusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing HDInsight Cluster ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing HDInsight Cluster ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateHDInsightClusterID validates that the specified value is a HDInsight Cluster ID
+func ValidateHDInsightClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseHDInsightClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid HDInsight Cluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ImageID is a parsed Resource ID for a Image
+type ImageID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewImageID returns a new ImageID from the specified components
+func NewImageID(subscriptionId, resourceGroup, name string) ImageID {
+	return ImageID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Image
+func (id ImageID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseImageID parses the specified Resource ID into a ImageID, returning an error
+// if the Resource ID isn't for a Image
+func ParseImageID(input string) (*ImageID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Image ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Image ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := ImageID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, fmt.Errorf("Error parsing Image ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Image ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateImageID validates that the specified value is a Image ID
+func ValidateImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Image ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,30 @@
+package resourceid
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ValidatingImporter returns a ResourceImporter which validates the Resource ID being imported
+// using the specified ValidateFunc (e.g. `ValidateVirtualNetworkID`) prior to passing through
+// the Resource ID - meaning an ID for a different Resource Type fails at import time with a
+// clear error, rather than during the Read.
+func ValidatingImporter(validateFunc schema.SchemaValidateFunc) *schema.ResourceImporter {
+	return ValidatingImporterWithState(validateFunc, schema.ImportStatePassthrough)
+}
+
+// ValidatingImporterWithState returns a ResourceImporter which validates the Resource ID being
+// imported using the specified ValidateFunc prior to calling the specified StateFunc - for
+// Resources which need to look up or set additional fields during import.
+func ValidatingImporterWithState(validateFunc schema.SchemaValidateFunc, stateFunc schema.StateFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, errors := validateFunc(d.Id(), "id"); len(errors) > 0 {
+				return nil, fmt.Errorf("Error importing Resource: %+v", errors[0])
+			}
+
+			return stateFunc(d, meta)
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidatingImporter(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input string
+		Valid bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Valid: false,
+		},
+		{
+			Name:  "Virtual Network",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			Valid: true,
+		},
+		{
+			Name:  "Subnet",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Valid: false,
+		},
+		{
+			Name:  "Virtual Machine",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/network1",
+			Valid: false,
+		},
+	}
+
+	importer := ValidatingImporter(ValidateVirtualNetworkID)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := &schema.ResourceData{}
+		d.SetId(v.Input)
+
+		_, err := importer.State(d, nil)
+		valid := err == nil
+		if v.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q: %+v", v.Valid, valid, v.Input, err)
+		}
+	}
+}
+
+func TestValidatingImporterWithState(t *testing.T) {
+	called := false
+	importer := ValidatingImporterWithState(ValidateVirtualNetworkID, func(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+		called = true
+		return []*schema.ResourceData{d}, nil
+	})
+
+	d := &schema.ResourceData{}
+	d.SetId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+	if _, err := importer.State(d, nil); err == nil {
+		t.Fatalf("Expected an error when importing a Subnet ID but didn't get one")
+	}
+	if called {
+		t.Fatalf("Expected the StateFunc not to be called for an invalid ID")
+	}
+
+	d.SetId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1")
+	if _, err := importer.State(d, nil); err != nil {
+		t.Fatalf("Expected no error when importing a Virtual Network ID but got: %+v", err)
+	}
+	if !called {
+		t.Fatalf("Expected the StateFunc to be called for a valid ID")
+	}
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotDpsID is a parsed Resource ID for a Iot Dps
+type IotDpsID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewIotDpsID returns a new IotDpsID from the specified components
+func NewIotDpsID(subscriptionId, resourceGroup, name string) IotDpsID {
+	return IotDpsID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Iot Dps
+func (id IotDpsID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/provisioningServices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseIotDpsID parses the specified Resource ID into a IotDpsID, returning an error
+// if the Resource ID isn't for a Iot Dps
+func ParseIotDpsID(input string) (*IotDpsID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing Iot Dps ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotDpsID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("provisioningServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotDpsID validates that the specified value is a Iot Dps ID
+func ValidateIotDpsID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseIotDpsID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Iot Dps ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
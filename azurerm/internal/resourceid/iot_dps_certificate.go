@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotDpsCertificateID is a parsed Resource ID for a Iot Dps Certificate
+type IotDpsCertificateID struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	ProvisioningServiceName string
+	Name                    string
+}
+
+// NewIotDpsCertificateID returns a new IotDpsCertificateID from the specified components
+func NewIotDpsCertificateID(subscriptionId, resourceGroup, provisioningServiceName, name string) IotDpsCertificateID {
+	return IotDpsCertificateID{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		ProvisioningServiceName: provisioningServiceName,
+		Name:                    name,
+	}
+}
+
+// String returns the Resource ID for this Iot Dps Certificate
+func (id IotDpsCertificateID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/provisioningServices/%s/certificates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProvisioningServiceName, id.Name)
+}
+
+// ParseIotDpsCertificateID parses the specified Resource ID into a IotDpsCertificateID, returning an error
+// if the Resource ID isn't for a Iot Dps Certificate
+func ParseIotDpsCertificateID(input string) (*IotDpsCertificateID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps Certificate ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing Iot Dps Certificate ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotDpsCertificateID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ProvisioningServiceName, err = id.PopSegment("provisioningServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps Certificate ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps Certificate ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Dps Certificate ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotDpsCertificateID validates that the specified value is a Iot Dps Certificate ID
+func ValidateIotDpsCertificateID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseIotDpsCertificateID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Iot Dps Certificate ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotHubID is a parsed Resource ID for a Iot Hub
+type IotHubID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewIotHubID returns a new IotHubID from the specified components
+func NewIotHubID(subscriptionId, resourceGroup, name string) IotHubID {
+	return IotHubID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Iot Hub
+func (id IotHubID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseIotHubID parses the specified Resource ID into a IotHubID, returning an error
+// if the Resource ID isn't for a Iot Hub
+func ParseIotHubID(input string) (*IotHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing Iot Hub ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotHubID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("IotHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotHubID validates that the specified value is a Iot Hub ID
+func ValidateIotHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseIotHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Iot Hub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
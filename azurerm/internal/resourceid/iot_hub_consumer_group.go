@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotHubConsumerGroupID is a parsed Resource ID for a Iot Hub Consumer Group
+type IotHubConsumerGroupID struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	IotHubName           string
+	EventHubEndpointName string
+	Name                 string
+}
+
+// NewIotHubConsumerGroupID returns a new IotHubConsumerGroupID from the specified components
+func NewIotHubConsumerGroupID(subscriptionId, resourceGroup, iotHubName, eventHubEndpointName, name string) IotHubConsumerGroupID {
+	return IotHubConsumerGroupID{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		IotHubName:           iotHubName,
+		EventHubEndpointName: eventHubEndpointName,
+		Name:                 name,
+	}
+}
+
+// String returns the Resource ID for this Iot Hub Consumer Group
+func (id IotHubConsumerGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/eventHubEndpoints/%s/ConsumerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
+}
+
+// ParseIotHubConsumerGroupID parses the specified Resource ID into a IotHubConsumerGroupID, returning an error
+// if the Resource ID isn't for a Iot Hub Consumer Group
+func ParseIotHubConsumerGroupID(input string) (*IotHubConsumerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotHubConsumerGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.EventHubEndpointName, err = id.PopSegment("eventHubEndpoints"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ConsumerGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Consumer Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotHubConsumerGroupID validates that the specified value is a Iot Hub Consumer Group ID
+func ValidateIotHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseIotHubConsumerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Iot Hub Consumer Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
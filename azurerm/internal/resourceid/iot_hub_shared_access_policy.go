@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotHubSharedAccessPolicyID is a parsed Resource ID for a Iot Hub Shared Access Policy
+type IotHubSharedAccessPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	IotHubName     string
+	Name           string
+}
+
+// NewIotHubSharedAccessPolicyID returns a new IotHubSharedAccessPolicyID from the specified components
+func NewIotHubSharedAccessPolicyID(subscriptionId, resourceGroup, iotHubName, name string) IotHubSharedAccessPolicyID {
+	return IotHubSharedAccessPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		IotHubName:     iotHubName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Iot Hub Shared Access Policy
+func (id IotHubSharedAccessPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/IotHubKeys/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.Name)
+}
+
+// ParseIotHubSharedAccessPolicyID parses the specified Resource ID into a IotHubSharedAccessPolicyID, returning an error
+// if the Resource ID isn't for a Iot Hub Shared Access Policy
+func ParseIotHubSharedAccessPolicyID(input string) (*IotHubSharedAccessPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Shared Access Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing Iot Hub Shared Access Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotHubSharedAccessPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Shared Access Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("IotHubKeys"); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Shared Access Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Iot Hub Shared Access Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotHubSharedAccessPolicyID validates that the specified value is a Iot Hub Shared Access Policy ID
+func ValidateIotHubSharedAccessPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseIotHubSharedAccessPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Iot Hub Shared Access Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KeyVaultID is a parsed Resource ID for a Key Vault
+type KeyVaultID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKeyVaultID returns a new KeyVaultID from the specified components
+func NewKeyVaultID(subscriptionId, resourceGroup, name string) KeyVaultID {
+	return KeyVaultID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Key Vault
+func (id KeyVaultID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseKeyVaultID parses the specified Resource ID into a KeyVaultID, returning an error
+// if the Resource ID isn't for a Key Vault
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.KeyVault") {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.KeyVault", id.Provider)
+	}
+
+	resourceId := KeyVaultID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKeyVaultID validates that the specified value is a Key Vault ID
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseKeyVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Key Vault ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
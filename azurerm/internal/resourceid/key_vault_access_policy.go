@@ -0,0 +1,93 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KeyVaultAccessPolicyID is a parsed Resource ID for a Key Vault Access Policy - since the
+// `applicationId` segment is optional this Resource ID can't be generated
+type KeyVaultAccessPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VaultName      string
+	ObjectId       string
+	ApplicationId  string
+}
+
+// NewKeyVaultAccessPolicyID returns a new KeyVaultAccessPolicyID from the specified components,
+// where the applicationId can be empty
+func NewKeyVaultAccessPolicyID(subscriptionId, resourceGroup, vaultName, objectId, applicationId string) KeyVaultAccessPolicyID {
+	return KeyVaultAccessPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		ObjectId:       objectId,
+		ApplicationId:  applicationId,
+	}
+}
+
+// String returns the Resource ID for this Key Vault Access Policy
+func (id KeyVaultAccessPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s/objectId/%s"
+	out := fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.ObjectId)
+	if id.ApplicationId != "" {
+		out = fmt.Sprintf("%s/applicationId/%s", out, id.ApplicationId)
+	}
+	return out
+}
+
+// ParseKeyVaultAccessPolicyID parses the specified Resource ID into a KeyVaultAccessPolicyID, returning an error
+// if the Resource ID isn't for a Key Vault Access Policy
+func ParseKeyVaultAccessPolicyID(input string) (*KeyVaultAccessPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.KeyVault") {
+		return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.KeyVault", id.Provider)
+	}
+
+	resourceId := KeyVaultAccessPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.ObjectId, err = id.PopSegment("objectId"); err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: %+v", input, err)
+	}
+
+	if _, ok := id.Path["applicationId"]; ok {
+		if resourceId.ApplicationId, err = id.PopSegment("applicationId"); err != nil {
+			return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: %+v", input, err)
+		}
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault Access Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKeyVaultAccessPolicyID validates that the specified value is a Key Vault Access Policy ID
+func ValidateKeyVaultAccessPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseKeyVaultAccessPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Key Vault Access Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,94 @@
+package resourceid
+
+import "testing"
+
+func TestKeyVaultAccessPolicyIDFormatter(t *testing.T) {
+	testData := []struct {
+		ApplicationId string
+		Expected      string
+	}{
+		{
+			ApplicationId: "",
+			Expected:      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1",
+		},
+		{
+			ApplicationId: "application1",
+			Expected:      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/applicationId/application1",
+		},
+	}
+
+	for _, v := range testData {
+		actual := NewKeyVaultAccessPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "object1", v.ApplicationId).String()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseKeyVaultAccessPolicyID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *KeyVaultAccessPolicyID
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// key vault
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/",
+		},
+		{
+			// valid, without an application id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1",
+			Expected: &KeyVaultAccessPolicyID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				VaultName:      "vault1",
+				ObjectId:       "object1",
+			},
+		},
+		{
+			// valid, with an application id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/applicationId/application1",
+			Expected: &KeyVaultAccessPolicyID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				VaultName:      "vault1",
+				ObjectId:       "object1",
+				ApplicationId:  "application1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/applicationId/",
+		},
+		{
+			// the ID of a nested resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/nested/nested1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseKeyVaultAccessPolicyID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KubernetesClusterID is a parsed Resource ID for a Kubernetes Cluster
+type KubernetesClusterID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKubernetesClusterID returns a new KubernetesClusterID from the specified components
+func NewKubernetesClusterID(subscriptionId, resourceGroup, name string) KubernetesClusterID {
+	return KubernetesClusterID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Kubernetes Cluster
+func (id KubernetesClusterID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseKubernetesClusterID parses the specified Resource ID into a KubernetesClusterID, returning an error
+// if the Resource ID isn't for a Kubernetes Cluster
+func ParseKubernetesClusterID(input string) (*KubernetesClusterID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := KubernetesClusterID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKubernetesClusterID validates that the specified value is a Kubernetes Cluster ID
+func ValidateKubernetesClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseKubernetesClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Kubernetes Cluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KubernetesClusterNodePoolID is a parsed Resource ID for a Kubernetes Cluster Node Pool
+type KubernetesClusterNodePoolID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ManagedClusterName string
+	Name               string
+}
+
+// NewKubernetesClusterNodePoolID returns a new KubernetesClusterNodePoolID from the specified components
+func NewKubernetesClusterNodePoolID(subscriptionId, resourceGroup, managedClusterName, name string) KubernetesClusterNodePoolID {
+	return KubernetesClusterNodePoolID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ManagedClusterName: managedClusterName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Kubernetes Cluster Node Pool
+func (id KubernetesClusterNodePoolID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/agentPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.Name)
+}
+
+// ParseKubernetesClusterNodePoolID parses the specified Resource ID into a KubernetesClusterNodePoolID, returning an error
+// if the Resource ID isn't for a Kubernetes Cluster Node Pool
+func ParseKubernetesClusterNodePoolID(input string) (*KubernetesClusterNodePoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster Node Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster Node Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := KubernetesClusterNodePoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster Node Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("agentPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster Node Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Kubernetes Cluster Node Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKubernetesClusterNodePoolID validates that the specified value is a Kubernetes Cluster Node Pool ID
+func ValidateKubernetesClusterNodePoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseKubernetesClusterNodePoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Kubernetes Cluster Node Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerID is a parsed Resource ID for a Load Balancer
+type LoadBalancerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLoadBalancerID returns a new LoadBalancerID from the specified components
+func NewLoadBalancerID(subscriptionId, resourceGroup, name string) LoadBalancerID {
+	return LoadBalancerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer
+func (id LoadBalancerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLoadBalancerID parses the specified Resource ID into a LoadBalancerID, returning an error
+// if the Resource ID isn't for a Load Balancer
+func ParseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerID validates that the specified value is a Load Balancer ID
+func ValidateLoadBalancerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLoadBalancerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Load Balancer ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
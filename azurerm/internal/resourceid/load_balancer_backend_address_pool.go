@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerBackendAddressPoolID is a parsed Resource ID for a Load Balancer Backend Address Pool
+type LoadBalancerBackendAddressPoolID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerBackendAddressPoolID returns a new LoadBalancerBackendAddressPoolID from the specified components
+func NewLoadBalancerBackendAddressPoolID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerBackendAddressPoolID {
+	return LoadBalancerBackendAddressPoolID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Backend Address Pool
+func (id LoadBalancerBackendAddressPoolID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerBackendAddressPoolID parses the specified Resource ID into a LoadBalancerBackendAddressPoolID, returning an error
+// if the Resource ID isn't for a Load Balancer Backend Address Pool
+func ParseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Backend Address Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerBackendAddressPoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backendAddressPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Backend Address Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerBackendAddressPoolID validates that the specified value is a Load Balancer Backend Address Pool ID
+func ValidateLoadBalancerBackendAddressPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLoadBalancerBackendAddressPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Load Balancer Backend Address Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerInboundNatRuleID is a parsed Resource ID for a Load Balancer Inbound Nat Rule
+type LoadBalancerInboundNatRuleID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerInboundNatRuleID returns a new LoadBalancerInboundNatRuleID from the specified components
+func NewLoadBalancerInboundNatRuleID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatRuleID {
+	return LoadBalancerInboundNatRuleID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Inbound Nat Rule
+func (id LoadBalancerInboundNatRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerInboundNatRuleID parses the specified Resource ID into a LoadBalancerInboundNatRuleID, returning an error
+// if the Resource ID isn't for a Load Balancer Inbound Nat Rule
+func ParseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerInboundNatRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inboundNatRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Inbound Nat Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerInboundNatRuleID validates that the specified value is a Load Balancer Inbound Nat Rule ID
+func ValidateLoadBalancerInboundNatRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLoadBalancerInboundNatRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Load Balancer Inbound Nat Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerProbeID is a parsed Resource ID for a Load Balancer Probe
+type LoadBalancerProbeID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerProbeID returns a new LoadBalancerProbeID from the specified components
+func NewLoadBalancerProbeID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerProbeID {
+	return LoadBalancerProbeID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Load Balancer Probe
+func (id LoadBalancerProbeID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/probes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerProbeID parses the specified Resource ID into a LoadBalancerProbeID, returning an error
+// if the Resource ID isn't for a Load Balancer Probe
+func ParseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Probe ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Load Balancer Probe ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerProbeID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Probe ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("probes"); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Probe ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Load Balancer Probe ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerProbeID validates that the specified value is a Load Balancer Probe ID
+func ValidateLoadBalancerProbeID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLoadBalancerProbeID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Load Balancer Probe ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsLinkedServiceID is a parsed Resource ID for a Log Analytics Linked Service
+type LogAnalyticsLinkedServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	Name           string
+}
+
+// NewLogAnalyticsLinkedServiceID returns a new LogAnalyticsLinkedServiceID from the specified components
+func NewLogAnalyticsLinkedServiceID(subscriptionId, resourceGroup, workspaceName, name string) LogAnalyticsLinkedServiceID {
+	return LogAnalyticsLinkedServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Log Analytics Linked Service
+func (id LogAnalyticsLinkedServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/linkedservices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name)
+}
+
+// ParseLogAnalyticsLinkedServiceID parses the specified Resource ID into a LogAnalyticsLinkedServiceID, returning an error
+// if the Resource ID isn't for a Log Analytics Linked Service
+func ParseLogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Linked Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationalInsights") {
+		return nil, fmt.Errorf("Error parsing Log Analytics Linked Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.OperationalInsights", id.Provider)
+	}
+
+	resourceId := LogAnalyticsLinkedServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Linked Service ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("linkedservices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Linked Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Linked Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsLinkedServiceID validates that the specified value is a Log Analytics Linked Service ID
+func ValidateLogAnalyticsLinkedServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogAnalyticsLinkedServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Log Analytics Linked Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsSolutionID is a parsed Resource ID for a Log Analytics Solution
+type LogAnalyticsSolutionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogAnalyticsSolutionID returns a new LogAnalyticsSolutionID from the specified components
+func NewLogAnalyticsSolutionID(subscriptionId, resourceGroup, name string) LogAnalyticsSolutionID {
+	return LogAnalyticsSolutionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Log Analytics Solution
+func (id LogAnalyticsSolutionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationsManagement/solutions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsSolutionID parses the specified Resource ID into a LogAnalyticsSolutionID, returning an error
+// if the Resource ID isn't for a Log Analytics Solution
+func ParseLogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Solution ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationsManagement") {
+		return nil, fmt.Errorf("Error parsing Log Analytics Solution ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.OperationsManagement", id.Provider)
+	}
+
+	resourceId := LogAnalyticsSolutionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("solutions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Solution ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Solution ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsSolutionID validates that the specified value is a Log Analytics Solution ID
+func ValidateLogAnalyticsSolutionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogAnalyticsSolutionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Log Analytics Solution ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsWorkspaceID is a parsed Resource ID for a Log Analytics Workspace
+type LogAnalyticsWorkspaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogAnalyticsWorkspaceID returns a new LogAnalyticsWorkspaceID from the specified components
+func NewLogAnalyticsWorkspaceID(subscriptionId, resourceGroup, name string) LogAnalyticsWorkspaceID {
+	return LogAnalyticsWorkspaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Log Analytics Workspace
+func (id LogAnalyticsWorkspaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsWorkspaceID parses the specified Resource ID into a LogAnalyticsWorkspaceID, returning an error
+// if the Resource ID isn't for a Log Analytics Workspace
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Workspace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationalInsights") {
+		return nil, fmt.Errorf("Error parsing Log Analytics Workspace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.OperationalInsights", id.Provider)
+	}
+
+	resourceId := LogAnalyticsWorkspaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Workspace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Log Analytics Workspace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsWorkspaceID validates that the specified value is a Log Analytics Workspace ID
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogAnalyticsWorkspaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Log Analytics Workspace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppActionID is a parsed Resource ID for a Logic App Action
+type LogicAppActionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkflowName   string
+	Name           string
+}
+
+// NewLogicAppActionID returns a new LogicAppActionID from the specified components
+func NewLogicAppActionID(subscriptionId, resourceGroup, workflowName, name string) LogicAppActionID {
+	return LogicAppActionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkflowName:   workflowName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Logic App Action
+func (id LogicAppActionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/actions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+// ParseLogicAppActionID parses the specified Resource ID into a LogicAppActionID, returning an error
+// if the Resource ID isn't for a Logic App Action
+func ParseLogicAppActionID(input string) (*LogicAppActionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Action ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing Logic App Action ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppActionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkflowName, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Action ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("actions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Action ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Action ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppActionID validates that the specified value is a Logic App Action ID
+func ValidateLogicAppActionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogicAppActionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Logic App Action ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
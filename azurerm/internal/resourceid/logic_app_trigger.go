@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppTriggerID is a parsed Resource ID for a Logic App Trigger
+type LogicAppTriggerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkflowName   string
+	Name           string
+}
+
+// NewLogicAppTriggerID returns a new LogicAppTriggerID from the specified components
+func NewLogicAppTriggerID(subscriptionId, resourceGroup, workflowName, name string) LogicAppTriggerID {
+	return LogicAppTriggerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkflowName:   workflowName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Logic App Trigger
+func (id LogicAppTriggerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/triggers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+// ParseLogicAppTriggerID parses the specified Resource ID into a LogicAppTriggerID, returning an error
+// if the Resource ID isn't for a Logic App Trigger
+func ParseLogicAppTriggerID(input string) (*LogicAppTriggerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Trigger ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing Logic App Trigger ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppTriggerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkflowName, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Trigger ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("triggers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Trigger ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Trigger ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppTriggerID validates that the specified value is a Logic App Trigger ID
+func ValidateLogicAppTriggerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogicAppTriggerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Logic App Trigger ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
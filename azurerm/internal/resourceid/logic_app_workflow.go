@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppWorkflowID is a parsed Resource ID for a Logic App Workflow
+type LogicAppWorkflowID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogicAppWorkflowID returns a new LogicAppWorkflowID from the specified components
+func NewLogicAppWorkflowID(subscriptionId, resourceGroup, name string) LogicAppWorkflowID {
+	return LogicAppWorkflowID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Logic App Workflow
+func (id LogicAppWorkflowID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLogicAppWorkflowID parses the specified Resource ID into a LogicAppWorkflowID, returning an error
+// if the Resource ID isn't for a Logic App Workflow
+func ParseLogicAppWorkflowID(input string) (*LogicAppWorkflowID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Workflow ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing Logic App Workflow ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppWorkflowID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Workflow ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Logic App Workflow ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppWorkflowID validates that the specified value is a Logic App Workflow ID
+func ValidateLogicAppWorkflowID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseLogicAppWorkflowID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Logic App Workflow ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
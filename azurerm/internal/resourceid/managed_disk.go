@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ManagedDiskID is a parsed Resource ID for a Managed Disk
+type ManagedDiskID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewManagedDiskID returns a new ManagedDiskID from the specified components
+func NewManagedDiskID(subscriptionId, resourceGroup, name string) ManagedDiskID {
+	return ManagedDiskID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Managed Disk
+func (id ManagedDiskID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseManagedDiskID parses the specified Resource ID into a ManagedDiskID, returning an error
+// if the Resource ID isn't for a Managed Disk
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := ManagedDiskID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("disks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateManagedDiskID validates that the specified value is a Managed Disk ID
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseManagedDiskID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Managed Disk ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
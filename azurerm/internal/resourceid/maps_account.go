@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MapsAccountID is a parsed Resource ID for a Maps Account
+type MapsAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMapsAccountID returns a new MapsAccountID from the specified components
+func NewMapsAccountID(subscriptionId, resourceGroup, name string) MapsAccountID {
+	return MapsAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Maps Account
+func (id MapsAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Maps/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMapsAccountID parses the specified Resource ID into a MapsAccountID, returning an error
+// if the Resource ID isn't for a Maps Account
+func ParseMapsAccountID(input string) (*MapsAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Maps Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Maps") {
+		return nil, fmt.Errorf("Error parsing Maps Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Maps", id.Provider)
+	}
+
+	resourceId := MapsAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Maps Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Maps Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMapsAccountID validates that the specified value is a Maps Account ID
+func ValidateMapsAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMapsAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Maps Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
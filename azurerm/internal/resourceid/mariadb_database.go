@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MariaDBDatabaseID is a parsed Resource ID for a MariaDB Database
+type MariaDBDatabaseID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMariaDBDatabaseID returns a new MariaDBDatabaseID from the specified components
+func NewMariaDBDatabaseID(subscriptionId, resourceGroup, serverName, name string) MariaDBDatabaseID {
+	return MariaDBDatabaseID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MariaDB Database
+func (id MariaDBDatabaseID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMariaDB/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMariaDBDatabaseID parses the specified Resource ID into a MariaDBDatabaseID, returning an error
+// if the Resource ID isn't for a MariaDB Database
+func ParseMariaDBDatabaseID(input string) (*MariaDBDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Database ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMariaDB") {
+		return nil, fmt.Errorf("Error parsing MariaDB Database ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMariaDB", id.Provider)
+	}
+
+	resourceId := MariaDBDatabaseID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Database ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Database ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Database ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMariaDBDatabaseID validates that the specified value is a MariaDB Database ID
+func ValidateMariaDBDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMariaDBDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MariaDB Database ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
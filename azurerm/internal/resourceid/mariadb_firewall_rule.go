@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MariaDBFirewallRuleID is a parsed Resource ID for a MariaDB Firewall Rule
+type MariaDBFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMariaDBFirewallRuleID returns a new MariaDBFirewallRuleID from the specified components
+func NewMariaDBFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) MariaDBFirewallRuleID {
+	return MariaDBFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MariaDB Firewall Rule
+func (id MariaDBFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMariaDB/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMariaDBFirewallRuleID parses the specified Resource ID into a MariaDBFirewallRuleID, returning an error
+// if the Resource ID isn't for a MariaDB Firewall Rule
+func ParseMariaDBFirewallRuleID(input string) (*MariaDBFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMariaDB") {
+		return nil, fmt.Errorf("Error parsing MariaDB Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMariaDB", id.Provider)
+	}
+
+	resourceId := MariaDBFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMariaDBFirewallRuleID validates that the specified value is a MariaDB Firewall Rule ID
+func ValidateMariaDBFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMariaDBFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MariaDB Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
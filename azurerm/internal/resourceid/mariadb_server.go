@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MariaDBServerID is a parsed Resource ID for a MariaDB Server
+type MariaDBServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMariaDBServerID returns a new MariaDBServerID from the specified components
+func NewMariaDBServerID(subscriptionId, resourceGroup, name string) MariaDBServerID {
+	return MariaDBServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MariaDB Server
+func (id MariaDBServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMariaDB/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMariaDBServerID parses the specified Resource ID into a MariaDBServerID, returning an error
+// if the Resource ID isn't for a MariaDB Server
+func ParseMariaDBServerID(input string) (*MariaDBServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMariaDB") {
+		return nil, fmt.Errorf("Error parsing MariaDB Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMariaDB", id.Provider)
+	}
+
+	resourceId := MariaDBServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MariaDB Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMariaDBServerID validates that the specified value is a MariaDB Server ID
+func ValidateMariaDBServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMariaDBServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MariaDB Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
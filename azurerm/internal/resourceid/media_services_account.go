@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MediaServicesAccountID is a parsed Resource ID for a Media Services Account
+type MediaServicesAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMediaServicesAccountID returns a new MediaServicesAccountID from the specified components
+func NewMediaServicesAccountID(subscriptionId, resourceGroup, name string) MediaServicesAccountID {
+	return MediaServicesAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Media Services Account
+func (id MediaServicesAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMediaServicesAccountID parses the specified Resource ID into a MediaServicesAccountID, returning an error
+// if the Resource ID isn't for a Media Services Account
+func ParseMediaServicesAccountID(input string) (*MediaServicesAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Media Services Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Media") {
+		return nil, fmt.Errorf("Error parsing Media Services Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Media", id.Provider)
+	}
+
+	resourceId := MediaServicesAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("mediaservices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Media Services Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Media Services Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMediaServicesAccountID validates that the specified value is a Media Services Account ID
+func ValidateMediaServicesAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMediaServicesAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Media Services Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
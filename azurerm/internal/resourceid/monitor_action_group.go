@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorActionGroupID is a parsed Resource ID for a Monitor Action Group
+type MonitorActionGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorActionGroupID returns a new MonitorActionGroupID from the specified components
+func NewMonitorActionGroupID(subscriptionId, resourceGroup, name string) MonitorActionGroupID {
+	return MonitorActionGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Monitor Action Group
+func (id MonitorActionGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/actionGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorActionGroupID parses the specified Resource ID into a MonitorActionGroupID, returning an error
+// if the Resource ID isn't for a Monitor Action Group
+func ParseMonitorActionGroupID(input string) (*MonitorActionGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Action Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Monitor Action Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorActionGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("actionGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Action Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Action Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorActionGroupID validates that the specified value is a Monitor Action Group ID
+func ValidateMonitorActionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMonitorActionGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Monitor Action Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorActivityLogAlertID is a parsed Resource ID for a Monitor Activity Log Alert
+type MonitorActivityLogAlertID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorActivityLogAlertID returns a new MonitorActivityLogAlertID from the specified components
+func NewMonitorActivityLogAlertID(subscriptionId, resourceGroup, name string) MonitorActivityLogAlertID {
+	return MonitorActivityLogAlertID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Monitor Activity Log Alert
+func (id MonitorActivityLogAlertID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/activityLogAlerts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorActivityLogAlertID parses the specified Resource ID into a MonitorActivityLogAlertID, returning an error
+// if the Resource ID isn't for a Monitor Activity Log Alert
+func ParseMonitorActivityLogAlertID(input string) (*MonitorActivityLogAlertID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Activity Log Alert ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Monitor Activity Log Alert ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorActivityLogAlertID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("activityLogAlerts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Activity Log Alert ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Activity Log Alert ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorActivityLogAlertID validates that the specified value is a Monitor Activity Log Alert ID
+func ValidateMonitorActivityLogAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMonitorActivityLogAlertID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Monitor Activity Log Alert ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorAutoscaleSettingID is a parsed Resource ID for a Monitor Autoscale Setting
+type MonitorAutoscaleSettingID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorAutoscaleSettingID returns a new MonitorAutoscaleSettingID from the specified components
+func NewMonitorAutoscaleSettingID(subscriptionId, resourceGroup, name string) MonitorAutoscaleSettingID {
+	return MonitorAutoscaleSettingID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Monitor Autoscale Setting
+func (id MonitorAutoscaleSettingID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/autoscalesettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorAutoscaleSettingID parses the specified Resource ID into a MonitorAutoscaleSettingID, returning an error
+// if the Resource ID isn't for a Monitor Autoscale Setting
+func ParseMonitorAutoscaleSettingID(input string) (*MonitorAutoscaleSettingID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Autoscale Setting ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Monitor Autoscale Setting ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorAutoscaleSettingID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("autoscalesettings"); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Autoscale Setting ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Autoscale Setting ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorAutoscaleSettingID validates that the specified value is a Monitor Autoscale Setting ID
+func ValidateMonitorAutoscaleSettingID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMonitorAutoscaleSettingID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Monitor Autoscale Setting ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
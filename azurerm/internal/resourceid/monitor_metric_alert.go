@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorMetricAlertID is a parsed Resource ID for a Monitor Metric Alert
+type MonitorMetricAlertID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorMetricAlertID returns a new MonitorMetricAlertID from the specified components
+func NewMonitorMetricAlertID(subscriptionId, resourceGroup, name string) MonitorMetricAlertID {
+	return MonitorMetricAlertID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Monitor Metric Alert
+func (id MonitorMetricAlertID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/metricAlerts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorMetricAlertID parses the specified Resource ID into a MonitorMetricAlertID, returning an error
+// if the Resource ID isn't for a Monitor Metric Alert
+func ParseMonitorMetricAlertID(input string) (*MonitorMetricAlertID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorMetricAlertID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("metricAlerts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorMetricAlertID validates that the specified value is a Monitor Metric Alert ID
+func ValidateMonitorMetricAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMonitorMetricAlertID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Monitor Metric Alert ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorMetricAlertRuleID is a parsed Resource ID for a Monitor Metric Alert Rule
+type MonitorMetricAlertRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorMetricAlertRuleID returns a new MonitorMetricAlertRuleID from the specified components
+func NewMonitorMetricAlertRuleID(subscriptionId, resourceGroup, name string) MonitorMetricAlertRuleID {
+	return MonitorMetricAlertRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Monitor Metric Alert Rule
+func (id MonitorMetricAlertRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/alertrules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorMetricAlertRuleID parses the specified Resource ID into a MonitorMetricAlertRuleID, returning an error
+// if the Resource ID isn't for a Monitor Metric Alert Rule
+func ParseMonitorMetricAlertRuleID(input string) (*MonitorMetricAlertRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorMetricAlertRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("alertrules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Monitor Metric Alert Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorMetricAlertRuleID validates that the specified value is a Monitor Metric Alert Rule ID
+func ValidateMonitorMetricAlertRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMonitorMetricAlertRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Monitor Metric Alert Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySQLConfigurationID is a parsed Resource ID for a MySQL Configuration
+type MySQLConfigurationID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySQLConfigurationID returns a new MySQLConfigurationID from the specified components
+func NewMySQLConfigurationID(subscriptionId, resourceGroup, serverName, name string) MySQLConfigurationID {
+	return MySQLConfigurationID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MySQL Configuration
+func (id MySQLConfigurationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySQLConfigurationID parses the specified Resource ID into a MySQLConfigurationID, returning an error
+// if the Resource ID isn't for a MySQL Configuration
+func ParseMySQLConfigurationID(input string) (*MySQLConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing MySQL Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySQLConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySQLConfigurationID validates that the specified value is a MySQL Configuration ID
+func ValidateMySQLConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMySQLConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MySQL Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySQLDatabaseID is a parsed Resource ID for a MySQL Database
+type MySQLDatabaseID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySQLDatabaseID returns a new MySQLDatabaseID from the specified components
+func NewMySQLDatabaseID(subscriptionId, resourceGroup, serverName, name string) MySQLDatabaseID {
+	return MySQLDatabaseID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MySQL Database
+func (id MySQLDatabaseID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySQLDatabaseID parses the specified Resource ID into a MySQLDatabaseID, returning an error
+// if the Resource ID isn't for a MySQL Database
+func ParseMySQLDatabaseID(input string) (*MySQLDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Database ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing MySQL Database ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySQLDatabaseID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Database ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Database ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Database ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySQLDatabaseID validates that the specified value is a MySQL Database ID
+func ValidateMySQLDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMySQLDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MySQL Database ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySQLFirewallRuleID is a parsed Resource ID for a MySQL Firewall Rule
+type MySQLFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySQLFirewallRuleID returns a new MySQLFirewallRuleID from the specified components
+func NewMySQLFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) MySQLFirewallRuleID {
+	return MySQLFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MySQL Firewall Rule
+func (id MySQLFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySQLFirewallRuleID parses the specified Resource ID into a MySQLFirewallRuleID, returning an error
+// if the Resource ID isn't for a MySQL Firewall Rule
+func ParseMySQLFirewallRuleID(input string) (*MySQLFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing MySQL Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySQLFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySQLFirewallRuleID validates that the specified value is a MySQL Firewall Rule ID
+func ValidateMySQLFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMySQLFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MySQL Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySQLServerID is a parsed Resource ID for a MySQL Server
+type MySQLServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMySQLServerID returns a new MySQLServerID from the specified components
+func NewMySQLServerID(subscriptionId, resourceGroup, name string) MySQLServerID {
+	return MySQLServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MySQL Server
+func (id MySQLServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseMySQLServerID parses the specified Resource ID into a MySQLServerID, returning an error
+// if the Resource ID isn't for a MySQL Server
+func ParseMySQLServerID(input string) (*MySQLServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing MySQL Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySQLServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySQLServerID validates that the specified value is a MySQL Server ID
+func ValidateMySQLServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMySQLServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MySQL Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySQLVirtualNetworkRuleID is a parsed Resource ID for a MySQL Virtual Network Rule
+type MySQLVirtualNetworkRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySQLVirtualNetworkRuleID returns a new MySQLVirtualNetworkRuleID from the specified components
+func NewMySQLVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) MySQLVirtualNetworkRuleID {
+	return MySQLVirtualNetworkRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this MySQL Virtual Network Rule
+func (id MySQLVirtualNetworkRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySQLVirtualNetworkRuleID parses the specified Resource ID into a MySQLVirtualNetworkRuleID, returning an error
+// if the Resource ID isn't for a MySQL Virtual Network Rule
+func ParseMySQLVirtualNetworkRuleID(input string) (*MySQLVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing MySQL Virtual Network Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySQLVirtualNetworkRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing MySQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySQLVirtualNetworkRuleID validates that the specified value is a MySQL Virtual Network Rule ID
+func ValidateMySQLVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseMySQLVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid MySQL Virtual Network Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkProfileID is a parsed Resource ID for a Network Profile
+type NetworkProfileID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkProfileID returns a new NetworkProfileID from the specified components
+func NewNetworkProfileID(subscriptionId, resourceGroup, name string) NetworkProfileID {
+	return NetworkProfileID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Network Profile
+func (id NetworkProfileID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkProfileID parses the specified Resource ID into a NetworkProfileID, returning an error
+// if the Resource ID isn't for a Network Profile
+func ParseNetworkProfileID(input string) (*NetworkProfileID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Profile ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Profile ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkProfileID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkProfiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Profile ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Profile ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkProfileID validates that the specified value is a Network Profile ID
+func ValidateNetworkProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseNetworkProfileID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Network Profile ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
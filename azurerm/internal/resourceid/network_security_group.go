@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkSecurityGroupID is a parsed Resource ID for a Network Security Group
+type NetworkSecurityGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkSecurityGroupID returns a new NetworkSecurityGroupID from the specified components
+func NewNetworkSecurityGroupID(subscriptionId, resourceGroup, name string) NetworkSecurityGroupID {
+	return NetworkSecurityGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Network Security Group
+func (id NetworkSecurityGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkSecurityGroupID parses the specified Resource ID into a NetworkSecurityGroupID, returning an error
+// if the Resource ID isn't for a Network Security Group
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkSecurityGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityGroupID validates that the specified value is a Network Security Group ID
+func ValidateNetworkSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseNetworkSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Network Security Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubID is a parsed Resource ID for a Notification Hub
+type NotificationHubID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewNotificationHubID returns a new NotificationHubID from the specified components
+func NewNotificationHubID(subscriptionId, resourceGroup, namespaceName, name string) NotificationHubID {
+	return NotificationHubID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Notification Hub
+func (id NotificationHubID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseNotificationHubID parses the specified Resource ID into a NotificationHubID, returning an error
+// if the Resource ID isn't for a Notification Hub
+func ParseNotificationHubID(input string) (*NotificationHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing Notification Hub ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("notificationHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubID validates that the specified value is a Notification Hub ID
+func ValidateNotificationHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseNotificationHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Notification Hub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubAuthorizationRuleID is a parsed Resource ID for a Notification Hub Authorization Rule
+type NotificationHubAuthorizationRuleID struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	NamespaceName       string
+	NotificationHubName string
+	Name                string
+}
+
+// NewNotificationHubAuthorizationRuleID returns a new NotificationHubAuthorizationRuleID from the specified components
+func NewNotificationHubAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, notificationHubName, name string) NotificationHubAuthorizationRuleID {
+	return NotificationHubAuthorizationRuleID{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		NamespaceName:       namespaceName,
+		NotificationHubName: notificationHubName,
+		Name:                name,
+	}
+}
+
+// String returns the Resource ID for this Notification Hub Authorization Rule
+func (id NotificationHubAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s/AuthorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.Name)
+}
+
+// ParseNotificationHubAuthorizationRuleID parses the specified Resource ID into a NotificationHubAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Notification Hub Authorization Rule
+func ParseNotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.NotificationHubName, err = id.PopSegment("notificationHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AuthorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubAuthorizationRuleID validates that the specified value is a Notification Hub Authorization Rule ID
+func ValidateNotificationHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseNotificationHubAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Notification Hub Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubNamespaceID is a parsed Resource ID for a Notification Hub Namespace
+type NotificationHubNamespaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNotificationHubNamespaceID returns a new NotificationHubNamespaceID from the specified components
+func NewNotificationHubNamespaceID(subscriptionId, resourceGroup, name string) NotificationHubNamespaceID {
+	return NotificationHubNamespaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Notification Hub Namespace
+func (id NotificationHubNamespaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseNotificationHubNamespaceID parses the specified Resource ID into a NotificationHubNamespaceID, returning an error
+// if the Resource ID isn't for a Notification Hub Namespace
+func ParseNotificationHubNamespaceID(input string) (*NotificationHubNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Namespace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing Notification Hub Namespace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubNamespaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Namespace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Notification Hub Namespace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubNamespaceID validates that the specified value is a Notification Hub Namespace ID
+func ValidateNotificationHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseNotificationHubNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Notification Hub Namespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
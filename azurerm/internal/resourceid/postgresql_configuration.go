@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLConfigurationID is a parsed Resource ID for a PostgreSQL Configuration
+type PostgreSQLConfigurationID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLConfigurationID returns a new PostgreSQLConfigurationID from the specified components
+func NewPostgreSQLConfigurationID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLConfigurationID {
+	return PostgreSQLConfigurationID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this PostgreSQL Configuration
+func (id PostgreSQLConfigurationID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLConfigurationID parses the specified Resource ID into a PostgreSQLConfigurationID, returning an error
+// if the Resource ID isn't for a PostgreSQL Configuration
+func ParsePostgreSQLConfigurationID(input string) (*PostgreSQLConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Configuration ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Configuration ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLConfigurationID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Configuration ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Configuration ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Configuration ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLConfigurationID validates that the specified value is a PostgreSQL Configuration ID
+func ValidatePostgreSQLConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePostgreSQLConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid PostgreSQL Configuration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
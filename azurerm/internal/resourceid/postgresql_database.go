@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLDatabaseID is a parsed Resource ID for a PostgreSQL Database
+type PostgreSQLDatabaseID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLDatabaseID returns a new PostgreSQLDatabaseID from the specified components
+func NewPostgreSQLDatabaseID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLDatabaseID {
+	return PostgreSQLDatabaseID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this PostgreSQL Database
+func (id PostgreSQLDatabaseID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLDatabaseID parses the specified Resource ID into a PostgreSQLDatabaseID, returning an error
+// if the Resource ID isn't for a PostgreSQL Database
+func ParsePostgreSQLDatabaseID(input string) (*PostgreSQLDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Database ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Database ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLDatabaseID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Database ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Database ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Database ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLDatabaseID validates that the specified value is a PostgreSQL Database ID
+func ValidatePostgreSQLDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePostgreSQLDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid PostgreSQL Database ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
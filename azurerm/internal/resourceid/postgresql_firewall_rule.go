@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLFirewallRuleID is a parsed Resource ID for a PostgreSQL Firewall Rule
+type PostgreSQLFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLFirewallRuleID returns a new PostgreSQLFirewallRuleID from the specified components
+func NewPostgreSQLFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLFirewallRuleID {
+	return PostgreSQLFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this PostgreSQL Firewall Rule
+func (id PostgreSQLFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLFirewallRuleID parses the specified Resource ID into a PostgreSQLFirewallRuleID, returning an error
+// if the Resource ID isn't for a PostgreSQL Firewall Rule
+func ParsePostgreSQLFirewallRuleID(input string) (*PostgreSQLFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLFirewallRuleID validates that the specified value is a PostgreSQL Firewall Rule ID
+func ValidatePostgreSQLFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePostgreSQLFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid PostgreSQL Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
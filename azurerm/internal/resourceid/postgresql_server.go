@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLServerID is a parsed Resource ID for a PostgreSQL Server
+type PostgreSQLServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPostgreSQLServerID returns a new PostgreSQLServerID from the specified components
+func NewPostgreSQLServerID(subscriptionId, resourceGroup, name string) PostgreSQLServerID {
+	return PostgreSQLServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this PostgreSQL Server
+func (id PostgreSQLServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePostgreSQLServerID parses the specified Resource ID into a PostgreSQLServerID, returning an error
+// if the Resource ID isn't for a PostgreSQL Server
+func ParsePostgreSQLServerID(input string) (*PostgreSQLServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLServerID validates that the specified value is a PostgreSQL Server ID
+func ValidatePostgreSQLServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePostgreSQLServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid PostgreSQL Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLVirtualNetworkRuleID is a parsed Resource ID for a PostgreSQL Virtual Network Rule
+type PostgreSQLVirtualNetworkRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLVirtualNetworkRuleID returns a new PostgreSQLVirtualNetworkRuleID from the specified components
+func NewPostgreSQLVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLVirtualNetworkRuleID {
+	return PostgreSQLVirtualNetworkRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this PostgreSQL Virtual Network Rule
+func (id PostgreSQLVirtualNetworkRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLVirtualNetworkRuleID parses the specified Resource ID into a PostgreSQLVirtualNetworkRuleID, returning an error
+// if the Resource ID isn't for a PostgreSQL Virtual Network Rule
+func ParsePostgreSQLVirtualNetworkRuleID(input string) (*PostgreSQLVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Virtual Network Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLVirtualNetworkRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing PostgreSQL Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLVirtualNetworkRuleID validates that the specified value is a PostgreSQL Virtual Network Rule ID
+func ValidatePostgreSQLVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePostgreSQLVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid PostgreSQL Virtual Network Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateDnsARecordID is a parsed Resource ID for a Private Dns A Record
+type PrivateDnsARecordID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	PrivateDnsZoneName string
+	Name               string
+}
+
+// NewPrivateDnsARecordID returns a new PrivateDnsARecordID from the specified components
+func NewPrivateDnsARecordID(subscriptionId, resourceGroup, privateDnsZoneName, name string) PrivateDnsARecordID {
+	return PrivateDnsARecordID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		PrivateDnsZoneName: privateDnsZoneName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Private Dns A Record
+func (id PrivateDnsARecordID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s/A/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName, id.Name)
+}
+
+// ParsePrivateDnsARecordID parses the specified Resource ID into a PrivateDnsARecordID, returning an error
+// if the Resource ID isn't for a Private Dns A Record
+func ParsePrivateDnsARecordID(input string) (*PrivateDnsARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns A Record ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Private Dns A Record ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PrivateDnsARecordID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns A Record ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("A"); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns A Record ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns A Record ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateDnsARecordID validates that the specified value is a Private Dns A Record ID
+func ValidatePrivateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePrivateDnsARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Private Dns A Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
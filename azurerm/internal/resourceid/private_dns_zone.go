@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PrivateDnsZoneID is a parsed Resource ID for a Private Dns Zone
+type PrivateDnsZoneID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateDnsZoneID returns a new PrivateDnsZoneID from the specified components
+func NewPrivateDnsZoneID(subscriptionId, resourceGroup, name string) PrivateDnsZoneID {
+	return PrivateDnsZoneID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Private Dns Zone
+func (id PrivateDnsZoneID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateDnsZoneID parses the specified Resource ID into a PrivateDnsZoneID, returning an error
+// if the Resource ID isn't for a Private Dns Zone
+func ParsePrivateDnsZoneID(input string) (*PrivateDnsZoneID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns Zone ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Private Dns Zone ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PrivateDnsZoneID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("privateDnsZones"); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns Zone ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Private Dns Zone ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateDnsZoneID validates that the specified value is a Private Dns Zone ID
+func ValidatePrivateDnsZoneID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePrivateDnsZoneID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Private Dns Zone ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ProximityPlacementGroupID is a parsed Resource ID for a Proximity Placement Group
+type ProximityPlacementGroupID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewProximityPlacementGroupID returns a new ProximityPlacementGroupID from the specified components
+func NewProximityPlacementGroupID(subscriptionId, resourceGroup, name string) ProximityPlacementGroupID {
+	return ProximityPlacementGroupID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Proximity Placement Group
+func (id ProximityPlacementGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/proximityPlacementGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseProximityPlacementGroupID parses the specified Resource ID into a ProximityPlacementGroupID, returning an error
+// if the Resource ID isn't for a Proximity Placement Group
+func ParseProximityPlacementGroupID(input string) (*ProximityPlacementGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Proximity Placement Group ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Proximity Placement Group ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := ProximityPlacementGroupID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("proximityPlacementGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing Proximity Placement Group ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Proximity Placement Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateProximityPlacementGroupID validates that the specified value is a Proximity Placement Group ID
+func ValidateProximityPlacementGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseProximityPlacementGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Proximity Placement Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPID is a parsed Resource ID for a Public IP
+type PublicIPID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPID returns a new PublicIPID from the specified components
+func NewPublicIPID(subscriptionId, resourceGroup, name string) PublicIPID {
+	return PublicIPID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Public IP
+func (id PublicIPID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPID parses the specified Resource ID into a PublicIPID, returning an error
+// if the Resource ID isn't for a Public IP
+func ParsePublicIPID(input string) (*PublicIPID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Public IP ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PublicIPID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPAddresses"); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPID validates that the specified value is a Public IP ID
+func ValidatePublicIPID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePublicIPID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Public IP ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPPrefixID is a parsed Resource ID for a Public IP Prefix
+type PublicIPPrefixID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPPrefixID returns a new PublicIPPrefixID from the specified components
+func NewPublicIPPrefixID(subscriptionId, resourceGroup, name string) PublicIPPrefixID {
+	return PublicIPPrefixID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Public IP Prefix
+func (id PublicIPPrefixID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPPrefixes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPPrefixID parses the specified Resource ID into a PublicIPPrefixID, returning an error
+// if the Resource ID isn't for a Public IP Prefix
+func ParsePublicIPPrefixID(input string) (*PublicIPPrefixID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Prefix ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Public IP Prefix ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PublicIPPrefixID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPPrefixes"); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Prefix ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Public IP Prefix ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPPrefixID validates that the specified value is a Public IP Prefix ID
+func ValidatePublicIPPrefixID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParsePublicIPPrefixID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Public IP Prefix ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,93 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesProtectedItemID is a parsed Resource ID for a Recovery Services Protected Item
+type RecoveryServicesProtectedItemID struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	VaultName               string
+	BackupFabricName        string
+	ProtectionContainerName string
+	Name                    string
+}
+
+// NewRecoveryServicesProtectedItemID returns a new RecoveryServicesProtectedItemID from the specified components
+func NewRecoveryServicesProtectedItemID(subscriptionId, resourceGroup, vaultName, backupFabricName, protectionContainerName, name string) RecoveryServicesProtectedItemID {
+	return RecoveryServicesProtectedItemID{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		VaultName:               vaultName,
+		BackupFabricName:        backupFabricName,
+		ProtectionContainerName: protectionContainerName,
+		Name:                    name,
+	}
+}
+
+// String returns the Resource ID for this Recovery Services Protected Item
+func (id RecoveryServicesProtectedItemID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/%s/protectionContainers/%s/protectedItems/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.BackupFabricName, id.ProtectionContainerName, id.Name)
+}
+
+// ParseRecoveryServicesProtectedItemID parses the specified Resource ID into a RecoveryServicesProtectedItemID, returning an error
+// if the Resource ID isn't for a Recovery Services Protected Item
+func ParseRecoveryServicesProtectedItemID(input string) (*RecoveryServicesProtectedItemID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesProtectedItemID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	if resourceId.BackupFabricName, err = id.PopSegment("backupFabrics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	if resourceId.ProtectionContainerName, err = id.PopSegment("protectionContainers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("protectedItems"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protected Item ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesProtectedItemID validates that the specified value is a Recovery Services Protected Item ID
+func ValidateRecoveryServicesProtectedItemID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRecoveryServicesProtectedItemID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Recovery Services Protected Item ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
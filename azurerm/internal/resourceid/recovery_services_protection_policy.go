@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesProtectionPolicyID is a parsed Resource ID for a Recovery Services Protection Policy
+type RecoveryServicesProtectionPolicyID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VaultName      string
+	Name           string
+}
+
+// NewRecoveryServicesProtectionPolicyID returns a new RecoveryServicesProtectionPolicyID from the specified components
+func NewRecoveryServicesProtectionPolicyID(subscriptionId, resourceGroup, vaultName, name string) RecoveryServicesProtectionPolicyID {
+	return RecoveryServicesProtectionPolicyID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Recovery Services Protection Policy
+func (id RecoveryServicesProtectionPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+// ParseRecoveryServicesProtectionPolicyID parses the specified Resource ID into a RecoveryServicesProtectionPolicyID, returning an error
+// if the Resource ID isn't for a Recovery Services Protection Policy
+func ParseRecoveryServicesProtectionPolicyID(input string) (*RecoveryServicesProtectionPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protection Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protection Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesProtectionPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protection Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backupPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protection Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Protection Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesProtectionPolicyID validates that the specified value is a Recovery Services Protection Policy ID
+func ValidateRecoveryServicesProtectionPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRecoveryServicesProtectionPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Recovery Services Protection Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
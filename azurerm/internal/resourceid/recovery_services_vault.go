@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesVaultID is a parsed Resource ID for a Recovery Services Vault
+type RecoveryServicesVaultID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRecoveryServicesVaultID returns a new RecoveryServicesVaultID from the specified components
+func NewRecoveryServicesVaultID(subscriptionId, resourceGroup, name string) RecoveryServicesVaultID {
+	return RecoveryServicesVaultID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Recovery Services Vault
+func (id RecoveryServicesVaultID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseRecoveryServicesVaultID parses the specified Resource ID into a RecoveryServicesVaultID, returning an error
+// if the Resource ID isn't for a Recovery Services Vault
+func ParseRecoveryServicesVaultID(input string) (*RecoveryServicesVaultID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Vault ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing Recovery Services Vault ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesVaultID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Vault ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Recovery Services Vault ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesVaultID validates that the specified value is a Recovery Services Vault ID
+func ValidateRecoveryServicesVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRecoveryServicesVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Recovery Services Vault ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RedisCacheID is a parsed Resource ID for a Redis Cache
+type RedisCacheID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRedisCacheID returns a new RedisCacheID from the specified components
+func NewRedisCacheID(subscriptionId, resourceGroup, name string) RedisCacheID {
+	return RedisCacheID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Redis Cache
+func (id RedisCacheID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseRedisCacheID parses the specified Resource ID into a RedisCacheID, returning an error
+// if the Resource ID isn't for a Redis Cache
+func ParseRedisCacheID(input string) (*RedisCacheID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Cache ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cache") {
+		return nil, fmt.Errorf("Error parsing Redis Cache ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Cache", id.Provider)
+	}
+
+	resourceId := RedisCacheID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("Redis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Cache ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Cache ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRedisCacheID validates that the specified value is a Redis Cache ID
+func ValidateRedisCacheID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRedisCacheID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Redis Cache ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
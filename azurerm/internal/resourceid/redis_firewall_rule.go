@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RedisFirewallRuleID is a parsed Resource ID for a Redis Firewall Rule
+type RedisFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RedisName      string
+	Name           string
+}
+
+// NewRedisFirewallRuleID returns a new RedisFirewallRuleID from the specified components
+func NewRedisFirewallRuleID(subscriptionId, resourceGroup, redisName, name string) RedisFirewallRuleID {
+	return RedisFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RedisName:      redisName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Redis Firewall Rule
+func (id RedisFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisName, id.Name)
+}
+
+// ParseRedisFirewallRuleID parses the specified Resource ID into a RedisFirewallRuleID, returning an error
+// if the Resource ID isn't for a Redis Firewall Rule
+func ParseRedisFirewallRuleID(input string) (*RedisFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cache") {
+		return nil, fmt.Errorf("Error parsing Redis Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Cache", id.Provider)
+	}
+
+	resourceId := RedisFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.RedisName, err = id.PopSegment("Redis"); err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Redis Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRedisFirewallRuleID validates that the specified value is a Redis Firewall Rule ID
+func ValidateRedisFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRedisFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Redis Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
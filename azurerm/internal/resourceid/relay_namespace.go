@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RelayNamespaceID is a parsed Resource ID for a Relay Namespace
+type RelayNamespaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRelayNamespaceID returns a new RelayNamespaceID from the specified components
+func NewRelayNamespaceID(subscriptionId, resourceGroup, name string) RelayNamespaceID {
+	return RelayNamespaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Relay Namespace
+func (id RelayNamespaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Relay/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseRelayNamespaceID parses the specified Resource ID into a RelayNamespaceID, returning an error
+// if the Resource ID isn't for a Relay Namespace
+func ParseRelayNamespaceID(input string) (*RelayNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Relay Namespace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Relay") {
+		return nil, fmt.Errorf("Error parsing Relay Namespace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Relay", id.Provider)
+	}
+
+	resourceId := RelayNamespaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Relay Namespace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Relay Namespace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRelayNamespaceID validates that the specified value is a Relay Namespace ID
+func ValidateRelayNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRelayNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Relay Namespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,68 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ResourceGroupID is a parsed Resource ID for a Resource Group
+type ResourceGroupID struct {
+	SubscriptionId string
+	Name           string
+}
+
+// NewResourceGroupID returns a new ResourceGroupID from the specified components
+func NewResourceGroupID(subscriptionId, name string) ResourceGroupID {
+	return ResourceGroupID{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Resource Group
+func (id ResourceGroupID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// ParseResourceGroupID parses the specified Resource ID into a ResourceGroupID, returning an error
+// if the Resource ID isn't for a Resource Group
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: expected no Resource Provider but got %q", input, id.Provider)
+	}
+
+	resourceId := ResourceGroupID{
+		SubscriptionId: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Resource Group ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateResourceGroupID validates that the specified value is a Resource Group ID
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseResourceGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Resource Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,317 @@
+package resourceid
+
+// This package contains strongly typed Resource ID's (which can be Parsed, Formatted and Validated)
+// which are generated via the generator in `./generator` - to add a new Resource ID, add a new
+// `go:generate` line below and then run `make generate` from the root of the repository.
+//
+// Every Resource and Data Source parses both its own Resource ID and the ID's of any other Resources
+// it references using the types in this package. The few Resource ID's which can't be generated (as
+// they contain an optional or variable segment) are defined by hand alongside these - for example
+// `KeyVaultAccessPolicyID` and `TrafficManagerEndpointID`.
+//
+// `azure.ParseAzureResourceID` should only be used directly for:
+// - the ID's of nested sub-resource blocks returned by the API (such as the Frontend IP Configurations
+//   within a Load Balancer or the child blocks of an Application Gateway), which aren't Resources
+// - Resource ID's of an arbitrary type (such as the `azurerm_resources` Data Source)
+// - the `helpers/azure` package, which this package depends on
+
+// Resources
+//go:generate go run ./generator -name ResourceGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1
+//go:generate go run ./generator -name TemplateDeployment -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Resources/deployments/deployment1
+
+// Analysis Services
+//go:generate go run ./generator -name AnalysisServicesServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/server1
+
+// API Management
+//go:generate go run ./generator -name ApiManagement -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1
+//go:generate go run ./generator -name ApiManagementApi -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1
+//go:generate go run ./generator -name ApiManagementApiOperation -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1
+//go:generate go run ./generator -name ApiManagementApiOperationPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy
+//go:generate go run ./generator -name ApiManagementApiPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/policies/policy
+//go:generate go run ./generator -name ApiManagementApiSchema -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/schemas/schema1
+//go:generate go run ./generator -name ApiManagementApiVersionSet -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/api-version-sets/set1
+//go:generate go run ./generator -name ApiManagementAuthorizationServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/authorizationServers/server1
+//go:generate go run ./generator -name ApiManagementBackend -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1
+//go:generate go run ./generator -name ApiManagementCertificate -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/certificates/certificate1
+//go:generate go run ./generator -name ApiManagementGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1
+//go:generate go run ./generator -name ApiManagementGroupUser -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/user1
+//go:generate go run ./generator -name ApiManagementLogger -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/loggers/logger1
+//go:generate go run ./generator -name ApiManagementOpenIDConnectProvider -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/openidConnectProviders/provider1
+//go:generate go run ./generator -name ApiManagementProduct -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1
+//go:generate go run ./generator -name ApiManagementProductApi -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/api1
+//go:generate go run ./generator -name ApiManagementProductGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1
+//go:generate go run ./generator -name ApiManagementProductPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/policy
+//go:generate go run ./generator -name ApiManagementProperty -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/properties/property1
+//go:generate go run ./generator -name ApiManagementSubscription -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/subscriptions/subscription1
+//go:generate go run ./generator -name ApiManagementUser -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/users/user1
+
+// App Service
+//go:generate go run ./generator -name AppService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1
+//go:generate go run ./generator -name AppServiceCustomHostnameBinding -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1
+//go:generate go run ./generator -name AppServicePlan -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1
+//go:generate go run ./generator -name AppServiceSlot -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1
+//go:generate go run ./generator -name FunctionApp -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1
+
+// Application Insights
+//go:generate go run ./generator -name ApplicationInsights -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/components/component1
+//go:generate go run ./generator -name ApplicationInsightsAPIKey -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/components/component1/apikeys/key1
+//go:generate go run ./generator -name ApplicationInsightsWebtest -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/webtests/test1
+
+// Automation
+//go:generate go run ./generator -name AutomationAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1
+//go:generate go run ./generator -name AutomationCredential -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/credentials/credential1
+//go:generate go run ./generator -name AutomationDscConfiguration -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/configurations/configuration1
+//go:generate go run ./generator -name AutomationDscNodeConfiguration -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/nodeConfigurations/configuration1
+//go:generate go run ./generator -name AutomationModule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/modules/module1
+//go:generate go run ./generator -name AutomationRunbook -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/runbooks/runbook1
+//go:generate go run ./generator -name AutomationSchedule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/schedules/schedule1
+//go:generate go run ./generator -name AutomationVariable -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/variables/variable1
+
+// Batch
+//go:generate go run ./generator -name BatchAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1
+//go:generate go run ./generator -name BatchApplication -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/applications/application1
+//go:generate go run ./generator -name BatchCertificate -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/certificates/sha1-42c107874fd0e4a9583292a2f1098e8fe4b2edda
+//go:generate go run ./generator -name BatchPool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/pools/pool1
+
+// CDN
+//go:generate go run ./generator -name CdnEndpoint -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1
+//go:generate go run ./generator -name CdnProfile -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1
+
+// Cognitive Services
+//go:generate go run ./generator -name CognitiveAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CognitiveServices/accounts/account1
+
+// Compute
+//go:generate go run ./generator -name AvailabilitySet -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/availabilitySets/set1
+//go:generate go run ./generator -name DedicatedHost -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/group1/hosts/host1
+//go:generate go run ./generator -name DedicatedHostGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/group1
+//go:generate go run ./generator -name DiskEncryptionSet -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskEncryptionSets/set1
+//go:generate go run ./generator -name Image -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/images/image1
+//go:generate go run ./generator -name ManagedDisk -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1
+//go:generate go run ./generator -name ProximityPlacementGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/proximityPlacementGroups/group1
+//go:generate go run ./generator -name SharedImage -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1
+//go:generate go run ./generator -name SharedImageGallery -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1
+//go:generate go run ./generator -name SharedImageVersion -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/1.2.3
+//go:generate go run ./generator -name Snapshot -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/snapshot1
+//go:generate go run ./generator -name VirtualMachine -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1
+//go:generate go run ./generator -name VirtualMachineDataDiskAttachment -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/dataDisks/disk1
+//go:generate go run ./generator -name VirtualMachineExtension -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/extensions/extension1
+//go:generate go run ./generator -name VirtualMachineScaleSet -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1
+
+// Containers
+//go:generate go run ./generator -name ContainerGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerInstance/containerGroups/group1
+//go:generate go run ./generator -name ContainerRegistry -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1
+//go:generate go run ./generator -name ContainerService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/containerServices/service1
+//go:generate go run ./generator -name KubernetesCluster -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1
+//go:generate go run ./generator -name KubernetesClusterNodePool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1
+
+// CosmosDB
+//go:generate go run ./generator -name CosmosDBAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1
+
+// Data Factory
+//go:generate go run ./generator -name DataFactory -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1
+//go:generate go run ./generator -name DataFactoryDataset -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/datasets/dataset1
+//go:generate go run ./generator -name DataFactoryLinkedService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/service1
+//go:generate go run ./generator -name DataFactoryPipeline -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/pipelines/pipeline1
+
+// Data Lake
+//go:generate go run ./generator -name DataLakeAnalyticsAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeAnalytics/accounts/account1
+//go:generate go run ./generator -name DataLakeAnalyticsFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeAnalytics/accounts/account1/firewallRules/rule1
+//go:generate go run ./generator -name DataLakeStore -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1
+//go:generate go run ./generator -name DataLakeStoreFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1/firewallRules/rule1
+
+// Databricks
+//go:generate go run ./generator -name DatabricksWorkspace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Databricks/workspaces/workspace1
+
+// DevSpaces
+//go:generate go run ./generator -name DevSpaceController -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevSpaces/controllers/controller1
+
+// DevTest Labs
+//go:generate go run ./generator -name DevTestLab -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1
+//go:generate go run ./generator -name DevTestPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/policysets/default/policies/policy1
+//go:generate go run ./generator -name DevTestSchedule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/schedules/schedule1
+//go:generate go run ./generator -name DevTestVirtualMachine -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/virtualmachines/machine1
+//go:generate go run ./generator -name DevTestVirtualNetwork -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/virtualnetworks/network1
+
+// DNS
+//go:generate go run ./generator -name DnsAAAARecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/record1
+//go:generate go run ./generator -name DnsARecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/A/record1
+//go:generate go run ./generator -name DnsCAARecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CAA/record1
+//go:generate go run ./generator -name DnsCnameRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CNAME/record1
+//go:generate go run ./generator -name DnsMXRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/record1
+//go:generate go run ./generator -name DnsNSRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/NS/record1
+//go:generate go run ./generator -name DnsPTRRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/PTR/record1
+//go:generate go run ./generator -name DnsSRVRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/SRV/record1
+//go:generate go run ./generator -name DnsTXTRecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/TXT/record1
+//go:generate go run ./generator -name DnsZone -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1
+
+// EventGrid
+//go:generate go run ./generator -name EventGridDomain -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1
+//go:generate go run ./generator -name EventGridTopic -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/topics/topic1
+
+// EventHub
+//go:generate go run ./generator -name EventHub -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1
+//go:generate go run ./generator -name EventHubAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/authorizationRules/rule1
+//go:generate go run ./generator -name EventHubConsumerGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/group1
+//go:generate go run ./generator -name EventHubNamespace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1
+//go:generate go run ./generator -name EventHubNamespaceAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/AuthorizationRules/rule1
+
+// HDInsight
+//go:generate go run ./generator -name HDInsightCluster -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1
+
+// IoT
+//go:generate go run ./generator -name IotDps -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/provisioningServices/service1
+//go:generate go run ./generator -name IotDpsCertificate -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/provisioningServices/service1/certificates/certificate1
+//go:generate go run ./generator -name IotHub -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1
+//go:generate go run ./generator -name IotHubConsumerGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/eventHubEndpoints/events/ConsumerGroups/group1
+//go:generate go run ./generator -name IotHubSharedAccessPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/IotHubKeys/policy1
+
+// Key Vault
+//go:generate go run ./generator -name KeyVault -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1
+
+// Log Analytics
+//go:generate go run ./generator -name LogAnalyticsLinkedService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedservices/automation
+//go:generate go run ./generator -name LogAnalyticsSolution -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationsManagement/solutions/solution1
+//go:generate go run ./generator -name LogAnalyticsWorkspace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1
+
+// Logic Apps
+//go:generate go run ./generator -name LogicAppAction -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/actions/action1
+//go:generate go run ./generator -name LogicAppTrigger -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/triggers/trigger1
+//go:generate go run ./generator -name LogicAppWorkflow -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1
+
+// Managed Identity
+//go:generate go run ./generator -name UserAssignedIdentity -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1
+
+// Maps
+//go:generate go run ./generator -name MapsAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1
+
+// MariaDB
+//go:generate go run ./generator -name MariaDBDatabase -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1/databases/database1
+//go:generate go run ./generator -name MariaDBFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1/firewallRules/rule1
+//go:generate go run ./generator -name MariaDBServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1
+
+// Media
+//go:generate go run ./generator -name MediaServicesAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1
+
+// Monitor
+//go:generate go run ./generator -name MonitorActionGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/actionGroups/group1
+//go:generate go run ./generator -name MonitorActivityLogAlert -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/activityLogAlerts/alert1
+//go:generate go run ./generator -name MonitorAutoscaleSetting -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/autoscalesettings/setting1
+//go:generate go run ./generator -name MonitorMetricAlert -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/metricAlerts/alert1
+//go:generate go run ./generator -name MonitorMetricAlertRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/alertrules/rule1
+
+// MySQL
+//go:generate go run ./generator -name MySQLConfiguration -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/configurations/configuration1
+//go:generate go run ./generator -name MySQLDatabase -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/databases/database1
+//go:generate go run ./generator -name MySQLFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/firewallRules/rule1
+//go:generate go run ./generator -name MySQLServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1
+//go:generate go run ./generator -name MySQLVirtualNetworkRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/virtualNetworkRules/rule1
+
+// Network
+//go:generate go run ./generator -name ApplicationGateway -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/gateway1
+//go:generate go run ./generator -name ApplicationGatewayBackendAddressPool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1
+//go:generate go run ./generator -name ApplicationSecurityGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/group1
+//go:generate go run ./generator -name ConnectionMonitor -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/monitor1
+//go:generate go run ./generator -name DdosProtectionPlan -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/ddosProtectionPlans/plan1
+//go:generate go run ./generator -name ExpressRouteCircuit -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1
+//go:generate go run ./generator -name ExpressRouteCircuitAuthorization -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/authorizations/authorization1
+//go:generate go run ./generator -name ExpressRouteCircuitPeering -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/peerings/AzurePrivatePeering
+//go:generate go run ./generator -name Firewall -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1
+//go:generate go run ./generator -name FirewallApplicationRuleCollection -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/applicationRuleCollections/collection1
+//go:generate go run ./generator -name FirewallNatRuleCollection -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/natRuleCollections/collection1
+//go:generate go run ./generator -name FirewallNetworkRuleCollection -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/networkRuleCollections/collection1
+//go:generate go run ./generator -name LoadBalancer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1
+//go:generate go run ./generator -name LoadBalancerBackendAddressPool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/pool1
+//go:generate go run ./generator -name LoadBalancerInboundNatPool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatPools/pool1
+//go:generate go run ./generator -name LoadBalancerInboundNatRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatRules/rule1
+//go:generate go run ./generator -name LoadBalancerOutboundRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/outboundRules/rule1
+//go:generate go run ./generator -name LoadBalancerProbe -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/probes/probe1
+//go:generate go run ./generator -name LoadBalancerRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/loadBalancingRules/rule1
+//go:generate go run ./generator -name LocalNetworkGateway -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/localNetworkGateways/gateway1
+//go:generate go run ./generator -name NetworkInterface -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1
+//go:generate go run ./generator -name NetworkInterfaceIPConfiguration -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1
+//go:generate go run ./generator -name NetworkProfile -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkProfiles/profile1
+//go:generate go run ./generator -name NetworkSecurityGroup -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/group1
+//go:generate go run ./generator -name NetworkSecurityRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1
+//go:generate go run ./generator -name NetworkWatcher -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1
+//go:generate go run ./generator -name PacketCapture -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1
+//go:generate go run ./generator -name PublicIP -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/address1
+//go:generate go run ./generator -name PublicIPPrefix -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPPrefixes/prefix1
+//go:generate go run ./generator -name Route -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/table1/routes/route1
+//go:generate go run ./generator -name RouteTable -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/table1
+//go:generate go run ./generator -name Subnet -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1
+//go:generate go run ./generator -name VirtualNetwork -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1
+//go:generate go run ./generator -name VirtualNetworkGateway -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gateway1
+//go:generate go run ./generator -name VirtualNetworkGatewayConnection -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/connections/connection1
+//go:generate go run ./generator -name VirtualNetworkPeering -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/virtualNetworkPeerings/peering1
+
+// Notification Hubs
+//go:generate go run ./generator -name NotificationHub -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1
+//go:generate go run ./generator -name NotificationHubAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1/AuthorizationRules/rule1
+//go:generate go run ./generator -name NotificationHubNamespace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1
+
+// PostgreSQL
+//go:generate go run ./generator -name PostgreSQLConfiguration -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/configurations/configuration1
+//go:generate go run ./generator -name PostgreSQLDatabase -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/databases/database1
+//go:generate go run ./generator -name PostgreSQLFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/firewallRules/rule1
+//go:generate go run ./generator -name PostgreSQLServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1
+//go:generate go run ./generator -name PostgreSQLVirtualNetworkRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/virtualNetworkRules/rule1
+
+// Private DNS
+//go:generate go run ./generator -name PrivateDnsARecord -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1/A/record1
+//go:generate go run ./generator -name PrivateDnsZone -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1
+
+// Recovery Services
+//go:generate go run ./generator -name RecoveryServicesProtectedItem -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/item1
+//go:generate go run ./generator -name RecoveryServicesProtectionPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/policy1
+//go:generate go run ./generator -name RecoveryServicesVault -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1
+
+// Redis
+//go:generate go run ./generator -name RedisCache -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/cache1
+//go:generate go run ./generator -name RedisFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/cache1/firewallRules/rule1
+
+// Relay
+//go:generate go run ./generator -name RelayNamespace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1
+
+// Scheduler
+//go:generate go run ./generator -name SchedulerJob -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Scheduler/jobCollections/collection1/jobs/job1
+//go:generate go run ./generator -name SchedulerJobCollection -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Scheduler/jobCollections/collection1
+
+// Search
+//go:generate go run ./generator -name SearchService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1
+
+// Service Bus
+//go:generate go run ./generator -name ServiceBusNamespace -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1
+//go:generate go run ./generator -name ServiceBusNamespaceAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/rule1
+//go:generate go run ./generator -name ServiceBusQueue -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1
+//go:generate go run ./generator -name ServiceBusQueueAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1/authorizationRules/rule1
+//go:generate go run ./generator -name ServiceBusSubscription -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1
+//go:generate go run ./generator -name ServiceBusSubscriptionRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1/rules/rule1
+//go:generate go run ./generator -name ServiceBusTopic -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1
+//go:generate go run ./generator -name ServiceBusTopicAuthorizationRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/authorizationRules/rule1
+
+// Service Fabric
+//go:generate go run ./generator -name ServiceFabricCluster -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabric/clusters/cluster1
+
+// SignalR
+//go:generate go run ./generator -name SignalRService -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.SignalRService/SignalR/service1
+
+// SQL
+//go:generate go run ./generator -name SqlActiveDirectoryAdministrator -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/administrators/activeDirectory
+//go:generate go run ./generator -name SqlDatabase -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/databases/database1
+//go:generate go run ./generator -name SqlElasticPool -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1
+//go:generate go run ./generator -name SqlFirewallRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1
+//go:generate go run ./generator -name SqlServer -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1
+//go:generate go run ./generator -name SqlVirtualNetworkRule -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/virtualNetworkRules/rule1
+
+// Storage
+//go:generate go run ./generator -name StorageAccount -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1
+//go:generate go run ./generator -name StorageManagementPolicy -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/managementPolicies/default
+
+// Stream Analytics
+//go:generate go run ./generator -name StreamAnalyticsFunction -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/functions/function1
+//go:generate go run ./generator -name StreamAnalyticsInput -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/inputs/input1
+//go:generate go run ./generator -name StreamAnalyticsJob -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1
+//go:generate go run ./generator -name StreamAnalyticsOutput -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/output1
+
+// Traffic Manager
+//go:generate go run ./generator -name TrafficManagerProfile -id /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1
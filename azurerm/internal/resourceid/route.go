@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RouteID is a parsed Resource ID for a Route
+type RouteID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+// NewRouteID returns a new RouteID from the specified components
+func NewRouteID(subscriptionId, resourceGroup, routeTableName, name string) RouteID {
+	return RouteID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Route
+func (id RouteID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ParseRouteID parses the specified Resource ID into a RouteID, returning an error
+// if the Resource ID isn't for a Route
+func ParseRouteID(input string) (*RouteID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Route ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Route ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteID validates that the specified value is a Route ID
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseRouteID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Route ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
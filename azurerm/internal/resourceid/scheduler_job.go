@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SchedulerJobID is a parsed Resource ID for a Scheduler Job
+type SchedulerJobID struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	JobCollectionName string
+	Name              string
+}
+
+// NewSchedulerJobID returns a new SchedulerJobID from the specified components
+func NewSchedulerJobID(subscriptionId, resourceGroup, jobCollectionName, name string) SchedulerJobID {
+	return SchedulerJobID{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		JobCollectionName: jobCollectionName,
+		Name:              name,
+	}
+}
+
+// String returns the Resource ID for this Scheduler Job
+func (id SchedulerJobID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s/jobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.JobCollectionName, id.Name)
+}
+
+// ParseSchedulerJobID parses the specified Resource ID into a SchedulerJobID, returning an error
+// if the Resource ID isn't for a Scheduler Job
+func ParseSchedulerJobID(input string) (*SchedulerJobID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Scheduler") {
+		return nil, fmt.Errorf("Error parsing Scheduler Job ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Scheduler", id.Provider)
+	}
+
+	resourceId := SchedulerJobID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.JobCollectionName, err = id.PopSegment("jobCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSchedulerJobID validates that the specified value is a Scheduler Job ID
+func ValidateSchedulerJobID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSchedulerJobID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Scheduler Job ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
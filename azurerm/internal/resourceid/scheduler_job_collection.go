@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SchedulerJobCollectionID is a parsed Resource ID for a Scheduler Job Collection
+type SchedulerJobCollectionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSchedulerJobCollectionID returns a new SchedulerJobCollectionID from the specified components
+func NewSchedulerJobCollectionID(subscriptionId, resourceGroup, name string) SchedulerJobCollectionID {
+	return SchedulerJobCollectionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Scheduler Job Collection
+func (id SchedulerJobCollectionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSchedulerJobCollectionID parses the specified Resource ID into a SchedulerJobCollectionID, returning an error
+// if the Resource ID isn't for a Scheduler Job Collection
+func ParseSchedulerJobCollectionID(input string) (*SchedulerJobCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job Collection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Scheduler") {
+		return nil, fmt.Errorf("Error parsing Scheduler Job Collection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Scheduler", id.Provider)
+	}
+
+	resourceId := SchedulerJobCollectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("jobCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job Collection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Scheduler Job Collection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSchedulerJobCollectionID validates that the specified value is a Scheduler Job Collection ID
+func ValidateSchedulerJobCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSchedulerJobCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Scheduler Job Collection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
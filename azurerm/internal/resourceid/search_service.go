@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SearchServiceID is a parsed Resource ID for a Search Service
+type SearchServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSearchServiceID returns a new SearchServiceID from the specified components
+func NewSearchServiceID(subscriptionId, resourceGroup, name string) SearchServiceID {
+	return SearchServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Search Service
+func (id SearchServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSearchServiceID parses the specified Resource ID into a SearchServiceID, returning an error
+// if the Resource ID isn't for a Search Service
+func ParseSearchServiceID(input string) (*SearchServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Search Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Search") {
+		return nil, fmt.Errorf("Error parsing Search Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Search", id.Provider)
+	}
+
+	resourceId := SearchServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("searchServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing Search Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Search Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSearchServiceID validates that the specified value is a Search Service ID
+func ValidateSearchServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSearchServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Search Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusNamespaceID is a parsed Resource ID for a Service Bus Namespace
+type ServiceBusNamespaceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceBusNamespaceID returns a new ServiceBusNamespaceID from the specified components
+func NewServiceBusNamespaceID(subscriptionId, resourceGroup, name string) ServiceBusNamespaceID {
+	return ServiceBusNamespaceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Namespace
+func (id ServiceBusNamespaceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceBusNamespaceID parses the specified Resource ID into a ServiceBusNamespaceID, returning an error
+// if the Resource ID isn't for a Service Bus Namespace
+func ParseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusNamespaceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusNamespaceID validates that the specified value is a Service Bus Namespace ID
+func ValidateServiceBusNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Namespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
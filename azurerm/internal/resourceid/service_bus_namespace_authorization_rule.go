@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusNamespaceAuthorizationRuleID is a parsed Resource ID for a Service Bus Namespace Authorization Rule
+type ServiceBusNamespaceAuthorizationRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusNamespaceAuthorizationRuleID returns a new ServiceBusNamespaceAuthorizationRuleID from the specified components
+func NewServiceBusNamespaceAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusNamespaceAuthorizationRuleID {
+	return ServiceBusNamespaceAuthorizationRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Namespace Authorization Rule
+func (id ServiceBusNamespaceAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/AuthorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusNamespaceAuthorizationRuleID parses the specified Resource ID into a ServiceBusNamespaceAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Service Bus Namespace Authorization Rule
+func ParseServiceBusNamespaceAuthorizationRuleID(input string) (*ServiceBusNamespaceAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusNamespaceAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AuthorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Namespace Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusNamespaceAuthorizationRuleID validates that the specified value is a Service Bus Namespace Authorization Rule ID
+func ValidateServiceBusNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusNamespaceAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Namespace Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
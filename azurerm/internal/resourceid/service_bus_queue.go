@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusQueueID is a parsed Resource ID for a Service Bus Queue
+type ServiceBusQueueID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusQueueID returns a new ServiceBusQueueID from the specified components
+func NewServiceBusQueueID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusQueueID {
+	return ServiceBusQueueID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Queue
+func (id ServiceBusQueueID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusQueueID parses the specified Resource ID into a ServiceBusQueueID, returning an error
+// if the Resource ID isn't for a Service Bus Queue
+func ParseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusQueueID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("queues"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusQueueID validates that the specified value is a Service Bus Queue ID
+func ValidateServiceBusQueueID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusQueueID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Queue ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusQueueAuthorizationRuleID is a parsed Resource ID for a Service Bus Queue Authorization Rule
+type ServiceBusQueueAuthorizationRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	QueueName      string
+	Name           string
+}
+
+// NewServiceBusQueueAuthorizationRuleID returns a new ServiceBusQueueAuthorizationRuleID from the specified components
+func NewServiceBusQueueAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, queueName, name string) ServiceBusQueueAuthorizationRuleID {
+	return ServiceBusQueueAuthorizationRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		QueueName:      queueName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Queue Authorization Rule
+func (id ServiceBusQueueAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.QueueName, id.Name)
+}
+
+// ParseServiceBusQueueAuthorizationRuleID parses the specified Resource ID into a ServiceBusQueueAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Service Bus Queue Authorization Rule
+func ParseServiceBusQueueAuthorizationRuleID(input string) (*ServiceBusQueueAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusQueueAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.QueueName, err = id.PopSegment("queues"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Queue Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusQueueAuthorizationRuleID validates that the specified value is a Service Bus Queue Authorization Rule ID
+func ValidateServiceBusQueueAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusQueueAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Queue Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
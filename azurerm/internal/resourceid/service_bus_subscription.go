@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusSubscriptionID is a parsed Resource ID for a Service Bus Subscription
+type ServiceBusSubscriptionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+// NewServiceBusSubscriptionID returns a new ServiceBusSubscriptionID from the specified components
+func NewServiceBusSubscriptionID(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusSubscriptionID {
+	return ServiceBusSubscriptionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Subscription
+func (id ServiceBusSubscriptionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ParseServiceBusSubscriptionID parses the specified Resource ID into a ServiceBusSubscriptionID, returning an error
+// if the Resource ID isn't for a Service Bus Subscription
+func ParseServiceBusSubscriptionID(input string) (*ServiceBusSubscriptionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusSubscriptionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: %+v", input, err)
+	}
+
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusSubscriptionID validates that the specified value is a Service Bus Subscription ID
+func ValidateServiceBusSubscriptionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusSubscriptionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Subscription ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,93 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusSubscriptionRuleID is a parsed Resource ID for a Service Bus Subscription Rule
+type ServiceBusSubscriptionRuleID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	NamespaceName    string
+	TopicName        string
+	SubscriptionName string
+	Name             string
+}
+
+// NewServiceBusSubscriptionRuleID returns a new ServiceBusSubscriptionRuleID from the specified components
+func NewServiceBusSubscriptionRuleID(subscriptionId, resourceGroup, namespaceName, topicName, subscriptionName, name string) ServiceBusSubscriptionRuleID {
+	return ServiceBusSubscriptionRuleID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		NamespaceName:    namespaceName,
+		TopicName:        topicName,
+		SubscriptionName: subscriptionName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Subscription Rule
+func (id ServiceBusSubscriptionRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s/rules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.Name)
+}
+
+// ParseServiceBusSubscriptionRuleID parses the specified Resource ID into a ServiceBusSubscriptionRuleID, returning an error
+// if the Resource ID isn't for a Service Bus Subscription Rule
+func ParseServiceBusSubscriptionRuleID(input string) (*ServiceBusSubscriptionRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusSubscriptionRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("rules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Subscription Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusSubscriptionRuleID validates that the specified value is a Service Bus Subscription Rule ID
+func ValidateServiceBusSubscriptionRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusSubscriptionRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Subscription Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusTopicID is a parsed Resource ID for a Service Bus Topic
+type ServiceBusTopicID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusTopicID returns a new ServiceBusTopicID from the specified components
+func NewServiceBusTopicID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusTopicID {
+	return ServiceBusTopicID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Topic
+func (id ServiceBusTopicID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusTopicID parses the specified Resource ID into a ServiceBusTopicID, returning an error
+// if the Resource ID isn't for a Service Bus Topic
+func ParseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusTopicID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusTopicID validates that the specified value is a Service Bus Topic ID
+func ValidateServiceBusTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusTopicID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Topic ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusTopicAuthorizationRuleID is a parsed Resource ID for a Service Bus Topic Authorization Rule
+type ServiceBusTopicAuthorizationRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+// NewServiceBusTopicAuthorizationRuleID returns a new ServiceBusTopicAuthorizationRuleID from the specified components
+func NewServiceBusTopicAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusTopicAuthorizationRuleID {
+	return ServiceBusTopicAuthorizationRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Bus Topic Authorization Rule
+func (id ServiceBusTopicAuthorizationRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ParseServiceBusTopicAuthorizationRuleID parses the specified Resource ID into a ServiceBusTopicAuthorizationRuleID, returning an error
+// if the Resource ID isn't for a Service Bus Topic Authorization Rule
+func ParseServiceBusTopicAuthorizationRuleID(input string) (*ServiceBusTopicAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusTopicAuthorizationRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Bus Topic Authorization Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusTopicAuthorizationRuleID validates that the specified value is a Service Bus Topic Authorization Rule ID
+func ValidateServiceBusTopicAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceBusTopicAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Bus Topic Authorization Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceFabricClusterID is a parsed Resource ID for a Service Fabric Cluster
+type ServiceFabricClusterID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceFabricClusterID returns a new ServiceFabricClusterID from the specified components
+func NewServiceFabricClusterID(subscriptionId, resourceGroup, name string) ServiceFabricClusterID {
+	return ServiceFabricClusterID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Service Fabric Cluster
+func (id ServiceFabricClusterID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabric/clusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceFabricClusterID parses the specified Resource ID into a ServiceFabricClusterID, returning an error
+// if the Resource ID isn't for a Service Fabric Cluster
+func ParseServiceFabricClusterID(input string) (*ServiceFabricClusterID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Service Fabric Cluster ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceFabric") {
+		return nil, fmt.Errorf("Error parsing Service Fabric Cluster ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ServiceFabric", id.Provider)
+	}
+
+	resourceId := ServiceFabricClusterID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("clusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Fabric Cluster ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Service Fabric Cluster ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceFabricClusterID validates that the specified value is a Service Fabric Cluster ID
+func ValidateServiceFabricClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseServiceFabricClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Service Fabric Cluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
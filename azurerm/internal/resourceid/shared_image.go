@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageID is a parsed Resource ID for a Shared Image
+type SharedImageID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	Name           string
+}
+
+// NewSharedImageID returns a new SharedImageID from the specified components
+func NewSharedImageID(subscriptionId, resourceGroup, galleryName, name string) SharedImageID {
+	return SharedImageID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Shared Image
+func (id SharedImageID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+// ParseSharedImageID parses the specified Resource ID into a SharedImageID, returning an error
+// if the Resource ID isn't for a Shared Image
+func ParseSharedImageID(input string) (*SharedImageID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageID validates that the specified value is a Shared Image ID
+func ValidateSharedImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSharedImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Shared Image ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageGalleryID is a parsed Resource ID for a Shared Image Gallery
+type SharedImageGalleryID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSharedImageGalleryID returns a new SharedImageGalleryID from the specified components
+func NewSharedImageGalleryID(subscriptionId, resourceGroup, name string) SharedImageGalleryID {
+	return SharedImageGalleryID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Shared Image Gallery
+func (id SharedImageGalleryID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSharedImageGalleryID parses the specified Resource ID into a SharedImageGalleryID, returning an error
+// if the Resource ID isn't for a Shared Image Gallery
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Gallery ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Shared Image Gallery ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageGalleryID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Gallery ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Gallery ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageGalleryID validates that the specified value is a Shared Image Gallery ID
+func ValidateSharedImageGalleryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSharedImageGalleryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Shared Image Gallery ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
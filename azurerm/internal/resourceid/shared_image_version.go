@@ -0,0 +1,87 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageVersionID is a parsed Resource ID for a Shared Image Version
+type SharedImageVersionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	Name           string
+}
+
+// NewSharedImageVersionID returns a new SharedImageVersionID from the specified components
+func NewSharedImageVersionID(subscriptionId, resourceGroup, galleryName, imageName, name string) SharedImageVersionID {
+	return SharedImageVersionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Shared Image Version
+func (id SharedImageVersionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+// ParseSharedImageVersionID parses the specified Resource ID into a SharedImageVersionID, returning an error
+// if the Resource ID isn't for a Shared Image Version
+func ParseSharedImageVersionID(input string) (*SharedImageVersionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageVersionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("versions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Shared Image Version ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageVersionID validates that the specified value is a Shared Image Version ID
+func ValidateSharedImageVersionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSharedImageVersionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Shared Image Version ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
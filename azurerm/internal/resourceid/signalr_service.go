@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SignalRServiceID is a parsed Resource ID for a SignalR Service
+type SignalRServiceID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSignalRServiceID returns a new SignalRServiceID from the specified components
+func NewSignalRServiceID(subscriptionId, resourceGroup, name string) SignalRServiceID {
+	return SignalRServiceID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this SignalR Service
+func (id SignalRServiceID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SignalRService/SignalR/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSignalRServiceID parses the specified Resource ID into a SignalRServiceID, returning an error
+// if the Resource ID isn't for a SignalR Service
+func ParseSignalRServiceID(input string) (*SignalRServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing SignalR Service ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.SignalRService") {
+		return nil, fmt.Errorf("Error parsing SignalR Service ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.SignalRService", id.Provider)
+	}
+
+	resourceId := SignalRServiceID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("SignalR"); err != nil {
+		return nil, fmt.Errorf("Error parsing SignalR Service ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing SignalR Service ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSignalRServiceID validates that the specified value is a SignalR Service ID
+func ValidateSignalRServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSignalRServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid SignalR Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SnapshotID is a parsed Resource ID for a Snapshot
+type SnapshotID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSnapshotID returns a new SnapshotID from the specified components
+func NewSnapshotID(subscriptionId, resourceGroup, name string) SnapshotID {
+	return SnapshotID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Snapshot
+func (id SnapshotID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/snapshots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSnapshotID parses the specified Resource ID into a SnapshotID, returning an error
+// if the Resource ID isn't for a Snapshot
+func ParseSnapshotID(input string) (*SnapshotID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Snapshot ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Snapshot ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SnapshotID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("snapshots"); err != nil {
+		return nil, fmt.Errorf("Error parsing Snapshot ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Snapshot ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSnapshotID validates that the specified value is a Snapshot ID
+func ValidateSnapshotID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSnapshotID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Snapshot ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlActiveDirectoryAdministratorID is a parsed Resource ID for a Sql Active Directory Administrator
+type SqlActiveDirectoryAdministratorID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlActiveDirectoryAdministratorID returns a new SqlActiveDirectoryAdministratorID from the specified components
+func NewSqlActiveDirectoryAdministratorID(subscriptionId, resourceGroup, serverName, name string) SqlActiveDirectoryAdministratorID {
+	return SqlActiveDirectoryAdministratorID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Active Directory Administrator
+func (id SqlActiveDirectoryAdministratorID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/administrators/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlActiveDirectoryAdministratorID parses the specified Resource ID into a SqlActiveDirectoryAdministratorID, returning an error
+// if the Resource ID isn't for a Sql Active Directory Administrator
+func ParseSqlActiveDirectoryAdministratorID(input string) (*SqlActiveDirectoryAdministratorID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Active Directory Administrator ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Active Directory Administrator ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlActiveDirectoryAdministratorID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Active Directory Administrator ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("administrators"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Active Directory Administrator ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Active Directory Administrator ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlActiveDirectoryAdministratorID validates that the specified value is a Sql Active Directory Administrator ID
+func ValidateSqlActiveDirectoryAdministratorID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlActiveDirectoryAdministratorID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Active Directory Administrator ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
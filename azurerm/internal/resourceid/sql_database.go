@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlDatabaseID is a parsed Resource ID for a Sql Database
+type SqlDatabaseID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlDatabaseID returns a new SqlDatabaseID from the specified components
+func NewSqlDatabaseID(subscriptionId, resourceGroup, serverName, name string) SqlDatabaseID {
+	return SqlDatabaseID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Database
+func (id SqlDatabaseID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlDatabaseID parses the specified Resource ID into a SqlDatabaseID, returning an error
+// if the Resource ID isn't for a Sql Database
+func ParseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Database ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Database ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlDatabaseID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Database ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Database ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Database ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlDatabaseID validates that the specified value is a Sql Database ID
+func ValidateSqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Database ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlElasticPoolID is a parsed Resource ID for a Sql Elastic Pool
+type SqlElasticPoolID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlElasticPoolID returns a new SqlElasticPoolID from the specified components
+func NewSqlElasticPoolID(subscriptionId, resourceGroup, serverName, name string) SqlElasticPoolID {
+	return SqlElasticPoolID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Elastic Pool
+func (id SqlElasticPoolID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/elasticPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlElasticPoolID parses the specified Resource ID into a SqlElasticPoolID, returning an error
+// if the Resource ID isn't for a Sql Elastic Pool
+func ParseSqlElasticPoolID(input string) (*SqlElasticPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Elastic Pool ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Elastic Pool ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlElasticPoolID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Elastic Pool ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("elasticPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Elastic Pool ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Elastic Pool ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlElasticPoolID validates that the specified value is a Sql Elastic Pool ID
+func ValidateSqlElasticPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlElasticPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Elastic Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlFirewallRuleID is a parsed Resource ID for a Sql Firewall Rule
+type SqlFirewallRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlFirewallRuleID returns a new SqlFirewallRuleID from the specified components
+func NewSqlFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) SqlFirewallRuleID {
+	return SqlFirewallRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Firewall Rule
+func (id SqlFirewallRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlFirewallRuleID parses the specified Resource ID into a SqlFirewallRuleID, returning an error
+// if the Resource ID isn't for a Sql Firewall Rule
+func ParseSqlFirewallRuleID(input string) (*SqlFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Firewall Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlFirewallRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Firewall Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Firewall Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlFirewallRuleID validates that the specified value is a Sql Firewall Rule ID
+func ValidateSqlFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Firewall Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
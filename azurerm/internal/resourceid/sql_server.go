@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlServerID is a parsed Resource ID for a Sql Server
+type SqlServerID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSqlServerID returns a new SqlServerID from the specified components
+func NewSqlServerID(subscriptionId, resourceGroup, name string) SqlServerID {
+	return SqlServerID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Server
+func (id SqlServerID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSqlServerID parses the specified Resource ID into a SqlServerID, returning an error
+// if the Resource ID isn't for a Sql Server
+func ParseSqlServerID(input string) (*SqlServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Server ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Server ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlServerID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Server ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Server ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlServerID validates that the specified value is a Sql Server ID
+func ValidateSqlServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Server ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlVirtualNetworkRuleID is a parsed Resource ID for a Sql Virtual Network Rule
+type SqlVirtualNetworkRuleID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlVirtualNetworkRuleID returns a new SqlVirtualNetworkRuleID from the specified components
+func NewSqlVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) SqlVirtualNetworkRuleID {
+	return SqlVirtualNetworkRuleID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Sql Virtual Network Rule
+func (id SqlVirtualNetworkRuleID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlVirtualNetworkRuleID parses the specified Resource ID into a SqlVirtualNetworkRuleID, returning an error
+// if the Resource ID isn't for a Sql Virtual Network Rule
+func ParseSqlVirtualNetworkRuleID(input string) (*SqlVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing Sql Virtual Network Rule ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlVirtualNetworkRuleID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Sql Virtual Network Rule ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlVirtualNetworkRuleID validates that the specified value is a Sql Virtual Network Rule ID
+func ValidateSqlVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseSqlVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Sql Virtual Network Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
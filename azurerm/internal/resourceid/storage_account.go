@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StorageAccountID is a parsed Resource ID for a Storage Account
+type StorageAccountID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewStorageAccountID returns a new StorageAccountID from the specified components
+func NewStorageAccountID(subscriptionId, resourceGroup, name string) StorageAccountID {
+	return StorageAccountID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Storage Account
+func (id StorageAccountID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseStorageAccountID parses the specified Resource ID into a StorageAccountID, returning an error
+// if the Resource ID isn't for a Storage Account
+func ParseStorageAccountID(input string) (*StorageAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Account ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Storage") {
+		return nil, fmt.Errorf("Error parsing Storage Account ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Storage", id.Provider)
+	}
+
+	resourceId := StorageAccountID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Account ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Account ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStorageAccountID validates that the specified value is a Storage Account ID
+func ValidateStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStorageAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Storage Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
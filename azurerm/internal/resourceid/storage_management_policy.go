@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StorageManagementPolicyID is a parsed Resource ID for a Storage Management Policy
+type StorageManagementPolicyID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+// NewStorageManagementPolicyID returns a new StorageManagementPolicyID from the specified components
+func NewStorageManagementPolicyID(subscriptionId, resourceGroup, storageAccountName, name string) StorageManagementPolicyID {
+	return StorageManagementPolicyID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Storage Management Policy
+func (id StorageManagementPolicyID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/managementPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.Name)
+}
+
+// ParseStorageManagementPolicyID parses the specified Resource ID into a StorageManagementPolicyID, returning an error
+// if the Resource ID isn't for a Storage Management Policy
+func ParseStorageManagementPolicyID(input string) (*StorageManagementPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Management Policy ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Storage") {
+		return nil, fmt.Errorf("Error parsing Storage Management Policy ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Storage", id.Provider)
+	}
+
+	resourceId := StorageManagementPolicyID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Management Policy ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("managementPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Management Policy ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Storage Management Policy ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStorageManagementPolicyID validates that the specified value is a Storage Management Policy ID
+func ValidateStorageManagementPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStorageManagementPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Storage Management Policy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
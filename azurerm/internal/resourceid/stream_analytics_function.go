@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StreamAnalyticsFunctionID is a parsed Resource ID for a Stream Analytics Function
+type StreamAnalyticsFunctionID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	StreamingjobName string
+	Name             string
+}
+
+// NewStreamAnalyticsFunctionID returns a new StreamAnalyticsFunctionID from the specified components
+func NewStreamAnalyticsFunctionID(subscriptionId, resourceGroup, streamingjobName, name string) StreamAnalyticsFunctionID {
+	return StreamAnalyticsFunctionID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		StreamingjobName: streamingjobName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Stream Analytics Function
+func (id StreamAnalyticsFunctionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/functions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.Name)
+}
+
+// ParseStreamAnalyticsFunctionID parses the specified Resource ID into a StreamAnalyticsFunctionID, returning an error
+// if the Resource ID isn't for a Stream Analytics Function
+func ParseStreamAnalyticsFunctionID(input string) (*StreamAnalyticsFunctionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Function ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.StreamAnalytics") {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Function ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.StreamAnalytics", id.Provider)
+	}
+
+	resourceId := StreamAnalyticsFunctionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Function ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("functions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Function ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Function ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStreamAnalyticsFunctionID validates that the specified value is a Stream Analytics Function ID
+func ValidateStreamAnalyticsFunctionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStreamAnalyticsFunctionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Stream Analytics Function ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StreamAnalyticsInputID is a parsed Resource ID for a Stream Analytics Input
+type StreamAnalyticsInputID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	StreamingjobName string
+	Name             string
+}
+
+// NewStreamAnalyticsInputID returns a new StreamAnalyticsInputID from the specified components
+func NewStreamAnalyticsInputID(subscriptionId, resourceGroup, streamingjobName, name string) StreamAnalyticsInputID {
+	return StreamAnalyticsInputID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		StreamingjobName: streamingjobName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Stream Analytics Input
+func (id StreamAnalyticsInputID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/inputs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.Name)
+}
+
+// ParseStreamAnalyticsInputID parses the specified Resource ID into a StreamAnalyticsInputID, returning an error
+// if the Resource ID isn't for a Stream Analytics Input
+func ParseStreamAnalyticsInputID(input string) (*StreamAnalyticsInputID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Input ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.StreamAnalytics") {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Input ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.StreamAnalytics", id.Provider)
+	}
+
+	resourceId := StreamAnalyticsInputID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Input ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inputs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Input ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Input ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStreamAnalyticsInputID validates that the specified value is a Stream Analytics Input ID
+func ValidateStreamAnalyticsInputID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStreamAnalyticsInputID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Stream Analytics Input ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StreamAnalyticsJobID is a parsed Resource ID for a Stream Analytics Job
+type StreamAnalyticsJobID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewStreamAnalyticsJobID returns a new StreamAnalyticsJobID from the specified components
+func NewStreamAnalyticsJobID(subscriptionId, resourceGroup, name string) StreamAnalyticsJobID {
+	return StreamAnalyticsJobID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Stream Analytics Job
+func (id StreamAnalyticsJobID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseStreamAnalyticsJobID parses the specified Resource ID into a StreamAnalyticsJobID, returning an error
+// if the Resource ID isn't for a Stream Analytics Job
+func ParseStreamAnalyticsJobID(input string) (*StreamAnalyticsJobID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Job ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.StreamAnalytics") {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Job ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.StreamAnalytics", id.Provider)
+	}
+
+	resourceId := StreamAnalyticsJobID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("streamingjobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Job ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Job ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStreamAnalyticsJobID validates that the specified value is a Stream Analytics Job ID
+func ValidateStreamAnalyticsJobID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStreamAnalyticsJobID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Stream Analytics Job ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StreamAnalyticsOutputID is a parsed Resource ID for a Stream Analytics Output
+type StreamAnalyticsOutputID struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	StreamingjobName string
+	Name             string
+}
+
+// NewStreamAnalyticsOutputID returns a new StreamAnalyticsOutputID from the specified components
+func NewStreamAnalyticsOutputID(subscriptionId, resourceGroup, streamingjobName, name string) StreamAnalyticsOutputID {
+	return StreamAnalyticsOutputID{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		StreamingjobName: streamingjobName,
+		Name:             name,
+	}
+}
+
+// String returns the Resource ID for this Stream Analytics Output
+func (id StreamAnalyticsOutputID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/outputs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.Name)
+}
+
+// ParseStreamAnalyticsOutputID parses the specified Resource ID into a StreamAnalyticsOutputID, returning an error
+// if the Resource ID isn't for a Stream Analytics Output
+func ParseStreamAnalyticsOutputID(input string) (*StreamAnalyticsOutputID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Output ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.StreamAnalytics") {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Output ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.StreamAnalytics", id.Provider)
+	}
+
+	resourceId := StreamAnalyticsOutputID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Output ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("outputs"); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Output ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Stream Analytics Output ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStreamAnalyticsOutputID validates that the specified value is a Stream Analytics Output ID
+func ValidateStreamAnalyticsOutputID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseStreamAnalyticsOutputID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Stream Analytics Output ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// TemplateDeploymentID is a parsed Resource ID for a Template Deployment
+type TemplateDeploymentID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewTemplateDeploymentID returns a new TemplateDeploymentID from the specified components
+func NewTemplateDeploymentID(subscriptionId, resourceGroup, name string) TemplateDeploymentID {
+	return TemplateDeploymentID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Template Deployment
+func (id TemplateDeploymentID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseTemplateDeploymentID parses the specified Resource ID into a TemplateDeploymentID, returning an error
+// if the Resource ID isn't for a Template Deployment
+func ParseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Template Deployment ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Resources") {
+		return nil, fmt.Errorf("Error parsing Template Deployment ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Resources", id.Provider)
+	}
+
+	resourceId := TemplateDeploymentID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("deployments"); err != nil {
+		return nil, fmt.Errorf("Error parsing Template Deployment ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Template Deployment ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateTemplateDeploymentID validates that the specified value is a Template Deployment ID
+func ValidateTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseTemplateDeploymentID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Template Deployment ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
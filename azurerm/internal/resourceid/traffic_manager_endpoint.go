@@ -0,0 +1,97 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// trafficManagerEndpointTypes are the segments under which a Traffic Manager Endpoint can be nested,
+// which is why this Resource ID can't be generated
+var trafficManagerEndpointTypes = []string{
+	"azureEndpoints",
+	"externalEndpoints",
+	"nestedEndpoints",
+}
+
+// TrafficManagerEndpointID is a parsed Resource ID for a Traffic Manager Endpoint
+type TrafficManagerEndpointID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProfileName    string
+	Type           string
+	Name           string
+}
+
+// NewTrafficManagerEndpointID returns a new TrafficManagerEndpointID from the specified components
+func NewTrafficManagerEndpointID(subscriptionId, resourceGroup, profileName, endpointType, name string) TrafficManagerEndpointID {
+	return TrafficManagerEndpointID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		Type:           endpointType,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Traffic Manager Endpoint
+func (id TrafficManagerEndpointID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Type, id.Name)
+}
+
+// ParseTrafficManagerEndpointID parses the specified Resource ID into a TrafficManagerEndpointID, returning an error
+// if the Resource ID isn't for a Traffic Manager Endpoint
+func ParseTrafficManagerEndpointID(input string) (*TrafficManagerEndpointID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := TrafficManagerEndpointID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: %+v", input, err)
+	}
+
+	for _, endpointType := range trafficManagerEndpointTypes {
+		if name, err := id.PopSegment(endpointType); err == nil {
+			resourceId.Type = endpointType
+			resourceId.Name = name
+			break
+		}
+	}
+
+	if resourceId.Type == "" {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: expected one of the segments %q", input, strings.Join(trafficManagerEndpointTypes, ", "))
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Endpoint ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateTrafficManagerEndpointID validates that the specified value is a Traffic Manager Endpoint ID
+func ValidateTrafficManagerEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseTrafficManagerEndpointID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Traffic Manager Endpoint ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package resourceid
+
+import "testing"
+
+func TestTrafficManagerEndpointIDFormatter(t *testing.T) {
+	actual := NewTrafficManagerEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "azureEndpoints", "endpoint1").String()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/azureEndpoints/endpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseTrafficManagerEndpointID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *TrafficManagerEndpointID
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1",
+		},
+		{
+			// unknown endpoint type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/otherEndpoints/endpoint1",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/azureEndpoints/",
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/azureEndpoints/endpoint1",
+			Expected: &TrafficManagerEndpointID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ProfileName:    "profile1",
+				Type:           "azureEndpoints",
+				Name:           "endpoint1",
+			},
+		},
+		{
+			// valid, lower-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficmanagerprofiles/profile1/externalendpoints/endpoint1",
+			Expected: &TrafficManagerEndpointID{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ProfileName:    "profile1",
+				Type:           "externalEndpoints",
+				Name:           "endpoint1",
+			},
+		},
+		{
+			// two endpoint types
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/azureEndpoints/endpoint1/nestedEndpoints/endpoint2",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseTrafficManagerEndpointID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
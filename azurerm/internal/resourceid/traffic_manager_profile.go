@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// TrafficManagerProfileID is a parsed Resource ID for a Traffic Manager Profile
+type TrafficManagerProfileID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewTrafficManagerProfileID returns a new TrafficManagerProfileID from the specified components
+func NewTrafficManagerProfileID(subscriptionId, resourceGroup, name string) TrafficManagerProfileID {
+	return TrafficManagerProfileID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Traffic Manager Profile
+func (id TrafficManagerProfileID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseTrafficManagerProfileID parses the specified Resource ID into a TrafficManagerProfileID, returning an error
+// if the Resource ID isn't for a Traffic Manager Profile
+func ParseTrafficManagerProfileID(input string) (*TrafficManagerProfileID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Profile ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Profile ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := TrafficManagerProfileID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Profile ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Traffic Manager Profile ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateTrafficManagerProfileID validates that the specified value is a Traffic Manager Profile ID
+func ValidateTrafficManagerProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseTrafficManagerProfileID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Traffic Manager Profile ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
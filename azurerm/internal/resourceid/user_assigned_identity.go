@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// UserAssignedIdentityID is a parsed Resource ID for a User Assigned Identity
+type UserAssignedIdentityID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewUserAssignedIdentityID returns a new UserAssignedIdentityID from the specified components
+func NewUserAssignedIdentityID(subscriptionId, resourceGroup, name string) UserAssignedIdentityID {
+	return UserAssignedIdentityID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this User Assigned Identity
+func (id UserAssignedIdentityID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseUserAssignedIdentityID parses the specified Resource ID into a UserAssignedIdentityID, returning an error
+// if the Resource ID isn't for a User Assigned Identity
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing User Assigned Identity ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ManagedIdentity") {
+		return nil, fmt.Errorf("Error parsing User Assigned Identity ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.ManagedIdentity", id.Provider)
+	}
+
+	resourceId := UserAssignedIdentityID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
+		return nil, fmt.Errorf("Error parsing User Assigned Identity ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing User Assigned Identity ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateUserAssignedIdentityID validates that the specified value is a User Assigned Identity ID
+func ValidateUserAssignedIdentityID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseUserAssignedIdentityID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid User Assigned Identity ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
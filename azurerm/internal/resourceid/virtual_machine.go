@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineID is a parsed Resource ID for a Virtual Machine
+type VirtualMachineID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineID returns a new VirtualMachineID from the specified components
+func NewVirtualMachineID(subscriptionId, resourceGroup, name string) VirtualMachineID {
+	return VirtualMachineID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Machine
+func (id VirtualMachineID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineID parses the specified Resource ID into a VirtualMachineID, returning an error
+// if the Resource ID isn't for a Virtual Machine
+func ParseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Virtual Machine ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineID validates that the specified value is a Virtual Machine ID
+func ValidateVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualMachineID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Machine ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineDataDiskAttachmentID is a parsed Resource ID for a Virtual Machine Data Disk Attachment
+type VirtualMachineDataDiskAttachmentID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineDataDiskAttachmentID returns a new VirtualMachineDataDiskAttachmentID from the specified components
+func NewVirtualMachineDataDiskAttachmentID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineDataDiskAttachmentID {
+	return VirtualMachineDataDiskAttachmentID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Machine Data Disk Attachment
+func (id VirtualMachineDataDiskAttachmentID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/dataDisks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineDataDiskAttachmentID parses the specified Resource ID into a VirtualMachineDataDiskAttachmentID, returning an error
+// if the Resource ID isn't for a Virtual Machine Data Disk Attachment
+func ParseVirtualMachineDataDiskAttachmentID(input string) (*VirtualMachineDataDiskAttachmentID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Data Disk Attachment ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Data Disk Attachment ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineDataDiskAttachmentID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Data Disk Attachment ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("dataDisks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Data Disk Attachment ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Data Disk Attachment ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineDataDiskAttachmentID validates that the specified value is a Virtual Machine Data Disk Attachment ID
+func ValidateVirtualMachineDataDiskAttachmentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualMachineDataDiskAttachmentID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Machine Data Disk Attachment ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineExtensionID is a parsed Resource ID for a Virtual Machine Extension
+type VirtualMachineExtensionID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineExtensionID returns a new VirtualMachineExtensionID from the specified components
+func NewVirtualMachineExtensionID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineExtensionID {
+	return VirtualMachineExtensionID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Machine Extension
+func (id VirtualMachineExtensionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/extensions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineExtensionID parses the specified Resource ID into a VirtualMachineExtensionID, returning an error
+// if the Resource ID isn't for a Virtual Machine Extension
+func ParseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineExtensionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("extensions"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Extension ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineExtensionID validates that the specified value is a Virtual Machine Extension ID
+func ValidateVirtualMachineExtensionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualMachineExtensionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Machine Extension ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineScaleSetID is a parsed Resource ID for a Virtual Machine Scale Set
+type VirtualMachineScaleSetID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineScaleSetID returns a new VirtualMachineScaleSetID from the specified components
+func NewVirtualMachineScaleSetID(subscriptionId, resourceGroup, name string) VirtualMachineScaleSetID {
+	return VirtualMachineScaleSetID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Machine Scale Set
+func (id VirtualMachineScaleSetID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineScaleSetID parses the specified Resource ID into a VirtualMachineScaleSetID, returning an error
+// if the Resource ID isn't for a Virtual Machine Scale Set
+func ParseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineScaleSetID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Machine Scale Set ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineScaleSetID validates that the specified value is a Virtual Machine Scale Set ID
+func ValidateVirtualMachineScaleSetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualMachineScaleSetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Machine Scale Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkID is a parsed Resource ID for a Virtual Network
+type VirtualNetworkID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkID returns a new VirtualNetworkID from the specified components
+func NewVirtualNetworkID(subscriptionId, resourceGroup, name string) VirtualNetworkID {
+	return VirtualNetworkID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network
+func (id VirtualNetworkID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkID parses the specified Resource ID into a VirtualNetworkID, returning an error
+// if the Resource ID isn't for a Virtual Network
+func ParseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkID validates that the specified value is a Virtual Network ID
+func ValidateVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualNetworkID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Network ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
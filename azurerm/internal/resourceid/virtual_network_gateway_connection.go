@@ -0,0 +1,75 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkGatewayConnectionID is a parsed Resource ID for a Virtual Network Gateway Connection
+type VirtualNetworkGatewayConnectionID struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayConnectionID returns a new VirtualNetworkGatewayConnectionID from the specified components
+func NewVirtualNetworkGatewayConnectionID(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayConnectionID {
+	return VirtualNetworkGatewayConnectionID{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network Gateway Connection
+func (id VirtualNetworkGatewayConnectionID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/connections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayConnectionID parses the specified Resource ID into a VirtualNetworkGatewayConnectionID, returning an error
+// if the Resource ID isn't for a Virtual Network Gateway Connection
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkGatewayConnectionID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Gateway Connection ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkGatewayConnectionID validates that the specified value is a Virtual Network Gateway Connection ID
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualNetworkGatewayConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Network Gateway Connection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,81 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkPeeringID is a parsed Resource ID for a Virtual Network Peering
+type VirtualNetworkPeeringID struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewVirtualNetworkPeeringID returns a new VirtualNetworkPeeringID from the specified components
+func NewVirtualNetworkPeeringID(subscriptionId, resourceGroup, virtualNetworkName, name string) VirtualNetworkPeeringID {
+	return VirtualNetworkPeeringID{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// String returns the Resource ID for this Virtual Network Peering
+func (id VirtualNetworkPeeringID) String() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/virtualNetworkPeerings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseVirtualNetworkPeeringID parses the specified Resource ID into a VirtualNetworkPeeringID, returning an error
+// if the Resource ID isn't for a Virtual Network Peering
+func ParseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Peering ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing Virtual Network Peering ID %q: expected the Resource Provider to be %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkPeeringID{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Peering ID %q: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkPeerings"); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Peering ID %q: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing Virtual Network Peering ID %q: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkPeeringID validates that the specified value is a Virtual Network Peering ID
+func ValidateVirtualNetworkPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseVirtualNetworkPeeringID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Virtual Network Peering ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
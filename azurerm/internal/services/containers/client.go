@@ -3,11 +3,12 @@ package containers
 import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-10-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2018-09-01/containerregistry"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
+	AgentPoolsClient         containerservice.AgentPoolsClient
 	KubernetesClustersClient containerservice.ManagedClustersClient
 	GroupsClient             containerinstance.ContainerGroupsClient
 	RegistriesClient         containerregistry.RegistriesClient
@@ -32,6 +33,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&c.ServicesClient.Client, o.ResourceManagerAuthorizer)
 
 	// AKS
+	c.AgentPoolsClient = containerservice.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&c.AgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	c.KubernetesClustersClient = containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&c.KubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -1,7 +1,7 @@
 package devspace
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
+	"github.com/Azure/azure-sdk-for-go/services/devspaces/mgmt/2019-04-01/devspaces"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
@@ -2,12 +2,12 @@ package recoveryservices
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
-	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
-	ProtectedItemsClient     backup.ProtectedItemsGroupClient
+	ProtectedItemsClient     backup.ProtectedItemsClient
 	ProtectionPoliciesClient backup.ProtectionPoliciesClient
 	VaultsClient             recoveryservices.VaultsClient
 }
@@ -18,7 +18,7 @@ func BuildClient(o *common.ClientOptions) *Client {
 	c.VaultsClient = recoveryservices.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&c.VaultsClient.Client, o.ResourceManagerAuthorizer)
 
-	c.ProtectedItemsClient = backup.NewProtectedItemsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	c.ProtectedItemsClient = backup.NewProtectedItemsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&c.ProtectedItemsClient.Client, o.ResourceManagerAuthorizer)
 
 	c.ProtectionPoliciesClient = backup.NewProtectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -0,0 +1,19 @@
+package resourcegraph
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/resourcegraph/mgmt/2018-09-01/resourcegraph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	QueryClient resourcegraph.BaseClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+	c := Client{}
+
+	c.QueryClient = resourcegraph.NewWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&c.QueryClient.Client, o.ResourceManagerAuthorizer)
+
+	return &c
+}
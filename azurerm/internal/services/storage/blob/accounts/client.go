@@ -0,0 +1,31 @@
+package accounts
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Client is the base client for the Blob Service of a Storage Account.
+type Client struct {
+	autorest.Client
+	BaseURI string
+}
+
+// New creates an instance of the Client client.
+func New() Client {
+	return NewWithEnvironment(azure.PublicCloud)
+}
+
+// NewWithEnvironment creates an instance of the Client client.
+func NewWithEnvironment(environment azure.Environment) Client {
+	return NewWithBaseURI(environment.StorageEndpointSuffix)
+}
+
+// NewWithBaseURI creates an instance of the Client client using the specified Base URI - which is either
+// a Storage Endpoint Suffix (e.g. `core.windows.net`) or the full URI of a local stand-in for the Blob API.
+func NewWithBaseURI(baseUri string) Client {
+	return Client{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: baseUri,
+	}
+}
@@ -0,0 +1,148 @@
+package accounts
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
+)
+
+// fakeBlobServiceServer is a local stand-in for the Service Properties operations of the Blob API
+type fakeBlobServiceServer struct {
+	accountName string
+
+	mu            sync.Mutex
+	staticWebsite *StaticWebsite
+}
+
+func (s *fakeBlobServiceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKeyLite "+s.accountName+":") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	query := r.URL.Query()
+	if r.Header.Get("x-ms-version") != APIVersion || query.Get("restype") != "service" || query.Get("comp") != "properties" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if strings.Trim(r.URL.Path, "/") != s.accountName {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var input StorageServiceProperties
+		if err := xml.NewDecoder(r.Body).Decode(&input); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// as with the Blob Service, elements which aren't specified retain their existing values
+		if input.StaticWebsite != nil {
+			s.staticWebsite = input.StaticWebsite
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	case http.MethodGet:
+		output := StorageServiceProperties{
+			StaticWebsite: &StaticWebsite{},
+		}
+		if s.staticWebsite != nil {
+			output.StaticWebsite = s.staticWebsite
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		xml.NewEncoder(w).Encode(output) // nolint: errcheck
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestServicePropertiesStaticWebsite(t *testing.T) {
+	ctx := context.TODO()
+	accountName := "account1"
+
+	server := httptest.NewServer(&fakeBlobServiceServer{
+		accountName: accountName,
+	})
+	defer server.Close()
+
+	client := NewWithBaseURI(server.URL)
+	client.Authorizer = authorizers.NewSharedKeyLiteAuthorizer(accountName, authorizers.StorageEmulatorAccountKey)
+
+	props, err := client.GetServiceProperties(ctx, accountName)
+	if err != nil {
+		t.Fatalf("Error retrieving: %s", err)
+	}
+	if props.StaticWebsite == nil || props.StaticWebsite.Enabled {
+		t.Fatalf("Expected the Static Website to be disabled but got %+v", props.StaticWebsite)
+	}
+
+	input := StorageServiceProperties{
+		StaticWebsite: &StaticWebsite{
+			Enabled:              true,
+			IndexDocument:        "index.html",
+			ErrorDocument404Path: "404.html",
+		},
+	}
+	if _, err := client.SetServiceProperties(ctx, accountName, input); err != nil {
+		t.Fatalf("Error updating: %s", err)
+	}
+
+	props, err = client.GetServiceProperties(ctx, accountName)
+	if err != nil {
+		t.Fatalf("Error retrieving: %s", err)
+	}
+	if props.StaticWebsite == nil || *props.StaticWebsite != *input.StaticWebsite {
+		t.Fatalf("Expected the Static Website to be %+v but got %+v", *input.StaticWebsite, props.StaticWebsite)
+	}
+
+	if _, err := client.SetServiceProperties(ctx, accountName, StorageServiceProperties{StaticWebsite: &StaticWebsite{}}); err != nil {
+		t.Fatalf("Error updating: %s", err)
+	}
+
+	props, err = client.GetServiceProperties(ctx, accountName)
+	if err != nil {
+		t.Fatalf("Error retrieving: %s", err)
+	}
+	if props.StaticWebsite == nil || props.StaticWebsite.Enabled {
+		t.Fatalf("Expected the Static Website to be disabled but got %+v", props.StaticWebsite)
+	}
+}
+
+func TestGetBlobEndpoint(t *testing.T) {
+	testData := []struct {
+		baseUri  string
+		expected string
+	}{
+		{
+			baseUri:  "core.windows.net",
+			expected: "https://account1.blob.core.windows.net",
+		},
+		{
+			baseUri:  "core.chinacloudapi.cn",
+			expected: "https://account1.blob.core.chinacloudapi.cn",
+		},
+		{
+			baseUri:  "http://127.0.0.1:10000/",
+			expected: "http://127.0.0.1:10000/account1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.baseUri)
+
+		if actual := getBlobEndpoint(v.baseUri, "account1"); actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
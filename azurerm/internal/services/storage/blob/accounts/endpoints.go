@@ -0,0 +1,19 @@
+package accounts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getBlobEndpoint returns the endpoint for Blob API Operations on this storage account
+//
+// The `baseUri` is normally the Storage Endpoint Suffix for the Environment (e.g. `core.windows.net`) - however
+// when this is a full URI (e.g. `http://127.0.0.1:10000`) it's assumed to be a local stand-in for the Blob API,
+// which (as with the Storage Emulator) uses path-style addressing for the Account Name.
+func getBlobEndpoint(baseUri string, accountName string) string {
+	if strings.HasPrefix(baseUri, "http://") || strings.HasPrefix(baseUri, "https://") {
+		return fmt.Sprintf("%s/%s", strings.TrimSuffix(baseUri, "/"), accountName)
+	}
+
+	return fmt.Sprintf("https://%s.blob.%s", accountName, baseUri)
+}
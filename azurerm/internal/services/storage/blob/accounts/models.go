@@ -0,0 +1,26 @@
+package accounts
+
+import "encoding/xml"
+
+// StorageServiceProperties are the properties of the Blob Service within a Storage Account
+//
+// NOTE: only the properties managed via the Data Plane API are defined here - when setting these the
+// Blob Service retains the existing values for any elements which aren't specified.
+type StorageServiceProperties struct {
+	XMLName xml.Name `xml:"StorageServiceProperties"`
+
+	// StaticWebsite - Optional. The Static Website configuration for this Storage Account
+	StaticWebsite *StaticWebsite `xml:"StaticWebsite,omitempty"`
+}
+
+// StaticWebsite is the Static Website configuration for a Storage Account
+type StaticWebsite struct {
+	// Enabled - Is the Static Website enabled for this Storage Account?
+	Enabled bool `xml:"Enabled"`
+
+	// IndexDocument - The default name of the index page under each directory
+	IndexDocument string `xml:"IndexDocument,omitempty"`
+
+	// ErrorDocument404Path - The absolute path of the custom 404 page
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
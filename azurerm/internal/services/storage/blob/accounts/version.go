@@ -0,0 +1,10 @@
+package accounts
+
+import "fmt"
+
+// APIVersion is the version of the API used for all Blob Service Account Operations
+const APIVersion = "2018-11-09"
+
+func UserAgent() string {
+	return fmt.Sprintf("terraform-provider-azurerm/blob-accounts storage/%s", APIVersion)
+}
@@ -0,0 +1,497 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
+)
+
+const pollingInterval = time.Second * 15
+
+// BlobUpload contains the information required to create (and upload the contents of) a Blob
+type BlobUpload struct {
+	Client *blobs.Client
+
+	AccountName   string
+	BlobName      string
+	ContainerName string
+
+	BlobType      string
+	ContentType   string
+	MetaData      map[string]string
+	Parallelism   int
+	Size          int
+	Source        string
+	SourceContent string
+	SourceUri     string
+
+	// Attempts is the number of times each block/page is uploaded before giving up
+	Attempts int
+}
+
+// blobUploadSource is the contents of a Blob which should be uploaded, either from
+// a local file or from inline content
+type blobUploadSource struct {
+	name   string
+	reader io.ReaderAt
+	size   int64
+	md5    string
+}
+
+// Create creates the Blob - either by copying it from `SourceUri`, uploading the contents of
+// `Source` or `SourceContent` or (where none are specified) by creating an empty Blob of the specified type
+func (sbu BlobUpload) Create(ctx context.Context) error {
+	if sbu.SourceUri != "" {
+		return sbu.copy(ctx)
+	}
+
+	var source *blobUploadSource
+	if sbu.Source != "" {
+		file, err := os.Open(sbu.Source)
+		if err != nil {
+			return fmt.Errorf("Error opening source file for upload %q: %s", sbu.Source, err)
+		}
+		defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", sbu.BlobName, sbu.Source))
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error stating source file %q: %s", sbu.Source, err)
+		}
+
+		hash, err := contentMD5(file)
+		if err != nil {
+			return fmt.Errorf("Error computing the MD5 of source file %q: %s", sbu.Source, err)
+		}
+
+		source = &blobUploadSource{
+			name:   fmt.Sprintf("source file %q", sbu.Source),
+			reader: file,
+			size:   info.Size(),
+			md5:    hash,
+		}
+	} else if sbu.SourceContent != "" {
+		content := []byte(sbu.SourceContent)
+		hash, err := contentMD5(bytes.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("Error computing the MD5 of the source content: %s", err)
+		}
+
+		source = &blobUploadSource{
+			name:   "source content",
+			reader: bytes.NewReader(content),
+			size:   int64(len(content)),
+			md5:    hash,
+		}
+	}
+
+	blobType := strings.ToLower(sbu.BlobType)
+
+	if blobType == "append" {
+		return sbu.uploadAppendBlob(ctx, source)
+	}
+
+	if blobType == "block" {
+		if source != nil {
+			return sbu.uploadBlockBlob(ctx, *source)
+		}
+
+		return sbu.createEmptyBlockBlob(ctx)
+	}
+
+	if blobType == "page" {
+		if source != nil {
+			return sbu.uploadPageBlob(ctx, *source)
+		}
+
+		return sbu.createEmptyPageBlob(ctx)
+	}
+
+	return fmt.Errorf("Unsupported Blob Type: %q", blobType)
+}
+
+// FileContentMD5 returns the base64-encoded MD5 hash of the specified file, in the same format
+// as the Content-MD5 of a Blob
+func FileContentMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing file `%s` after computing the MD5", path))
+
+	return contentMD5(file)
+}
+
+func contentMD5(input io.Reader) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, input); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (sbu BlobUpload) copy(ctx context.Context) error {
+	input := blobs.CopyInput{
+		CopySource: sbu.SourceUri,
+		MetaData:   sbu.MetaData,
+	}
+	if err := sbu.Client.CopyAndWait(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input, pollingInterval); err != nil {
+		return fmt.Errorf("Error copy/waiting: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) createEmptyBlockBlob(ctx context.Context) error {
+	// the Put Block Blob API requires content - however committing an empty Block List
+	// is the documented way to create an empty Block Blob
+	input := blobs.PutBlockListInput{
+		BlockList:   blobs.BlockList{},
+		ContentType: &sbu.ContentType,
+		MetaData:    sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
+	if sbu.Size == 0 {
+		return fmt.Errorf("`size` cannot be zero for a page blob")
+	}
+
+	input := blobs.PutPageBlobInput{
+		BlobContentLengthBytes: int64(sbu.Size),
+		ContentType:            &sbu.ContentType,
+		MetaData:               sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutPageBlob(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutPageBlob: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) uploadAppendBlob(ctx context.Context, source *blobUploadSource) error {
+	input := blobs.PutAppendBlobInput{
+		ContentType: &sbu.ContentType,
+		MetaData:    sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutAppendBlob(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutAppendBlob: %s", err)
+	}
+
+	if source == nil || source.size == 0 {
+		return nil
+	}
+
+	// blocks have to be appended in order, so this can't be parallelised
+	const blockSize int64 = 4 * 1024 * 1024
+	for offset := int64(0); offset < source.size; offset += blockSize {
+		size := blockSize
+		if remainder := source.size - offset; remainder < blockSize {
+			size = remainder
+		}
+
+		buffer := make([]byte, size)
+		if _, err := source.reader.ReadAt(buffer, offset); err != nil && err != io.EOF {
+			return fmt.Errorf("Error reading %s at offset %d: %s", source.name, offset, err)
+		}
+
+		input := blobs.AppendBlockInput{
+			BlobConditionAppendPosition: utils.Int64(offset),
+			Content:                     buffer,
+		}
+
+		var err error
+		for i := 0; i < sbu.Attempts; i++ {
+			if _, err = sbu.Client.AppendBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Error appending block at offset %d for %s: %s", offset, source.name, err)
+		}
+	}
+
+	// the Content MD5 of an Append Blob isn't updated when blocks are appended, so we set it once they're uploaded
+	propertiesInput := blobs.SetPropertiesInput{
+		ContentType: &sbu.ContentType,
+		ContentMD5:  &source.md5,
+	}
+	if _, err := sbu.Client.SetProperties(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, propertiesInput); err != nil {
+		return fmt.Errorf("Error setting the Content MD5 for %s: %s", source.name, err)
+	}
+
+	return nil
+}
+
+type storageBlobBlock struct {
+	section *io.SectionReader
+	id      string
+}
+
+func (sbu BlobUpload) uploadBlockBlob(ctx context.Context, source blobUploadSource) error {
+	blockList, parts, err := sbu.blockBlobSplit(source)
+	if err != nil {
+		return fmt.Errorf("Error reading and splitting %s for upload: %s", source.name, err)
+	}
+
+	if len(parts) == 0 {
+		return sbu.createEmptyBlockBlob(ctx)
+	}
+
+	wg := &sync.WaitGroup{}
+	blocks := make(chan storageBlobBlock, len(parts))
+	errors := make(chan error, len(parts))
+
+	wg.Add(len(parts))
+	for _, p := range parts {
+		blocks <- p
+	}
+	close(blocks)
+
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			for block := range blocks {
+				sbu.blockUploadWorker(ctx, source, block, errors)
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading %s: %s", source.name, <-errors)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			UncommittedBlockIDs: blockList,
+		},
+		ContentMD5:  &source.md5,
+		ContentType: &sbu.ContentType,
+		MetaData:    sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error updating block list for %s: %s", source.name, err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) blockBlobSplit(source blobUploadSource) ([]blobs.BlockID, []storageBlobBlock, error) {
+	const (
+		idSize          = 64
+		blockSize int64 = 4 * 1024 * 1024
+	)
+	var parts []storageBlobBlock
+	var blockList []blobs.BlockID
+
+	for i := int64(0); i < source.size; i = i + blockSize {
+		entropy := make([]byte, idSize)
+		if _, err := rand.Read(entropy); err != nil {
+			return nil, nil, fmt.Errorf("Error generating a random block ID for %s: %s", source.name, err)
+		}
+
+		sectionSize := blockSize
+		remainder := source.size - i
+		if remainder < blockSize {
+			sectionSize = remainder
+		}
+
+		block := blobs.BlockID{
+			Value: base64.StdEncoding.EncodeToString(entropy),
+		}
+
+		blockList = append(blockList, block)
+
+		parts = append(parts, storageBlobBlock{
+			id:      block.Value,
+			section: io.NewSectionReader(source.reader, i, sectionSize),
+		})
+	}
+
+	return blockList, parts, nil
+}
+
+func (sbu BlobUpload) blockUploadWorker(ctx context.Context, source blobUploadSource, block storageBlobBlock, errors chan error) {
+	buffer := make([]byte, block.section.Size())
+
+	if _, err := block.section.Read(buffer); err != nil {
+		errors <- fmt.Errorf("Error reading %s: %s", source.name, err)
+		return
+	}
+
+	input := blobs.PutBlockInput{
+		BlockID: block.id,
+		Content: buffer,
+	}
+
+	var err error
+	for i := 0; i < sbu.Attempts; i++ {
+		if _, err = sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		errors <- fmt.Errorf("Error uploading block %q for %s: %s", block.id, source.name, err)
+	}
+}
+
+type storageBlobPage struct {
+	offset  int64
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) uploadPageBlob(ctx context.Context, source blobUploadSource) error {
+	blobSize, pageList, err := sbu.pageBlobSplit(source)
+	if err != nil {
+		return fmt.Errorf("Error splitting %s into pages: %s", source.name, err)
+	}
+
+	// whilst the source may not be aligned to a 512-byte boundary, the page blob needs to be
+	if blobSize%512 != 0 {
+		blobSize = blobSize + (512 - (blobSize % 512))
+	}
+
+	input := blobs.PutPageBlobInput{
+		BlobContentLengthBytes: blobSize,
+		ContentMD5:             &source.md5,
+		ContentType:            &sbu.ContentType,
+		MetaData:               sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutPageBlob(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutPageBlob: %s", err)
+	}
+
+	pages := make(chan storageBlobPage, len(pageList))
+	errors := make(chan error, len(pageList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(pageList))
+
+	for _, page := range pageList {
+		pages <- page
+	}
+	close(pages)
+
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			for page := range pages {
+				sbu.pageUploadWorker(ctx, source, page, blobSize, errors)
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading %s: %s", source.name, <-errors)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) pageBlobSplit(source blobUploadSource) (int64, []storageBlobPage, error) {
+	const (
+		minPageSize int64 = 4 * 1024
+		maxPageSize int64 = 4 * 1024 * 1024
+	)
+
+	blobSize := source.size
+	if source.size%minPageSize != 0 {
+		blobSize = source.size + (minPageSize - (source.size % minPageSize))
+	}
+
+	emptyPage := make([]byte, minPageSize)
+
+	type byteRange struct {
+		offset int64
+		length int64
+	}
+
+	var nonEmptyRanges []byteRange
+	var currentRange byteRange
+	for i := int64(0); i < blobSize; i += minPageSize {
+		pageBuf := make([]byte, minPageSize)
+		if _, err := source.reader.ReadAt(pageBuf, i); err != nil && err != io.EOF {
+			return int64(0), nil, fmt.Errorf("Could not read chunk at %d: %s", i, err)
+		}
+
+		if bytes.Equal(pageBuf, emptyPage) {
+			if currentRange.length != 0 {
+				nonEmptyRanges = append(nonEmptyRanges, currentRange)
+			}
+			currentRange = byteRange{
+				offset: i + minPageSize,
+			}
+		} else {
+			currentRange.length += minPageSize
+			if currentRange.length == maxPageSize || (currentRange.offset+currentRange.length == blobSize) {
+				nonEmptyRanges = append(nonEmptyRanges, currentRange)
+				currentRange = byteRange{
+					offset: i + minPageSize,
+				}
+			}
+		}
+	}
+
+	var pages []storageBlobPage
+	for _, nonEmptyRange := range nonEmptyRanges {
+		pages = append(pages, storageBlobPage{
+			offset:  nonEmptyRange.offset,
+			section: io.NewSectionReader(source.reader, nonEmptyRange.offset, nonEmptyRange.length),
+		})
+	}
+
+	return source.size, pages, nil
+}
+
+func (sbu BlobUpload) pageUploadWorker(ctx context.Context, source blobUploadSource, page storageBlobPage, blobSize int64, errors chan error) {
+	start := page.offset
+	end := page.offset + page.section.Size() - 1
+	if end > blobSize-1 {
+		end = blobSize - 1
+	}
+	size := end - start + 1
+
+	chunk := make([]byte, size)
+	if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
+		errors <- fmt.Errorf("Error reading %s at offset %d: %s", source.name, page.offset, err)
+		return
+	}
+
+	input := blobs.PutPageUpdateInput{
+		StartByte: start,
+		EndByte:   end,
+		Content:   chunk,
+	}
+
+	var err error
+	for i := 0; i < sbu.Attempts; i++ {
+		if _, err = sbu.Client.PutPageUpdate(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		errors <- fmt.Errorf("Error writing page at offset %d for %s: %s", page.offset, source.name, err)
+	}
+}
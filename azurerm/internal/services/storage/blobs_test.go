@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestBlobUploadBlockBlobSplit(t *testing.T) {
+	testData := []struct {
+		size           int
+		expectedBlocks int
+	}{
+		{
+			size:           0,
+			expectedBlocks: 0,
+		},
+		{
+			size:           1024,
+			expectedBlocks: 1,
+		},
+		{
+			size:           4 * 1024 * 1024,
+			expectedBlocks: 1,
+		},
+		{
+			size:           (4 * 1024 * 1024) + 1,
+			expectedBlocks: 2,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d bytes", v.size)
+
+		source := testBlobUploadSource(make([]byte, v.size))
+		blockList, parts, err := BlobUpload{}.blockBlobSplit(source)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if len(blockList) != v.expectedBlocks || len(parts) != v.expectedBlocks {
+			t.Fatalf("Expected %d blocks but got %d (and %d parts)", v.expectedBlocks, len(blockList), len(parts))
+		}
+
+		total := int64(0)
+		for i, part := range parts {
+			if part.id != blockList[i].Value {
+				t.Fatalf("Expected the ID of part %d to be %q but got %q", i, blockList[i].Value, part.id)
+			}
+			total += part.section.Size()
+		}
+
+		if total != int64(v.size) {
+			t.Fatalf("Expected the parts to total %d bytes but got %d", v.size, total)
+		}
+	}
+}
+
+func TestBlobUploadPageBlobSplit(t *testing.T) {
+	const pageSize = 4 * 1024
+
+	content := make([]byte, 4*pageSize)
+	// first page populated, second & third pages empty, fourth page partially populated
+	for i := 0; i < pageSize; i++ {
+		content[i] = 1
+	}
+	content[(3*pageSize)+10] = 1
+
+	size, pages, err := BlobUpload{}.pageBlobSplit(testBlobUploadSource(content))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if size != int64(len(content)) {
+		t.Fatalf("Expected the size to be %d but got %d", len(content), size)
+	}
+
+	if len(pages) != 2 {
+		t.Fatalf("Expected 2 non-empty pages but got %d", len(pages))
+	}
+
+	if pages[0].offset != 0 || pages[0].section.Size() != pageSize {
+		t.Fatalf("Expected the first page to be at offset 0 with %d bytes but got %d / %d", pageSize, pages[0].offset, pages[0].section.Size())
+	}
+
+	if pages[1].offset != 3*pageSize || pages[1].section.Size() != pageSize {
+		t.Fatalf("Expected the second page to be at offset %d with %d bytes but got %d / %d", 3*pageSize, pageSize, pages[1].offset, pages[1].section.Size())
+	}
+}
+
+func TestBlobUploadPageBlobSplitUnaligned(t *testing.T) {
+	content := []byte("hello world")
+
+	size, pages, err := BlobUpload{}.pageBlobSplit(testBlobUploadSource(content))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if size != int64(len(content)) {
+		t.Fatalf("Expected the size to be %d but got %d", len(content), size)
+	}
+
+	if len(pages) != 1 || pages[0].offset != 0 {
+		t.Fatalf("Expected a single page at offset 0 but got %+v", pages)
+	}
+}
+
+func TestFileContentMD5(t *testing.T) {
+	file, err := ioutil.TempFile("", "blob-upload")
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString("hello world"); err != nil {
+		t.Fatalf("Error writing temporary file: %+v", err)
+	}
+	file.Close()
+
+	actual, err := FileContentMD5(file.Name())
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := "XrY7u+Ae7tCTyyK7j1rNww=="
+	if actual != expected {
+		t.Fatalf("Expected the MD5 to be %q but got %q", expected, actual)
+	}
+
+	if _, err := FileContentMD5(file.Name() + "-missing"); err == nil {
+		t.Fatalf("Expected an error for a missing file but didn't get one")
+	}
+}
+
+func testBlobUploadSource(content []byte) blobUploadSource {
+	return blobUploadSource{
+		name:   "test content",
+		reader: bytes.NewReader(content),
+		size:   int64(len(content)),
+	}
+}
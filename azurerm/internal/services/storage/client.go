@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/blob/accounts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/filesystems"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/paths"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/directories"
@@ -20,17 +24,35 @@ import (
 )
 
 type Client struct {
-	QueuesClient queues.Client
+	BlobContainersClient     storage.BlobContainersClient
+	BlobServicesClient       storage.BlobServicesClient
+	ManagementPoliciesClient storage.ManagementPoliciesClient
+	QueuesClient             queues.Client
+
+	// UseAzureADAuthentication specifies whether the Data Lake Storage Gen2 clients authenticate
+	// using Azure Active Directory rather than the Storage Account Key
+	UseAzureADAuthentication bool
+
 	// this is currently unexported since we only use it to look up the account key
 	// we could export/use this in the future - but there's no point it being public
 	// until that time
 	accountsClient storage.AccountsClient
 	environment    az.Environment
+	storageAdAuth  autorest.Authorizer
 }
 
 // NOTE: this temporarily diverges from the other clients until we move this client in here
 // once we have this, can take an Options like everything else
 func BuildClient(accountsClient storage.AccountsClient, options *common.ClientOptions) *Client {
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
+	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
+
+	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
+
 	queuesClient := queues.New()
 	options.ConfigureClient(&queuesClient.Client, options.StorageAuthorizer)
 
@@ -39,29 +61,34 @@ func BuildClient(accountsClient storage.AccountsClient, options *common.ClientOp
 	return &Client{
 		accountsClient: accountsClient,
 		environment:    options.Environment,
+		storageAdAuth:  options.StorageAuthorizer,
 
-		QueuesClient: queuesClient,
+		BlobContainersClient:     blobContainersClient,
+		BlobServicesClient:       blobServicesClient,
+		ManagementPoliciesClient: managementPoliciesClient,
+		QueuesClient:             queuesClient,
 	}
 }
 
 func (client Client) FindResourceGroup(ctx context.Context, accountName string) (*string, error) {
-	accounts, err := client.accountsClient.List(ctx)
+	accounts, err := client.accountsClient.ListComplete(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
 	}
 
-	if accounts.Value == nil {
-		return nil, nil
-	}
-
 	var resourceGroup *string
-	for _, account := range *accounts.Value {
+	for accounts.NotDone() {
+		account := accounts.Value()
+		if err := accounts.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
+		}
+
 		if account.Name == nil || account.ID == nil {
 			continue
 		}
 
 		if strings.EqualFold(accountName, *account.Name) {
-			id, err := azure.ParseAzureResourceID(*account.ID)
+			id, err := resourceid.ParseStorageAccountID(*account.ID)
 			if err != nil {
 				return nil, fmt.Errorf("Error parsing ID for Storage Account %q: %s", accountName, err)
 			}
@@ -74,6 +101,18 @@ func (client Client) FindResourceGroup(ctx context.Context, accountName string)
 	return resourceGroup, nil
 }
 
+func (client Client) AccountsClient(ctx context.Context, resourceGroup, accountName string) (*accounts.Client, error) {
+	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
+	}
+
+	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
+	accountsClient := accounts.NewWithEnvironment(client.environment)
+	accountsClient.Client.Authorizer = storageAuth
+	return &accountsClient, nil
+}
+
 func (client Client) BlobsClient(ctx context.Context, resourceGroup, accountName string) (*blobs.Client, error) {
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
@@ -98,6 +137,28 @@ func (client Client) ContainersClient(ctx context.Context, resourceGroup, accoun
 	return &containersClient, nil
 }
 
+func (client Client) DataLakeFileSystemsClient(ctx context.Context, resourceGroup, accountName string) (*filesystems.Client, error) {
+	storageAuth, err := client.dataLakeStoreAuthorizer(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSystemsClient := filesystems.NewWithEnvironment(client.environment)
+	fileSystemsClient.Client.Authorizer = storageAuth
+	return &fileSystemsClient, nil
+}
+
+func (client Client) DataLakePathsClient(ctx context.Context, resourceGroup, accountName string) (*paths.Client, error) {
+	storageAuth, err := client.dataLakeStoreAuthorizer(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	pathsClient := paths.NewWithEnvironment(client.environment)
+	pathsClient.Client.Authorizer = storageAuth
+	return &pathsClient, nil
+}
+
 func (client Client) FileShareDirectoriesClient(ctx context.Context, resourceGroup, accountName string) (*directories.Client, error) {
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
@@ -146,8 +207,23 @@ func (client Client) TablesClient(ctx context.Context, resourceGroup, accountNam
 	return &tablesClient, nil
 }
 
+// dataLakeStoreAuthorizer returns the Authorizer used for the DFS endpoint, which uses either the
+// Azure Active Directory token for Storage or the Storage Account Key
+func (client Client) dataLakeStoreAuthorizer(ctx context.Context, resourceGroup, accountName string) (autorest.Authorizer, error) {
+	if client.UseAzureADAuthentication {
+		return client.storageAdAuth, nil
+	}
+
+	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
+	}
+
+	return authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey), nil
+}
+
 func (client Client) findAccountKey(ctx context.Context, resourceGroup, accountName string) (*string, error) {
-	props, err := client.accountsClient.ListKeys(ctx, resourceGroup, accountName)
+	props, err := client.accountsClient.ListKeys(ctx, resourceGroup, accountName, "")
 	if err != nil {
 		return nil, fmt.Errorf("Error Listing Keys for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
 	}
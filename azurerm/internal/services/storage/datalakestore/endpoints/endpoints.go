@@ -0,0 +1,65 @@
+package endpoints
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// GetDataLakeStoreEndpoint returns the endpoint for Data Lake Storage Gen2 (DFS) API Operations on this storage account
+//
+// The `baseUri` is normally the Storage Endpoint Suffix for the Environment (e.g. `core.windows.net`) - however
+// when this is a full URI (e.g. `http://127.0.0.1:10004`) it's assumed to be a local stand-in for the DFS API,
+// which (as with the Storage Emulator) uses path-style addressing for the Account Name.
+func GetDataLakeStoreEndpoint(baseUri string, accountName string) string {
+	if isPathStyleBaseUri(baseUri) {
+		return fmt.Sprintf("%s/%s", strings.TrimSuffix(baseUri, "/"), accountName)
+	}
+
+	return fmt.Sprintf("https://%s.dfs.%s", accountName, baseUri)
+}
+
+// ParseDataLakeStoreURI parses a URI returned from GetDataLakeStoreEndpoint (optionally with a path appended)
+// and returns the Account Name and the (unescaped) path within the Account, without a leading slash
+func ParseDataLakeStoreURI(input string) (accountName string, path string, err error) {
+	if input == "" {
+		return "", "", fmt.Errorf("`input` was empty")
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil {
+		return "", "", fmt.Errorf("Error parsing %q as a URL: %s", input, err)
+	}
+
+	if uri.Host == "" {
+		return "", "", fmt.Errorf("Expected %q to contain a Host", input)
+	}
+
+	path = strings.TrimPrefix(uri.Path, "/")
+
+	if isPathStyleHost(uri.Hostname()) {
+		segments := strings.SplitN(path, "/", 2)
+		accountName = segments[0]
+		path = ""
+		if len(segments) == 2 {
+			path = segments[1]
+		}
+	} else {
+		accountName = strings.Split(uri.Host, ".")[0]
+	}
+
+	if accountName == "" {
+		return "", "", fmt.Errorf("Expected %q to contain an Account Name", input)
+	}
+
+	return accountName, path, nil
+}
+
+func isPathStyleBaseUri(baseUri string) bool {
+	return strings.HasPrefix(baseUri, "http://") || strings.HasPrefix(baseUri, "https://")
+}
+
+func isPathStyleHost(host string) bool {
+	return strings.EqualFold(host, "localhost") || net.ParseIP(host) != nil
+}
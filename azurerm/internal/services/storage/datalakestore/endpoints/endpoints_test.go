@@ -0,0 +1,118 @@
+package endpoints
+
+import "testing"
+
+func TestGetDataLakeStoreEndpoint(t *testing.T) {
+	testData := []struct {
+		baseUri     string
+		accountName string
+		expected    string
+	}{
+		{
+			baseUri:     "core.windows.net",
+			accountName: "account1",
+			expected:    "https://account1.dfs.core.windows.net",
+		},
+		{
+			baseUri:     "core.chinacloudapi.cn",
+			accountName: "account1",
+			expected:    "https://account1.dfs.core.chinacloudapi.cn",
+		},
+		{
+			baseUri:     "http://127.0.0.1:10004",
+			accountName: "account1",
+			expected:    "http://127.0.0.1:10004/account1",
+		},
+		{
+			baseUri:     "http://localhost:10004/",
+			accountName: "account1",
+			expected:    "http://localhost:10004/account1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.baseUri, v.accountName)
+
+		actual := GetDataLakeStoreEndpoint(v.baseUri, v.accountName)
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestParseDataLakeStoreURI(t *testing.T) {
+	testData := []struct {
+		input       string
+		accountName string
+		path        string
+		shouldError bool
+	}{
+		{
+			input:       "",
+			shouldError: true,
+		},
+		{
+			input:       "account1",
+			shouldError: true,
+		},
+		{
+			input:       "https://account1.dfs.core.windows.net",
+			accountName: "account1",
+			path:        "",
+		},
+		{
+			input:       "https://account1.dfs.core.windows.net/filesystem1",
+			accountName: "account1",
+			path:        "filesystem1",
+		},
+		{
+			input:       "https://account1.dfs.core.windows.net/filesystem1/some/directory",
+			accountName: "account1",
+			path:        "filesystem1/some/directory",
+		},
+		{
+			input:       "https://account1.dfs.core.windows.net/filesystem1/some%20directory",
+			accountName: "account1",
+			path:        "filesystem1/some directory",
+		},
+		{
+			input:       "http://127.0.0.1:10004/account1/filesystem1/directory",
+			accountName: "account1",
+			path:        "filesystem1/directory",
+		},
+		{
+			input:       "http://localhost:10004/account1",
+			accountName: "account1",
+			path:        "",
+		},
+		{
+			input:       "http://localhost:10004/",
+			shouldError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		accountName, path, err := ParseDataLakeStoreURI(v.input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.shouldError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if accountName != v.accountName {
+			t.Fatalf("Expected the Account Name to be %q but got %q", v.accountName, accountName)
+		}
+
+		if path != v.path {
+			t.Fatalf("Expected the Path to be %q but got %q", v.path, path)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package filesystems
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
+)
+
+func TestFileSystemsRequests(t *testing.T) {
+	ctx := context.TODO()
+	accountName := "account1"
+	fileSystemName := "filesystem1"
+	properties := map[string]string{
+		"hello": "world",
+	}
+
+	testData := []struct {
+		name            string
+		call            func(client Client) (autorest.Response, error)
+		statusCode      int
+		expectedMethod  string
+		expectedHeaders map[string]string
+		expectError     bool
+	}{
+		{
+			name: "Create",
+			call: func(client Client) (autorest.Response, error) {
+				return client.Create(ctx, accountName, fileSystemName, CreateInput{Properties: properties})
+			},
+			statusCode:     http.StatusCreated,
+			expectedMethod: http.MethodPut,
+			expectedHeaders: map[string]string{
+				"x-ms-properties": "hello=d29ybGQ=",
+			},
+		},
+		{
+			name: "Create Existing",
+			call: func(client Client) (autorest.Response, error) {
+				return client.Create(ctx, accountName, fileSystemName, CreateInput{})
+			},
+			statusCode:     http.StatusConflict,
+			expectedMethod: http.MethodPut,
+			expectError:    true,
+		},
+		{
+			name: "Get Properties",
+			call: func(client Client) (autorest.Response, error) {
+				resp, err := client.GetProperties(ctx, accountName, fileSystemName)
+				return resp.Response, err
+			},
+			statusCode:     http.StatusOK,
+			expectedMethod: http.MethodHead,
+		},
+		{
+			name: "Get Properties Not Found",
+			call: func(client Client) (autorest.Response, error) {
+				resp, err := client.GetProperties(ctx, accountName, fileSystemName)
+				return resp.Response, err
+			},
+			statusCode:     http.StatusNotFound,
+			expectedMethod: http.MethodHead,
+			expectError:    true,
+		},
+		{
+			name: "Set Properties",
+			call: func(client Client) (autorest.Response, error) {
+				return client.SetProperties(ctx, accountName, fileSystemName, SetPropertiesInput{Properties: properties})
+			},
+			statusCode:     http.StatusOK,
+			expectedMethod: http.MethodPatch,
+			expectedHeaders: map[string]string{
+				"x-ms-properties": "hello=d29ybGQ=",
+			},
+		},
+		{
+			name: "Delete",
+			call: func(client Client) (autorest.Response, error) {
+				return client.Delete(ctx, accountName, fileSystemName)
+			},
+			statusCode:     http.StatusAccepted,
+			expectedMethod: http.MethodDelete,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		requests := make([]*http.Request, 0)
+		client := NewWithBaseURI("core.windows.net")
+		client.Authorizer = authorizers.NewSharedKeyLiteAuthorizer(accountName, authorizers.StorageEmulatorAccountKey)
+		client.RetryAttempts = 1
+		client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r)
+			return &http.Response{
+				StatusCode: v.statusCode,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+				Request:    r,
+			}, nil
+		})
+
+		resp, err := v.call(client)
+		if v.expectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if resp.Response == nil || resp.StatusCode != v.statusCode {
+			t.Fatalf("Expected the response to have a Status Code of %d but got %+v", v.statusCode, resp.Response)
+		}
+
+		if len(requests) != 1 {
+			t.Fatalf("Expected 1 request but got %d", len(requests))
+		}
+		request := requests[0]
+		if request.Method != v.expectedMethod {
+			t.Fatalf("Expected a %s request but got %s", v.expectedMethod, request.Method)
+		}
+		if expected := "https://account1.dfs.core.windows.net/filesystem1?resource=filesystem"; request.URL.String() != expected {
+			t.Fatalf("Expected the URL to be %q but got %q", expected, request.URL.String())
+		}
+		if !strings.HasPrefix(request.Header.Get("Authorization"), "SharedKeyLite account1:") {
+			t.Fatalf("Expected the request to be authorized using SharedKeyLite but got %q", request.Header.Get("Authorization"))
+		}
+
+		expectedHeaders := map[string]string{
+			"x-ms-version": APIVersion,
+		}
+		for k, v := range v.expectedHeaders {
+			expectedHeaders[k] = v
+		}
+		for k, expected := range expectedHeaders {
+			if actual := request.Header.Get(k); actual != expected {
+				t.Fatalf("Expected the header %q to be %q but got %q", k, expected, actual)
+			}
+		}
+	}
+}
+
+func TestFileSystemsGetPropertiesResponder(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Properties":        []string{"hello=d29ybGQ=,second=dmFsdWUgd2l0aCBzcGFjZXM="},
+			"X-Ms-Namespace-Enabled": []string{"true"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader("")),
+	}
+
+	result, err := New().GetPropertiesResponder(resp)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]string{
+		"hello":  "world",
+		"second": "value with spaces",
+	}
+	if !reflect.DeepEqual(result.Properties, expected) {
+		t.Fatalf("Expected the Properties to be %+v but got %+v", expected, result.Properties)
+	}
+	if !result.NamespaceEnabled {
+		t.Fatalf("Expected the Namespace to be enabled")
+	}
+}
+
+func TestFileSystemsResourceID(t *testing.T) {
+	testData := []struct {
+		id       string
+		expected *ResourceID
+	}{
+		{
+			id: "https://account1.dfs.core.windows.net/filesystem1",
+			expected: &ResourceID{
+				AccountName:    "account1",
+				FileSystemName: "filesystem1",
+			},
+		},
+		{
+			id: "",
+		},
+		{
+			id: "https://account1.dfs.core.windows.net",
+		},
+		{
+			id: "https://account1.dfs.core.windows.net/filesystem1/directory",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.id)
+
+		actual, err := ParseResourceID(v.id)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", v.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error parsing %q: %s", v.id, err)
+		}
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", *v.expected, *actual)
+		}
+	}
+
+	if id := New().GetResourceID("account1", "filesystem1"); id != testData[0].id {
+		t.Fatalf("Expected the Resource ID to be %q but got %q", testData[0].id, id)
+	}
+}
+
+func TestFileSystemsProperties(t *testing.T) {
+	input := map[string]string{
+		"b": "2",
+		"a": "hello world",
+	}
+
+	header := formatPropertiesForHeader(input)
+	if expected := "a=aGVsbG8gd29ybGQ=,b=Mg=="; header != expected {
+		t.Fatalf("Expected the header to be %q but got %q", expected, header)
+	}
+
+	parsed, err := parsePropertiesFromHeader(header)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %s", header, err)
+	}
+	if !reflect.DeepEqual(parsed, input) {
+		t.Fatalf("Expected %+v but got %+v", input, parsed)
+	}
+
+	if _, err := parsePropertiesFromHeader("a"); err == nil {
+		t.Fatalf("Expected an error parsing a property without a value")
+	}
+
+	if err := validateProperties(map[string]string{"a,b": "c"}); err == nil {
+		t.Fatalf("Expected an error validating a property name containing a comma")
+	}
+}
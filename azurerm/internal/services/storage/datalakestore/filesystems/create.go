@@ -0,0 +1,97 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+type CreateInput struct {
+	// A name-value pair to associate with the File System as a Property.
+	Properties map[string]string
+}
+
+// Create creates a new File System within the specified Storage Account.
+// If the File System with the same name already exists, the operation fails.
+func (client Client) Create(ctx context.Context, accountName, fileSystemName string, input CreateInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("filesystems.Client#Create: `accountName` cannot be an empty string")
+	}
+	if fileSystemName == "" {
+		return result, fmt.Errorf("filesystems.Client#Create: `fileSystemName` cannot be an empty string")
+	}
+	if err := validateProperties(input.Properties); err != nil {
+		return result, fmt.Errorf("filesystems.Client#Create: `input.Properties` is not valid: %s", err)
+	}
+
+	req, err := client.CreatePreparer(ctx, accountName, fileSystemName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "Create", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "Create", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreatePreparer prepares the Create request.
+func (client Client) CreatePreparer(ctx context.Context, accountName, fileSystemName string, input CreateInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"resource": autorest.Encode("query", "filesystem"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": APIVersion,
+	}
+
+	if len(input.Properties) > 0 {
+		headers["x-ms-properties"] = formatPropertiesForHeader(input.Properties)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPut(),
+		autorest.WithBaseURL(endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateSender sends the Create request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) CreateSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// CreateResponder handles the response to the Create request. The method always
+// closes the http.Response Body.
+func (client Client) CreateResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusCreated),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,107 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+type GetPropertiesResponse struct {
+	autorest.Response
+
+	// A map of name-value pairs associated with the File System as Properties.
+	Properties map[string]string
+
+	// Is the Hierarchical Namespace enabled for the Storage Account containing this File System?
+	NamespaceEnabled bool
+}
+
+// GetProperties returns the Properties for the specified File System
+func (client Client) GetProperties(ctx context.Context, accountName, fileSystemName string) (result GetPropertiesResponse, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("filesystems.Client#GetProperties: `accountName` cannot be an empty string")
+	}
+	if fileSystemName == "" {
+		return result, fmt.Errorf("filesystems.Client#GetProperties: `fileSystemName` cannot be an empty string")
+	}
+
+	req, err := client.GetPropertiesPreparer(ctx, accountName, fileSystemName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "GetProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetPropertiesSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "GetProperties", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetPropertiesResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "GetProperties", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetPropertiesPreparer prepares the GetProperties request.
+func (client Client) GetPropertiesPreparer(ctx context.Context, accountName, fileSystemName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"resource": autorest.Encode("query", "filesystem"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsHead(),
+		autorest.WithBaseURL(endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetPropertiesSender sends the GetProperties request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) GetPropertiesSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// GetPropertiesResponder handles the response to the GetProperties request. The method always
+// closes the http.Response Body.
+func (client Client) GetPropertiesResponder(resp *http.Response) (result GetPropertiesResponse, err error) {
+	if resp != nil && resp.StatusCode == http.StatusOK {
+		properties, parseErr := parsePropertiesFromHeader(resp.Header.Get("x-ms-properties"))
+		if parseErr != nil {
+			result.Response = autorest.Response{Response: resp}
+			return result, parseErr
+		}
+
+		result.Properties = properties
+		result.NamespaceEnabled = strings.EqualFold(resp.Header.Get("x-ms-namespace-enabled"), "true")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+
+	return
+}
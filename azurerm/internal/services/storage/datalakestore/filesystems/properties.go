@@ -0,0 +1,71 @@
+package filesystems
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// parsePropertiesFromHeader parses the `x-ms-properties` header, which is a comma-separated
+// list of `name=value` pairs where each value is Base64 encoded
+func parsePropertiesFromHeader(input string) (map[string]string, error) {
+	properties := make(map[string]string)
+	if input == "" {
+		return properties, nil
+	}
+
+	for _, pair := range strings.Split(input, ",") {
+		segments := strings.SplitN(pair, "=", 2)
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("Expected the property %q to be in the format `name=value`", pair)
+		}
+
+		value, err := base64.StdEncoding.DecodeString(segments[1])
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding the value for the property %q: %s", segments[0], err)
+		}
+
+		properties[segments[0]] = string(value)
+	}
+
+	return properties, nil
+}
+
+// formatPropertiesForHeader returns the value for the `x-ms-properties` header
+func formatPropertiesForHeader(input map[string]string) string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	// sorted to ensure the header is consistent between requests
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value := base64.StdEncoding.EncodeToString([]byte(input[k]))
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, value))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func validateProperties(input map[string]string) error {
+	for k := range input {
+		if k == "" {
+			return fmt.Errorf("property names cannot be empty")
+		}
+
+		if strings.ContainsAny(k, ",=") {
+			return fmt.Errorf("property names cannot contain `,` or `=` but got %q", k)
+		}
+
+		for _, r := range k {
+			if r > 127 {
+				return fmt.Errorf("property names must be ASCII but got %q", k)
+			}
+		}
+	}
+
+	return nil
+}
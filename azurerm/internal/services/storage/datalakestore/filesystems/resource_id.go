@@ -0,0 +1,39 @@
+package filesystems
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+// GetResourceID returns the Resource ID for the given File System
+// This can be useful when, for example, you're using this as a unique identifier
+func (client Client) GetResourceID(accountName, fileSystemName string) string {
+	domain := endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)
+	return fmt.Sprintf("%s/%s", domain, fileSystemName)
+}
+
+type ResourceID struct {
+	AccountName    string
+	FileSystemName string
+}
+
+// ParseResourceID parses the Resource ID and returns an object which can be used
+// to interact with the File System Resource
+func ParseResourceID(id string) (*ResourceID, error) {
+	// example: https://foo.dfs.core.windows.net/Bar
+	accountName, path, err := endpoints.ParseDataLakeStoreURI(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" || strings.Contains(path, "/") {
+		return nil, fmt.Errorf("Expected %q to contain a single File System Name", id)
+	}
+
+	return &ResourceID{
+		AccountName:    accountName,
+		FileSystemName: path,
+	}, nil
+}
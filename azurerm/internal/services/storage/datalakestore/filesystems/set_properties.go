@@ -0,0 +1,94 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+type SetPropertiesInput struct {
+	// A name-value pair to associate with the File System as a Property.
+	// Any Properties not included here are removed from the File System.
+	Properties map[string]string
+}
+
+// SetProperties replaces the Properties for the specified File System
+func (client Client) SetProperties(ctx context.Context, accountName, fileSystemName string, input SetPropertiesInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("filesystems.Client#SetProperties: `accountName` cannot be an empty string")
+	}
+	if fileSystemName == "" {
+		return result, fmt.Errorf("filesystems.Client#SetProperties: `fileSystemName` cannot be an empty string")
+	}
+	if err := validateProperties(input.Properties); err != nil {
+		return result, fmt.Errorf("filesystems.Client#SetProperties: `input.Properties` is not valid: %s", err)
+	}
+
+	req, err := client.SetPropertiesPreparer(ctx, accountName, fileSystemName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "SetProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.SetPropertiesSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "SetProperties", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.SetPropertiesResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "filesystems.Client", "SetProperties", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// SetPropertiesPreparer prepares the SetProperties request.
+func (client Client) SetPropertiesPreparer(ctx context.Context, accountName, fileSystemName string, input SetPropertiesInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"resource": autorest.Encode("query", "filesystem"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version":    APIVersion,
+		"x-ms-properties": formatPropertiesForHeader(input.Properties),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// SetPropertiesSender sends the SetProperties request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) SetPropertiesSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// SetPropertiesResponder handles the response to the SetProperties request. The method always
+// closes the http.Response Body.
+func (client Client) SetPropertiesResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	return
+}
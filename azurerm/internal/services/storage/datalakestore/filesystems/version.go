@@ -0,0 +1,10 @@
+package filesystems
+
+import "fmt"
+
+// APIVersion is the version of the API used for all Data Lake Storage Gen2 Operations
+const APIVersion = "2018-11-09"
+
+func UserAgent() string {
+	return fmt.Sprintf("terraform-provider-azurerm/datalakestore storage/%s", APIVersion)
+}
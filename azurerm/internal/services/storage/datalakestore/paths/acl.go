@@ -0,0 +1,121 @@
+package paths
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type TagType string
+
+const (
+	TagTypeUser  TagType = "user"
+	TagTypeGroup TagType = "group"
+	TagTypeMask  TagType = "mask"
+	TagTypeOther TagType = "other"
+)
+
+var permissionsRegex = regexp.MustCompile(`^[r-][w-][x-]$`)
+
+// ACE is a single Access Control Entry, in the format `[default:]{type}:[{id}]:{permissions}`
+type ACE struct {
+	// IsDefault specifies whether this is a Default ACE (which is inherited by new children of a Directory)
+	// rather than an Access ACE
+	IsDefault bool
+
+	// TagType is the type of Entry
+	TagType TagType
+
+	// TagQualifier is the Object ID of the User/Group/Service Principal this Entry applies to,
+	// which is empty for the Owning User/Group, the Mask and Other
+	TagQualifier string
+
+	// Permissions are the permissions granted in the format `rwx`, where a `-` is used for an ungranted permission
+	Permissions string
+}
+
+// ACL is an Access Control List, comprising of one or more Access Control Entries
+type ACL struct {
+	Entries []ACE
+}
+
+// ParseACE parses a single Access Control Entry
+func ParseACE(input string) (*ACE, error) {
+	ace := ACE{}
+
+	segments := strings.Split(input, ":")
+	if len(segments) == 4 {
+		if segments[0] != "default" {
+			return nil, fmt.Errorf("Expected the scope of the ACE %q to be `default` but got %q", input, segments[0])
+		}
+		ace.IsDefault = true
+		segments = segments[1:]
+	}
+
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("Expected the ACE %q to be in the format `[default:]{type}:[{id}]:{permissions}`", input)
+	}
+
+	tagType := TagType(segments[0])
+	switch tagType {
+	case TagTypeUser, TagTypeGroup:
+		// the Owning User/Group has no Qualifier
+	case TagTypeMask, TagTypeOther:
+		if segments[1] != "" {
+			return nil, fmt.Errorf("The ACE %q of type %q cannot contain an ID", input, string(tagType))
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported type %q in the ACE %q", segments[0], input)
+	}
+	ace.TagType = tagType
+	ace.TagQualifier = segments[1]
+
+	if !permissionsRegex.MatchString(segments[2]) {
+		return nil, fmt.Errorf("Expected the permissions of the ACE %q to be in the format `rwx` but got %q", input, segments[2])
+	}
+	ace.Permissions = segments[2]
+
+	return &ace, nil
+}
+
+// ParseACL parses a comma-separated Access Control List
+func ParseACL(input string) (*ACL, error) {
+	acl := ACL{
+		Entries: make([]ACE, 0),
+	}
+
+	if input == "" {
+		return &acl, nil
+	}
+
+	for _, v := range strings.Split(input, ",") {
+		ace, err := ParseACE(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+
+		acl.Entries = append(acl.Entries, *ace)
+	}
+
+	return &acl, nil
+}
+
+// String returns the Access Control Entry in the format used by the API
+func (ace ACE) String() string {
+	prefix := ""
+	if ace.IsDefault {
+		prefix = "default:"
+	}
+
+	return fmt.Sprintf("%s%s:%s:%s", prefix, string(ace.TagType), ace.TagQualifier, ace.Permissions)
+}
+
+// String returns the Access Control List in the format used by the API
+func (acl ACL) String() string {
+	entries := make([]string, 0, len(acl.Entries))
+	for _, v := range acl.Entries {
+		entries = append(entries, v.String())
+	}
+
+	return strings.Join(entries, ",")
+}
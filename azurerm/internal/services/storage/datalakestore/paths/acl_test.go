@@ -0,0 +1,115 @@
+package paths
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseACE(t *testing.T) {
+	testData := []struct {
+		input       string
+		expected    *ACE
+		shouldError bool
+	}{
+		{
+			input:       "",
+			shouldError: true,
+		},
+		{
+			input:       "user:rwx",
+			shouldError: true,
+		},
+		{
+			input: "user::rwx",
+			expected: &ACE{
+				TagType:     TagTypeUser,
+				Permissions: "rwx",
+			},
+		},
+		{
+			input: "group:00000000-0000-0000-0000-000000000001:r-x",
+			expected: &ACE{
+				TagType:      TagTypeGroup,
+				TagQualifier: "00000000-0000-0000-0000-000000000001",
+				Permissions:  "r-x",
+			},
+		},
+		{
+			input: "default:other::---",
+			expected: &ACE{
+				IsDefault:   true,
+				TagType:     TagTypeOther,
+				Permissions: "---",
+			},
+		},
+		{
+			input:       "access:user::rwx",
+			shouldError: true,
+		},
+		{
+			input:       "mask:00000000-0000-0000-0000-000000000001:rwx",
+			shouldError: true,
+		},
+		{
+			input:       "owner::rwx",
+			shouldError: true,
+		},
+		{
+			input:       "user::xwr",
+			shouldError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := ParseACE(v.input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.shouldError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(*actual, *v.expected) {
+			t.Fatalf("Expected %+v but got %+v", *v.expected, *actual)
+		}
+
+		if actual.String() != v.input {
+			t.Fatalf("Expected the ACE to round-trip to %q but got %q", v.input, actual.String())
+		}
+	}
+}
+
+func TestParseACL(t *testing.T) {
+	input := "user::rwx,group::r-x,other::---,default:user:00000000-0000-0000-0000-000000000001:r--"
+	acl, err := ParseACL(input)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %s", input, err)
+	}
+
+	if len(acl.Entries) != 4 {
+		t.Fatalf("Expected 4 entries but got %d", len(acl.Entries))
+	}
+
+	if acl.String() != input {
+		t.Fatalf("Expected the ACL to round-trip to %q but got %q", input, acl.String())
+	}
+
+	empty, err := ParseACL("")
+	if err != nil {
+		t.Fatalf("Error parsing an empty ACL: %s", err)
+	}
+	if len(empty.Entries) != 0 {
+		t.Fatalf("Expected no entries but got %d", len(empty.Entries))
+	}
+
+	if _, err := ParseACL("user::rwx,nope"); err == nil {
+		t.Fatalf("Expected an error parsing an invalid ACL but didn't get one")
+	}
+}
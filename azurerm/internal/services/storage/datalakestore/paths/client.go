@@ -0,0 +1,42 @@
+package paths
+
+import (
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Client is the base client for Data Lake Storage Gen2 Paths.
+type Client struct {
+	autorest.Client
+	BaseURI string
+}
+
+// New creates an instance of the Client client.
+func New() Client {
+	return NewWithEnvironment(azure.PublicCloud)
+}
+
+// NewWithEnvironment creates an instance of the Client client.
+func NewWithEnvironment(environment azure.Environment) Client {
+	return NewWithBaseURI(environment.StorageEndpointSuffix)
+}
+
+// NewWithBaseURI creates an instance of the Client client using the specified Base URI - which is either
+// a Storage Endpoint Suffix (e.g. `core.windows.net`) or the full URI of a local stand-in for the DFS API.
+func NewWithBaseURI(baseUri string) Client {
+	return Client{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: baseUri,
+	}
+}
+
+// encodePath escapes each segment of the (slash-separated) path within the File System
+func encodePath(input string) string {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	for i, v := range segments {
+		segments[i] = autorest.Encode("path", v)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,237 @@
+package paths
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
+)
+
+func TestPathsRequests(t *testing.T) {
+	ctx := context.TODO()
+	accountName := "account1"
+	fileSystemName := "filesystem1"
+	path := "some/nested directory"
+	owner := "00000000-0000-0000-0000-000000000001"
+	acl := "user::rwx,user:00000000-0000-0000-0000-000000000002:r-x,group::r-x,mask::r-x,other::---,default:user::rwx"
+
+	testData := []struct {
+		name            string
+		call            func(client Client) (autorest.Response, error)
+		statusCode      int
+		expectedMethod  string
+		expectedQuery   string
+		expectedHeaders map[string]string
+		expectError     bool
+	}{
+		{
+			name: "Create",
+			call: func(client Client) (autorest.Response, error) {
+				return client.Create(ctx, accountName, fileSystemName, path, CreateInput{Resource: PathResourceDirectory})
+			},
+			statusCode:     http.StatusCreated,
+			expectedMethod: http.MethodPut,
+			expectedQuery:  "resource=directory",
+		},
+		{
+			name: "Get Access Control",
+			call: func(client Client) (autorest.Response, error) {
+				resp, err := client.GetProperties(ctx, accountName, fileSystemName, path, GetPropertiesActionGetAccessControl)
+				return resp.Response, err
+			},
+			statusCode:     http.StatusOK,
+			expectedMethod: http.MethodHead,
+			expectedQuery:  "action=getAccessControl",
+		},
+		{
+			name: "Get Status Not Found",
+			call: func(client Client) (autorest.Response, error) {
+				resp, err := client.GetProperties(ctx, accountName, fileSystemName, path, GetPropertiesActionGetStatus)
+				return resp.Response, err
+			},
+			statusCode:     http.StatusNotFound,
+			expectedMethod: http.MethodHead,
+			expectedQuery:  "action=getStatus",
+			expectError:    true,
+		},
+		{
+			name: "Set Access Control",
+			call: func(client Client) (autorest.Response, error) {
+				return client.SetAccessControl(ctx, accountName, fileSystemName, path, SetAccessControlInput{
+					Owner: &owner,
+					ACL:   &acl,
+				})
+			},
+			statusCode:     http.StatusOK,
+			expectedMethod: http.MethodPatch,
+			expectedQuery:  "action=setAccessControl",
+			expectedHeaders: map[string]string{
+				"x-ms-owner": owner,
+				"x-ms-group": "",
+				"x-ms-acl":   acl,
+			},
+		},
+		{
+			name: "Delete",
+			call: func(client Client) (autorest.Response, error) {
+				return client.Delete(ctx, accountName, fileSystemName, path)
+			},
+			statusCode:     http.StatusOK,
+			expectedMethod: http.MethodDelete,
+			expectedQuery:  "recursive=true",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		requests := make([]*http.Request, 0)
+		client := NewWithBaseURI("core.windows.net")
+		client.Authorizer = authorizers.NewSharedKeyLiteAuthorizer(accountName, authorizers.StorageEmulatorAccountKey)
+		client.RetryAttempts = 1
+		client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r)
+			return &http.Response{
+				StatusCode: v.statusCode,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+				Request:    r,
+			}, nil
+		})
+
+		resp, err := v.call(client)
+		if v.expectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if resp.Response == nil || resp.StatusCode != v.statusCode {
+			t.Fatalf("Expected the response to have a Status Code of %d but got %+v", v.statusCode, resp.Response)
+		}
+
+		if len(requests) != 1 {
+			t.Fatalf("Expected 1 request but got %d", len(requests))
+		}
+		request := requests[0]
+		if request.Method != v.expectedMethod {
+			t.Fatalf("Expected a %s request but got %s", v.expectedMethod, request.Method)
+		}
+		if expected := "account1.dfs.core.windows.net"; request.URL.Host != expected {
+			t.Fatalf("Expected the Host to be %q but got %q", expected, request.URL.Host)
+		}
+		if expected := "/filesystem1/some/nested directory"; request.URL.Path != expected {
+			t.Fatalf("Expected the Path to be %q but got %q", expected, request.URL.Path)
+		}
+		if request.URL.RawQuery != v.expectedQuery {
+			t.Fatalf("Expected the Query to be %q but got %q", v.expectedQuery, request.URL.RawQuery)
+		}
+		if !strings.HasPrefix(request.Header.Get("Authorization"), "SharedKeyLite account1:") {
+			t.Fatalf("Expected the request to be authorized using SharedKeyLite but got %q", request.Header.Get("Authorization"))
+		}
+
+		expectedHeaders := map[string]string{
+			"x-ms-version": APIVersion,
+		}
+		for k, v := range v.expectedHeaders {
+			expectedHeaders[k] = v
+		}
+		for k, expected := range expectedHeaders {
+			if actual := request.Header.Get(k); actual != expected {
+				t.Fatalf("Expected the header %q to be %q but got %q", k, expected, actual)
+			}
+		}
+	}
+}
+
+func TestPathsSetAccessControlInvalidACL(t *testing.T) {
+	requests := 0
+	client := NewWithBaseURI("core.windows.net")
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("")), Request: r}, nil
+	})
+
+	invalidAcl := "user::rwz"
+	if _, err := client.SetAccessControl(context.TODO(), "account1", "filesystem1", "directory", SetAccessControlInput{ACL: &invalidAcl}); err == nil {
+		t.Fatalf("Expected an error setting an invalid ACL but didn't get one")
+	}
+	if requests != 0 {
+		t.Fatalf("Expected no requests to be sent for an invalid ACL but got %d", requests)
+	}
+}
+
+func TestPathsGetPropertiesResponder(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Resource-Type": []string{"directory"},
+			"X-Ms-Owner":         []string{"$superuser"},
+			"X-Ms-Group":         []string{"$superuser"},
+			"X-Ms-Permissions":   []string{"rwxr-x---"},
+			"X-Ms-Acl":           []string{"user::rwx,group::r-x,other::---"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader("")),
+	}
+
+	result, err := New().GetPropertiesResponder(resp)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := GetPropertiesResponse{
+		ResourceType: PathResourceDirectory,
+		Owner:        "$superuser",
+		Group:        "$superuser",
+		Permissions:  "rwxr-x---",
+		ACL:          "user::rwx,group::r-x,other::---",
+	}
+	expected.Response = result.Response
+	if result != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, result)
+	}
+}
+
+func TestPathsResourceID(t *testing.T) {
+	testData := []struct {
+		baseUri string
+		id      string
+	}{
+		{
+			baseUri: "core.windows.net",
+			id:      "https://account1.dfs.core.windows.net/filesystem1/some/directory",
+		},
+		{
+			baseUri: "http://127.0.0.1:10004",
+			id:      "http://127.0.0.1:10004/account1/filesystem1/some/directory",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.baseUri)
+
+		client := NewWithBaseURI(v.baseUri)
+		id := client.GetResourceID("account1", "filesystem1", "/some/directory/")
+		if id != v.id {
+			t.Fatalf("Expected the Resource ID to be %q but got %q", v.id, id)
+		}
+
+		parsed, err := ParseResourceID(id)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %s", id, err)
+		}
+		if parsed.AccountName != "account1" || parsed.FileSystemName != "filesystem1" || parsed.Path != "some/directory" {
+			t.Fatalf("Unexpected Resource ID: %+v", *parsed)
+		}
+	}
+
+	for _, v := range []string{"", "https://account1.dfs.core.windows.net/filesystem1", "https://account1.dfs.core.windows.net/filesystem1/"} {
+		if _, err := ParseResourceID(v); err == nil {
+			t.Fatalf("Expected an error parsing %q but didn't get one", v)
+		}
+	}
+}
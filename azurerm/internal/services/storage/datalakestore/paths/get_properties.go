@@ -0,0 +1,118 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+type GetPropertiesAction string
+
+const (
+	// GetPropertiesActionGetAccessControl returns the Owner, Group, Permissions and ACL for the Path
+	GetPropertiesActionGetAccessControl GetPropertiesAction = "getAccessControl"
+	// GetPropertiesActionGetStatus returns the System Properties for the Path
+	GetPropertiesActionGetStatus GetPropertiesAction = "getStatus"
+)
+
+type GetPropertiesResponse struct {
+	autorest.Response
+
+	ResourceType PathResource
+	Owner        string
+	Group        string
+	// Permissions are the POSIX Permissions for the Owner, Group and Other (e.g. `rwxr-x---`)
+	Permissions string
+	// ACL is the Access Control List for the Path in the format used by the API (see ParseACL)
+	ACL string
+}
+
+// GetProperties returns the System Properties or the Access Control for the specified Path
+func (client Client) GetProperties(ctx context.Context, accountName, fileSystemName, path string, action GetPropertiesAction) (result GetPropertiesResponse, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("paths.Client#GetProperties: `accountName` cannot be an empty string")
+	}
+	if fileSystemName == "" {
+		return result, fmt.Errorf("paths.Client#GetProperties: `fileSystemName` cannot be an empty string")
+	}
+	if path == "" {
+		return result, fmt.Errorf("paths.Client#GetProperties: `path` cannot be an empty string")
+	}
+
+	req, err := client.GetPropertiesPreparer(ctx, accountName, fileSystemName, path, action)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "GetProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetPropertiesSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "paths.Client", "GetProperties", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetPropertiesResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "GetProperties", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetPropertiesPreparer prepares the GetProperties request.
+func (client Client) GetPropertiesPreparer(ctx context.Context, accountName, fileSystemName, path string, action GetPropertiesAction) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+		"path":           encodePath(path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action": autorest.Encode("query", string(action)),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsHead(),
+		autorest.WithBaseURL(endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetPropertiesSender sends the GetProperties request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) GetPropertiesSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// GetPropertiesResponder handles the response to the GetProperties request. The method always
+// closes the http.Response Body.
+func (client Client) GetPropertiesResponder(resp *http.Response) (result GetPropertiesResponse, err error) {
+	if resp != nil && resp.StatusCode == http.StatusOK {
+		result.ResourceType = PathResource(resp.Header.Get("x-ms-resource-type"))
+		result.Owner = resp.Header.Get("x-ms-owner")
+		result.Group = resp.Header.Get("x-ms-group")
+		result.Permissions = resp.Header.Get("x-ms-permissions")
+		result.ACL = resp.Header.Get("x-ms-acl")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+
+	return
+}
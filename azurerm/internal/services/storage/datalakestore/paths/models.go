@@ -0,0 +1,10 @@
+package paths
+
+type PathResource string
+
+const (
+	// PathResourceDirectory is a Directory within a File System
+	PathResourceDirectory PathResource = "directory"
+	// PathResourceFile is a File within a File System
+	PathResourceFile PathResource = "file"
+)
@@ -0,0 +1,42 @@
+package paths
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+// GetResourceID returns the Resource ID for the given Path
+// This can be useful when, for example, you're using this as a unique identifier
+func (client Client) GetResourceID(accountName, fileSystemName, path string) string {
+	domain := endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)
+	return fmt.Sprintf("%s/%s/%s", domain, fileSystemName, strings.Trim(path, "/"))
+}
+
+type ResourceID struct {
+	AccountName    string
+	FileSystemName string
+	Path           string
+}
+
+// ParseResourceID parses the Resource ID and returns an object which can be used
+// to interact with the Path Resource
+func ParseResourceID(id string) (*ResourceID, error) {
+	// example: https://foo.dfs.core.windows.net/Bar/some/directory
+	accountName, path, err := endpoints.ParseDataLakeStoreURI(id)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.SplitN(path, "/", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("Expected %q to contain a File System Name and a Path", id)
+	}
+
+	return &ResourceID{
+		AccountName:    accountName,
+		FileSystemName: segments[0],
+		Path:           segments[1],
+	}, nil
+}
@@ -0,0 +1,114 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/endpoints"
+)
+
+type SetAccessControlInput struct {
+	// The Object ID of the Owning User, or `$superuser`. This is left unchanged when nil.
+	Owner *string
+
+	// The Object ID of the Owning Group, or `$superuser`. This is left unchanged when nil.
+	Group *string
+
+	// The Access Control List for the Path, which replaces any existing ACL. This is left unchanged when nil.
+	ACL *string
+}
+
+// SetAccessControl sets the Owner, Group and Access Control List for the specified Path
+func (client Client) SetAccessControl(ctx context.Context, accountName, fileSystemName, path string, input SetAccessControlInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("paths.Client#SetAccessControl: `accountName` cannot be an empty string")
+	}
+	if fileSystemName == "" {
+		return result, fmt.Errorf("paths.Client#SetAccessControl: `fileSystemName` cannot be an empty string")
+	}
+	if path == "" {
+		return result, fmt.Errorf("paths.Client#SetAccessControl: `path` cannot be an empty string")
+	}
+	if input.ACL != nil {
+		if _, err := ParseACL(*input.ACL); err != nil {
+			return result, fmt.Errorf("paths.Client#SetAccessControl: `input.ACL` is not valid: %s", err)
+		}
+	}
+
+	req, err := client.SetAccessControlPreparer(ctx, accountName, fileSystemName, path, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControl", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.SetAccessControlSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControl", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.SetAccessControlResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paths.Client", "SetAccessControl", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// SetAccessControlPreparer prepares the SetAccessControl request.
+func (client Client) SetAccessControlPreparer(ctx context.Context, accountName, fileSystemName, path string, input SetAccessControlInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+		"path":           encodePath(path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action": autorest.Encode("query", "setAccessControl"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": APIVersion,
+	}
+
+	if input.Owner != nil {
+		headers["x-ms-owner"] = *input.Owner
+	}
+	if input.Group != nil {
+		headers["x-ms-group"] = *input.Group
+	}
+	if input.ACL != nil {
+		headers["x-ms-acl"] = *input.ACL
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(endpoints.GetDataLakeStoreEndpoint(client.BaseURI, accountName)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// SetAccessControlSender sends the SetAccessControl request. The method will close the
+// http.Response Body if it receives an error.
+func (client Client) SetAccessControlSender(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req,
+		azure.DoRetryWithRegistration(client.Client))
+}
+
+// SetAccessControlResponder handles the response to the SetAccessControl request. The method always
+// closes the http.Response Body.
+func (client Client) SetAccessControlResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	return
+}
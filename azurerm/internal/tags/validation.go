@@ -0,0 +1,114 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ValidationRules defines the limits which apply to the Tags assigned to a Resource
+type ValidationRules struct {
+	// MaxTags is the maximum number of Tags which can be assigned to a Resource
+	MaxTags int
+
+	// MaxKeyLength is the maximum length of the key for a Tag
+	MaxKeyLength int
+
+	// MaxValueLength is the maximum length of the value for a Tag
+	MaxValueLength int
+
+	// ForbiddenKeyCharacters are the characters which can't be used within the key for a Tag
+	ForbiddenKeyCharacters string
+}
+
+// DefaultRules are the limits which apply to the Tags assigned to most Resources, as documented here:
+// https://docs.microsoft.com/en-us/azure/azure-resource-manager/resource-group-using-tags
+var DefaultRules = ValidationRules{
+	MaxTags:                50,
+	MaxKeyLength:           512,
+	MaxValueLength:         256,
+	ForbiddenKeyCharacters: `<>%&\?/`,
+}
+
+// StorageAccountRules are the limits which apply to the Tags assigned to a Storage Account,
+// which only supports keys of up to 128 characters
+var StorageAccountRules = ValidationRules{
+	MaxTags:                50,
+	MaxKeyLength:           128,
+	MaxValueLength:         256,
+	ForbiddenKeyCharacters: DefaultRules.ForbiddenKeyCharacters,
+}
+
+// Validate returns a SchemaValidateFunc which validates a map of Tags against the specified rules
+func Validate(rules ValidationRules) schema.SchemaValidateFunc {
+	return func(v interface{}, _ string) (warnings []string, errors []error) {
+		tagsMap, ok := v.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected the tags to be a map but got %T", v))
+			return warnings, errors
+		}
+
+		if len(tagsMap) > rules.MaxTags {
+			errors = append(errors, fmt.Errorf("a maximum of %d tags can be applied to each ARM resource", rules.MaxTags))
+		}
+
+		for k, v := range tagsMap {
+			if len(k) > rules.MaxKeyLength {
+				errors = append(errors, fmt.Errorf("the maximum length for a tag key is %d characters: %q is %d characters", rules.MaxKeyLength, k, len(k)))
+			}
+
+			if rules.ForbiddenKeyCharacters != "" && strings.ContainsAny(k, rules.ForbiddenKeyCharacters) {
+				errors = append(errors, fmt.Errorf("the tag key %q contains a forbidden character - tag keys cannot contain any of the characters %q", k, rules.ForbiddenKeyCharacters))
+			}
+
+			value, err := ValueToString(v)
+			if err != nil {
+				errors = append(errors, err)
+			} else if len(value) > rules.MaxValueLength {
+				errors = append(errors, fmt.Errorf("the maximum length for a tag value is %d characters: the value for %q is %d characters", rules.MaxValueLength, k, len(value)))
+			}
+		}
+
+		return warnings, errors
+	}
+}
+
+// ValidateRequiredKeys validates that each of the required keys is present within the map of Tags,
+// comparing the keys case-insensitively (since Tag keys are case-insensitive in Azure)
+func ValidateRequiredKeys(tagsMap map[string]interface{}, requiredKeys []string) error {
+	missing := make([]string, 0)
+	for _, required := range requiredKeys {
+		found := false
+		for k := range tagsMap {
+			if strings.EqualFold(k, required) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, required)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return fmt.Errorf("the tag(s) %q are required but were not specified", strings.Join(missing, ", "))
+}
+
+// ValueToString converts the value for a Tag into a string
+func ValueToString(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case string:
+		return value, nil
+	case int:
+		return fmt.Sprintf("%d", value), nil
+	default:
+		return "", fmt.Errorf("unknown tag type %T in tag value", value)
+	}
+}
@@ -0,0 +1,174 @@
+package tags
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateMaximumNumberOfTags(t *testing.T) {
+	tagsMap := make(map[string]interface{})
+	for i := 0; i < 50; i++ {
+		tagsMap[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+	}
+
+	if _, es := Validate(DefaultRules)(tagsMap, "tags"); len(es) != 0 {
+		t.Fatalf("Expected no validation errors for 50 tags but got %d", len(es))
+	}
+
+	tagsMap["key50"] = "value50"
+	_, es := Validate(DefaultRules)(tagsMap, "tags")
+	if len(es) != 1 {
+		t.Fatal("Expected one validation error for too many tags")
+	}
+
+	if !strings.Contains(es[0].Error(), "a maximum of 50 tags") {
+		t.Fatal("Wrong validation error message for too many tags")
+	}
+}
+
+func TestValidateTagMaxKeyLength(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Rules    ValidationRules
+		Key      string
+		Expected string
+	}{
+		{
+			Name:  "Default - Maximum",
+			Rules: DefaultRules,
+			Key:   strings.Repeat("long", 128),
+		},
+		{
+			Name:     "Default - Too Long",
+			Rules:    DefaultRules,
+			Key:      strings.Repeat("long", 128) + "a",
+			Expected: "513",
+		},
+		{
+			Name:  "Storage Account - Maximum",
+			Rules: StorageAccountRules,
+			Key:   strings.Repeat("long", 32),
+		},
+		{
+			Name:     "Storage Account - Too Long",
+			Rules:    StorageAccountRules,
+			Key:      strings.Repeat("long", 32) + "a",
+			Expected: "129",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, es := Validate(v.Rules)(map[string]interface{}{
+			v.Key: "value",
+		}, "tags")
+
+		if v.Expected == "" {
+			if len(es) != 0 {
+				t.Fatalf("Expected no validation errors but got: %+v", es)
+			}
+			continue
+		}
+
+		if len(es) != 1 {
+			t.Fatalf("Expected one validation error for a key which is too long but got %d", len(es))
+		}
+
+		if !strings.Contains(es[0].Error(), "maximum length for a tag key") {
+			t.Fatal("Wrong validation error message maximum tag key length")
+		}
+
+		if !strings.Contains(es[0].Error(), v.Expected) {
+			t.Fatal("Expected the length in the validation error for tag key")
+		}
+	}
+}
+
+func TestValidateTagMaxValueLength(t *testing.T) {
+	tagsMap := map[string]interface{}{
+		"toolong": strings.Repeat("long", 64) + "a",
+	}
+
+	_, es := Validate(DefaultRules)(tagsMap, "tags")
+	if len(es) != 1 {
+		t.Fatal("Expected one validation error for a value which is > 256 chars")
+	}
+
+	if !strings.Contains(es[0].Error(), "257") {
+		t.Fatal("Expected the length in the validation error for value")
+	}
+}
+
+func TestValidateTagForbiddenKeyCharacters(t *testing.T) {
+	for _, c := range []string{"<", ">", "%", "&", `\`, "?", "/"} {
+		key := fmt.Sprintf("hello%sworld", c)
+		_, es := Validate(DefaultRules)(map[string]interface{}{
+			key: "value",
+		}, "tags")
+
+		if len(es) != 1 {
+			t.Fatalf("Expected one validation error for the key %q but got %d", key, len(es))
+		}
+
+		if !strings.Contains(es[0].Error(), "forbidden character") {
+			t.Fatalf("Wrong validation error message for the key %q", key)
+		}
+	}
+
+	_, es := Validate(DefaultRules)(map[string]interface{}{
+		"hello-world_1.2 3:4": "value/with?chars",
+	}, "tags")
+	if len(es) != 0 {
+		t.Fatalf("Expected no validation errors but got: %+v", es)
+	}
+}
+
+func TestValidateRequiredKeys(t *testing.T) {
+	testData := []struct {
+		Tags     map[string]interface{}
+		Required []string
+		Error    bool
+	}{
+		{
+			Tags:     map[string]interface{}{},
+			Required: []string{},
+		},
+		{
+			Tags:     map[string]interface{}{},
+			Required: []string{"owner"},
+			Error:    true,
+		},
+		{
+			Tags: map[string]interface{}{
+				"owner": "platform",
+			},
+			Required: []string{"owner"},
+		},
+		{
+			// keys are case-insensitive
+			Tags: map[string]interface{}{
+				"Owner": "platform",
+			},
+			Required: []string{"owner"},
+		},
+		{
+			Tags: map[string]interface{}{
+				"owner": "platform",
+			},
+			Required: []string{"owner", "cost_centre"},
+			Error:    true,
+		},
+	}
+
+	for _, v := range testData {
+		err := ValidateRequiredKeys(v.Tags, v.Required)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error for %+v / %+v but didn't get one", v.Tags, v.Required)
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error for %+v / %+v but got: %+v", v.Tags, v.Required, err)
+		}
+	}
+}
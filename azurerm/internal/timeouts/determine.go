@@ -0,0 +1,39 @@
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation
+//
+// This function determines if this is a Create or an Update by checking if the resource is new
+// and returns the timeout for the matching operation
+func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+}
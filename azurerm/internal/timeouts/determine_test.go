@@ -0,0 +1,81 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDetermineTimeouts(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+	}
+
+	testData := []struct {
+		name     string
+		new      bool
+		function func(context.Context, *schema.ResourceData) (context.Context, context.CancelFunc)
+		expected time.Duration
+	}{
+		{
+			name:     "Create",
+			function: ForCreate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "CreateUpdate for a new resource",
+			new:      true,
+			function: ForCreateUpdate,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "CreateUpdate for an existing resource",
+			function: ForCreateUpdate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "Delete",
+			function: ForDelete,
+			expected: 40 * time.Minute,
+		},
+		{
+			name:     "Read",
+			function: ForRead,
+			expected: 20 * time.Minute,
+		},
+		{
+			name:     "Update",
+			function: ForUpdate,
+			expected: 30 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		d := resource.Data(nil)
+		if v.new {
+			d.MarkNewResource()
+		}
+
+		ctx, cancel := v.function(context.Background(), d)
+		deadline, ok := ctx.Deadline()
+		cancel()
+		if !ok {
+			t.Fatalf("Expected a deadline to be set for %q but it wasn't", v.name)
+		}
+
+		remaining := time.Until(deadline)
+		if remaining > v.expected || remaining < v.expected-time.Minute {
+			t.Fatalf("Expected a deadline of %s for %q but got %s", v.expected, v.name, remaining)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,15 +25,16 @@ func resourceLogicAppTriggerUpdate(d *schema.ResourceData, meta interface{}, log
 
 func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, kind string, propertyName string, logicAppId string, name string, vals map[string]interface{}, resourceName string) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(logicAppId)
+	id, err := resourceid.ParseLogicAppWorkflowID(logicAppId)
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
+	logicAppName := id.Name
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
@@ -99,7 +103,8 @@ func resourceLogicAppTriggerRemove(d *schema.ResourceData, meta interface{}, res
 
 func resourceLogicAppComponentRemove(d *schema.ResourceData, meta interface{}, kind, propertyName, resourceGroup, logicAppName, name string) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q Deletion", logicAppName, resourceGroup, kind, name)
 
@@ -146,17 +151,16 @@ func resourceLogicAppComponentRemove(d *schema.ResourceData, meta interface{}, k
 	return nil
 }
 
-func retrieveLogicAppAction(meta interface{}, resourceGroup, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
-	return retrieveLogicAppComponent(meta, resourceGroup, "Action", "actions", logicAppName, name)
+func retrieveLogicAppAction(ctx context.Context, meta interface{}, resourceGroup, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
+	return retrieveLogicAppComponent(ctx, meta, resourceGroup, "Action", "actions", logicAppName, name)
 }
 
-func retrieveLogicAppTrigger(meta interface{}, resourceGroup, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
-	return retrieveLogicAppComponent(meta, resourceGroup, "Trigger", "triggers", logicAppName, name)
+func retrieveLogicAppTrigger(ctx context.Context, meta interface{}, resourceGroup, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
+	return retrieveLogicAppComponent(ctx, meta, resourceGroup, "Trigger", "triggers", logicAppName, name)
 }
 
-func retrieveLogicAppComponent(meta interface{}, resourceGroup, kind, propertyName, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
+func retrieveLogicAppComponent(ctx context.Context, meta interface{}, resourceGroup, kind, propertyName, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
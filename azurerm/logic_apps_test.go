@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func testCheckAzureRMLogicAppActionExists(resourceName string) resource.TestCheckFunc {
@@ -17,13 +18,13 @@ func testCheckAzureRMLogicAppActionExists(resourceName string) resource.TestChec
 		}
 
 		logicAppId := rs.Primary.Attributes["logic_app_id"]
-		id, err := parseAzureResourceID(logicAppId)
+		id, err := resourceid.ParseLogicAppWorkflowID(logicAppId)
 		if err != nil {
 			return err
 		}
 
 		actionName := rs.Primary.Attributes["name"]
-		workflowName := id.Path["workflows"]
+		workflowName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).logic.WorkflowsClient
@@ -65,13 +66,13 @@ func testCheckAzureRMLogicAppTriggerExists(resourceName string) resource.TestChe
 		}
 
 		logicAppId := rs.Primary.Attributes["logic_app_id"]
-		id, err := parseAzureResourceID(logicAppId)
+		id, err := resourceid.ParseLogicAppWorkflowID(logicAppId)
 		if err != nil {
 			return err
 		}
 
 		triggerName := rs.Primary.Attributes["name"]
-		workflowName := id.Path["workflows"]
+		workflowName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).logic.WorkflowsClient
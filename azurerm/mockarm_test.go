@@ -0,0 +1,120 @@
+package azurerm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/mockarm"
+)
+
+const (
+	testMockSubscriptionId = "00000000-0000-0000-0000-000000000000"
+	testMockTenantId       = "11111111-1111-1111-1111-111111111111"
+)
+
+// testMockArmClient returns an ArmClient whose Service Clients send requests to the specified
+// mock Resource Manager, rather than Azure - allowing resources to be tested without credentials
+func testMockArmClient(server *mockarm.Server) *ArmClient {
+	client := ArmClient{
+		subscriptionId:           testMockSubscriptionId,
+		tenantId:                 testMockTenantId,
+		environment:              azure.PublicCloud,
+		skipProviderRegistration: true,
+		StopContext:              context.Background(),
+		Features:                 features.Default(),
+	}
+
+	o := &common.ClientOptions{
+		GraphAuthorizer:           autorest.NullAuthorizer{},
+		GraphEndpoint:             server.URL(),
+		KeyVaultAuthorizer:        autorest.NullAuthorizer{},
+		ResourceManagerAuthorizer: autorest.NullAuthorizer{},
+		ResourceManagerEndpoint:   server.URL(),
+		StorageAuthorizer:         autorest.NullAuthorizer{},
+		SubscriptionId:            testMockSubscriptionId,
+		PollingDuration:           time.Minute,
+		SkipProviderReg:           true,
+		Environment:               azure.PublicCloud,
+	}
+	client.buildClients(o, testMockTenantId, sender.BuildSender("AzureRM"))
+
+	return &client
+}
+
+func TestMockArmClient_clientWiring(t *testing.T) {
+	server := mockarm.NewServer()
+	defer server.Close()
+
+	client := testMockArmClient(server)
+
+	if client.resourceGroupsClient.SubscriptionID != testMockSubscriptionId {
+		t.Fatalf("Expected the Resource Groups Client to use the Subscription ID %q but got %q", testMockSubscriptionId, client.resourceGroupsClient.SubscriptionID)
+	}
+
+	if client.applicationsClient.TenantID != testMockTenantId {
+		t.Fatalf("Expected the Applications Client to use the Tenant ID %q but got %q", testMockTenantId, client.applicationsClient.TenantID)
+	}
+
+	if client.servicePrincipalsClient.TenantID != testMockTenantId {
+		t.Fatalf("Expected the Service Principals Client to use the Tenant ID %q but got %q", testMockTenantId, client.servicePrincipalsClient.TenantID)
+	}
+}
+
+func TestMockAzureRMResourceGroup_basic(t *testing.T) {
+	server := mockarm.NewServer()
+	defer server.Close()
+
+	client := testMockArmClient(server)
+	resource := resourceArmResourceGroup()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":     "acctestRG-mock",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+
+	if err := resource.Create(d, client); err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+
+	expectedId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/acctestRG-mock"
+	if d.Id() != expectedId {
+		t.Fatalf("Expected the ID to be %q but got %q", expectedId, d.Id())
+	}
+	if v := d.Get("location").(string); v != "westeurope" {
+		t.Fatalf("Expected the Location to be `westeurope` but got %q", v)
+	}
+	if v := d.Get("tags.environment").(string); v != "Production" {
+		t.Fatalf("Expected the tag `environment` to be `Production` but got %q", v)
+	}
+
+	if err := resource.Read(d, client); err != nil {
+		t.Fatalf("Error reading Resource Group: %+v", err)
+	}
+	if d.Id() == "" {
+		t.Fatalf("Expected the Resource Group to exist but it was removed from the state")
+	}
+
+	if err := resource.Delete(d, client); err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+	if _, ok := server.Resource(expectedId); ok {
+		t.Fatalf("Expected the Resource Group to have been deleted")
+	}
+
+	// a subsequent Read should remove the Resource Group from the state
+	if err := resource.Read(d, client); err != nil {
+		t.Fatalf("Error reading deleted Resource Group: %+v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected the Resource Group to have been removed from the state")
+	}
+}
@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -13,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -95,6 +99,40 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
+
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HTTP_TRACE_FILE", ""),
+			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"required_tag_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"features": schemaFeatures(),
+
+			"retry": schemaRetryPolicy(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -160,7 +198,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
 			"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_redis_cache":                            dataSourceArmRedisCache(),
+			"azurerm_resource_graph_query":                   dataSourceArmResourceGraphQuery(),
 			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
+			"azurerm_resources":                              dataSourceArmResources(),
 			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                            dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
@@ -266,6 +306,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_data_lake_store":                                    resourceArmDataLakeStore(),
 			"azurerm_databricks_workspace":                               resourceArmDatabricksWorkspace(),
 			"azurerm_ddos_protection_plan":                               resourceArmDDoSProtectionPlan(),
+			"azurerm_dedicated_host":                                     resourceArmDedicatedHost(),
+			"azurerm_dedicated_host_group":                               resourceArmDedicatedHostGroup(),
 			"azurerm_dev_test_lab":                                       resourceArmDevTestLab(),
 			"azurerm_dev_test_schedule":                                  resourceArmDevTestLabSchedules(),
 			"azurerm_dev_test_linux_virtual_machine":                     resourceArmDevTestLinuxVirtualMachine(),
@@ -273,6 +315,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_dev_test_virtual_network":                           resourceArmDevTestVirtualNetwork(),
 			"azurerm_dev_test_windows_virtual_machine":                   resourceArmDevTestWindowsVirtualMachine(),
 			"azurerm_devspace_controller":                                resourceArmDevSpaceController(),
+			"azurerm_disk_encryption_set":                                resourceArmDiskEncryptionSet(),
 			"azurerm_dns_a_record":                                       resourceArmDnsARecord(),
 			"azurerm_dns_aaaa_record":                                    resourceArmDnsAAAARecord(),
 			"azurerm_dns_caa_record":                                     resourceArmDnsCaaRecord(),
@@ -319,6 +362,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
 			"azurerm_key_vault":                                          resourceArmKeyVault(),
 			"azurerm_kubernetes_cluster":                                 resourceArmKubernetesCluster(),
+			"azurerm_kubernetes_cluster_node_pool":                       resourceArmKubernetesClusterNodePool(),
 			"azurerm_lb_backend_address_pool":                            resourceArmLoadBalancerBackendAddressPool(),
 			"azurerm_lb_nat_pool":                                        resourceArmLoadBalancerNatPool(),
 			"azurerm_lb_nat_rule":                                        resourceArmLoadBalancerNatRule(),
@@ -326,6 +370,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_lb_outbound_rule":                                   resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_rule":                                            resourceArmLoadBalancerRule(),
 			"azurerm_lb":                                                 resourceArmLoadBalancer(),
+			"azurerm_linux_virtual_machine":                              resourceArmLinuxVirtualMachine(),
+			"azurerm_linux_virtual_machine_scale_set":                    resourceArmLinuxVirtualMachineScaleSet(),
 			"azurerm_local_network_gateway":                              resourceArmLocalNetworkGateway(),
 			"azurerm_log_analytics_solution":                             resourceArmLogAnalyticsSolution(),
 			"azurerm_log_analytics_linked_service":                       resourceArmLogAnalyticsLinkedService(),
@@ -385,6 +431,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
 			"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
 			"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
+			"azurerm_proximity_placement_group":                                              resourceArmProximityPlacementGroup(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
 			"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
@@ -425,8 +472,12 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_sql_server":                                                             resourceArmSqlServer(),
 			"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+			"azurerm_storage_account_customer_managed_key":                                   resourceArmStorageAccountCustomerManagedKey(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_data_lake_gen2_filesystem":                                      resourceArmStorageDataLakeGen2FileSystem(),
+			"azurerm_storage_data_lake_gen2_path":                                            resourceArmStorageDataLakeGen2Path(),
+			"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
 			"azurerm_storage_share_directory":                                                resourceArmStorageShareDirectory(),
@@ -456,9 +507,13 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_windows_virtual_machine":                                                resourceArmWindowsVirtualMachine(),
+			"azurerm_windows_virtual_machine_scale_set":                                      resourceArmWindowsVirtualMachineScaleSet(),
 		},
 	}
 
+	customizeDiffForTags(p.ResourcesMap)
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -493,14 +548,41 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+
+		var httpTraceSink *common.HTTPTraceSink
+		if path := d.Get("http_trace_file").(string); path != "" {
+			httpTraceSink, err = common.OpenHTTPTraceSink(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		retryPolicy := expandRetryPolicy(d.Get("retry").([]interface{}))
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, httpTraceSink, retryPolicy)
 
 		if err != nil {
+			if httpTraceSink != nil {
+				httpTraceSink.Close()
+			}
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
 
+		if httpTraceSink != nil {
+			// the trace file remains open for the lifetime of the Provider, so is closed once it's stopped
+			go func(ctx context.Context, sink *common.HTTPTraceSink) {
+				<-ctx.Done()
+				if err := sink.Close(); err != nil {
+					log.Printf("[DEBUG] Error closing the HTTP Trace file: %+v", err)
+				}
+			}(client.StopContext, httpTraceSink)
+		}
+		client.Features = expandFeatures(d.Get("features").([]interface{}))
+		client.defaultTags = d.Get("default_tags").(map[string]interface{})
+		client.requiredTagKeys = *utils.ExpandStringSlice(d.Get("required_tag_keys").([]interface{}))
+		client.storage.UseAzureADAuthentication = d.Get("storage_use_azuread").(bool)
+
 		// replaces the context between tests
 		p.MetaReset = func() error {
 			client.StopContext = p.StopContext()
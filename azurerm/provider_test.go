@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azuread/azuread"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -21,7 +20,6 @@ func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
 		"azurerm": testAccProvider,
-		"azuread": azuread.Provider().(*schema.Provider),
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/analysisservices/mgmt/2017-08-01/analysisservices"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,6 +12,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,8 +24,13 @@ func resourceArmAnalysisServicesServer() *schema.Resource {
 		Update: resourceArmAnalysisServicesServerUpdate,
 		Delete: resourceArmAnalysisServicesServerDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAnalysisServicesServerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,7 +109,8 @@ func resourceArmAnalysisServicesServer() *schema.Resource {
 
 func resourceArmAnalysisServicesServerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).analysisservices.ServerClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Analysis Services Server creation.")
 
@@ -133,7 +142,7 @@ func resourceArmAnalysisServicesServerCreate(d *schema.ResourceData, meta interf
 		Location:         &location,
 		Sku:              &analysisservices.ResourceSku{Name: &sku},
 		ServerProperties: serverProperties,
-		Tags:             expandTags(tags),
+		Tags:             expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, analysisServicesServer)
@@ -161,15 +170,16 @@ func resourceArmAnalysisServicesServerCreate(d *schema.ResourceData, meta interf
 
 func resourceArmAnalysisServicesServerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).analysisservices.ServerClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAnalysisServicesServerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["servers"]
+	name := id.Name
 
 	server, err := client.GetDetails(ctx, resourceGroup, name)
 
@@ -215,17 +225,18 @@ func resourceArmAnalysisServicesServerRead(d *schema.ResourceData, meta interfac
 
 func resourceArmAnalysisServicesServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).analysisservices.ServerClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Analysis Services Server creation.")
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAnalysisServicesServerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["servers"]
+	name := id.Name
 
 	serverProperties := expandAnalysisServicesServerMutableProperties(d)
 	sku := d.Get("sku").(string)
@@ -233,7 +244,7 @@ func resourceArmAnalysisServicesServerUpdate(d *schema.ResourceData, meta interf
 
 	analysisServicesServer := analysisservices.ServerUpdateParameters{
 		Sku:                     &analysisservices.ResourceSku{Name: &sku},
-		Tags:                    expandTags(tags),
+		Tags:                    expandTagsWithDefaults(meta, tags),
 		ServerMutableProperties: serverProperties,
 	}
 
@@ -251,15 +262,16 @@ func resourceArmAnalysisServicesServerUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmAnalysisServicesServerDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).analysisservices.ServerClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAnalysisServicesServerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["servers"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementApiCreateUpdate,
-		Read:   resourceArmApiManagementApiRead,
-		Update: resourceArmApiManagementApiCreateUpdate,
-		Delete: resourceArmApiManagementApiDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementApiCreateUpdate,
+		Read:     resourceArmApiManagementApiRead,
+		Update:   resourceArmApiManagementApiCreateUpdate,
+		Delete:   resourceArmApiManagementApiDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementApiID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -181,7 +189,8 @@ func resourceArmApiManagementApi() *schema.Resource {
 
 func resourceArmApiManagementApiCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -284,17 +293,18 @@ func resourceArmApiManagementApiCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).apiManagement.ApiClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiid := id.Path["apis"]
+	serviceName := id.ServiceName
+	apiid := id.Name
 
 	name := apiid
 	revision := ""
@@ -344,16 +354,17 @@ func resourceArmApiManagementApiRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmApiManagementApiDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiid := id.Path["apis"]
+	serviceName := id.ServiceName
+	apiid := id.Name
 
 	name := apiid
 	revision := ""
@@ -3,21 +3,29 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementApiOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementApiOperationCreateUpdate,
-		Read:   resourceArmApiManagementApiOperationRead,
-		Update: resourceArmApiManagementApiOperationCreateUpdate,
-		Delete: resourceArmApiManagementApiOperationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementApiOperationCreateUpdate,
+		Read:     resourceArmApiManagementApiOperationRead,
+		Update:   resourceArmApiManagementApiOperationCreateUpdate,
+		Delete:   resourceArmApiManagementApiOperationDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementApiOperationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -99,7 +107,8 @@ func resourceArmApiManagementApiOperation() *schema.Resource {
 
 func resourceArmApiManagementApiOperationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -154,17 +163,18 @@ func resourceArmApiManagementApiOperationCreateUpdate(d *schema.ResourceData, me
 
 func resourceArmApiManagementApiOperationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiOperationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiId := id.Path["apis"]
-	operationId := id.Path["operations"]
+	serviceName := id.ServiceName
+	apiId := id.ApisName
+	operationId := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, apiId, operationId)
 	if err != nil {
@@ -209,17 +219,18 @@ func resourceArmApiManagementApiOperationRead(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementApiOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiOperationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiId := id.Path["apis"]
-	operationId := id.Path["operations"]
+	serviceName := id.ServiceName
+	apiId := id.ApisName
+	operationId := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, serviceName, apiId, operationId, "")
 	if err != nil {
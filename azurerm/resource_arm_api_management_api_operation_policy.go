@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementApiOperationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementAPIOperationPolicyCreateUpdate,
-		Read:   resourceArmApiManagementAPIOperationPolicyRead,
-		Update: resourceArmApiManagementAPIOperationPolicyCreateUpdate,
-		Delete: resourceArmApiManagementAPIOperationPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementAPIOperationPolicyCreateUpdate,
+		Read:     resourceArmApiManagementAPIOperationPolicyRead,
+		Update:   resourceArmApiManagementAPIOperationPolicyCreateUpdate,
+		Delete:   resourceArmApiManagementAPIOperationPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementApiOperationPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +58,8 @@ func resourceArmApiManagementApiOperationPolicy() *schema.Resource {
 
 func resourceArmApiManagementAPIOperationPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -111,16 +120,17 @@ func resourceArmApiManagementAPIOperationPolicyCreateUpdate(d *schema.ResourceDa
 
 func resourceArmApiManagementAPIOperationPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiOperationPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
-	operationID := id.Path["operations"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
+	operationID := id.OperationName
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, apiName, operationID)
 	if err != nil {
@@ -149,16 +159,17 @@ func resourceArmApiManagementAPIOperationPolicyRead(d *schema.ResourceData, meta
 
 func resourceArmApiManagementAPIOperationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiOperationPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiOperationPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
-	operationID := id.Path["operations"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
+	operationID := id.OperationName
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, apiName, operationID, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
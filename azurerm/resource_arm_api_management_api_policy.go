@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementApiPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementAPIPolicyCreateUpdate,
-		Read:   resourceArmApiManagementAPIPolicyRead,
-		Update: resourceArmApiManagementAPIPolicyCreateUpdate,
-		Delete: resourceArmApiManagementAPIPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementAPIPolicyCreateUpdate,
+		Read:     resourceArmApiManagementAPIPolicyRead,
+		Update:   resourceArmApiManagementAPIPolicyCreateUpdate,
+		Delete:   resourceArmApiManagementAPIPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementApiPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +56,8 @@ func resourceArmApiManagementApiPolicy() *schema.Resource {
 
 func resourceArmApiManagementAPIPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -108,15 +117,16 @@ func resourceArmApiManagementAPIPolicyCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmApiManagementAPIPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, apiName)
 	if err != nil {
@@ -144,15 +154,16 @@ func resourceArmApiManagementAPIPolicyRead(d *schema.ResourceData, meta interfac
 
 func resourceArmApiManagementAPIPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, apiName, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementApiSchema() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementApiSchemaCreateUpdate,
-		Read:   resourceArmApiManagementApiSchemaRead,
-		Update: resourceArmApiManagementApiSchemaCreateUpdate,
-		Delete: resourceArmApiManagementApiSchemaDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementApiSchemaCreateUpdate,
+		Read:     resourceArmApiManagementApiSchemaRead,
+		Update:   resourceArmApiManagementApiSchemaCreateUpdate,
+		Delete:   resourceArmApiManagementApiSchemaDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementApiSchemaID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +56,8 @@ func resourceArmApiManagementApiSchema() *schema.Resource {
 
 func resourceArmApiManagementApiSchemaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiSchemasClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	schemaID := d.Get("schema_id").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -97,16 +106,17 @@ func resourceArmApiManagementApiSchemaCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmApiManagementApiSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiSchemasClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiSchemaID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
-	schemaID := id.Path["schemas"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
+	schemaID := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, apiName, schemaID)
 	if err != nil {
@@ -136,16 +146,17 @@ func resourceArmApiManagementApiSchemaRead(d *schema.ResourceData, meta interfac
 
 func resourceArmApiManagementApiSchemaDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ApiSchemasClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementApiSchemaID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	apiName := id.Path["apis"]
-	schemaID := id.Path["schemas"]
+	serviceName := id.ServiceName
+	apiName := id.ApisName
+	schemaID := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, apiName, schemaID, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,20 +3,27 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementGroupUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementGroupUserCreate,
-		Read:   resourceArmApiManagementGroupUserRead,
-		Delete: resourceArmApiManagementGroupUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementGroupUserCreate,
+		Read:     resourceArmApiManagementGroupUserRead,
+		Delete:   resourceArmApiManagementGroupUserDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementGroupUserID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,7 +40,8 @@ func resourceArmApiManagementGroupUser() *schema.Resource {
 
 func resourceArmApiManagementGroupUserCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.GroupUsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -68,16 +76,17 @@ func resourceArmApiManagementGroupUserCreate(d *schema.ResourceData, meta interf
 
 func resourceArmApiManagementGroupUserRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.GroupUsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementGroupUserID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	groupName := id.Path["groups"]
-	userId := id.Path["users"]
+	serviceName := id.ServiceName
+	groupName := id.GroupName
+	userId := id.Name
 
 	resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, groupName, userId)
 	if err != nil {
@@ -100,16 +109,17 @@ func resourceArmApiManagementGroupUserRead(d *schema.ResourceData, meta interfac
 
 func resourceArmApiManagementGroupUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.GroupUsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementGroupUserID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	groupName := id.Path["groups"]
-	userId := id.Path["users"]
+	serviceName := id.ServiceName
+	groupName := id.GroupName
+	userId := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, groupName, userId); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementProduct() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementProductCreateUpdate,
-		Read:   resourceArmApiManagementProductRead,
-		Update: resourceArmApiManagementProductCreateUpdate,
-		Delete: resourceArmApiManagementProductDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementProductCreateUpdate,
+		Read:     resourceArmApiManagementProductRead,
+		Update:   resourceArmApiManagementProductCreateUpdate,
+		Delete:   resourceArmApiManagementProductDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementProductID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,7 +78,8 @@ func resourceArmApiManagementProduct() *schema.Resource {
 
 func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for API Management Product creation.")
 
@@ -142,16 +151,17 @@ func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmApiManagementProductRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	productId := id.Path["products"]
+	serviceName := id.ServiceName
+	productId := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, productId)
 	if err != nil {
@@ -183,15 +193,16 @@ func resourceArmApiManagementProductRead(d *schema.ResourceData, meta interface{
 
 func resourceArmApiManagementProductDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	productId := id.Path["products"]
+	serviceName := id.ServiceName
+	productId := id.Name
 
 	log.Printf("[DEBUG] Deleting Product %q (API Management Service %q / Resource Grouo %q)", productId, serviceName, resourceGroup)
 	deleteSubscriptions := true
@@ -3,20 +3,27 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementProductApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementProductApiCreate,
-		Read:   resourceArmApiManagementProductApiRead,
-		Delete: resourceArmApiManagementProductApiDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementProductApiCreate,
+		Read:     resourceArmApiManagementProductApiRead,
+		Delete:   resourceArmApiManagementProductApiDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementProductApiID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,7 +40,8 @@ func resourceArmApiManagementProductApi() *schema.Resource {
 
 func resourceArmApiManagementProductApiCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductApisClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -68,16 +76,17 @@ func resourceArmApiManagementProductApiCreate(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementProductApiRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductApisClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductApiID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	productId := id.Path["products"]
-	apiName := id.Path["apis"]
+	serviceName := id.ServiceName
+	productId := id.ProductName
+	apiName := id.Name
 
 	resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, productId, apiName)
 	if err != nil {
@@ -100,16 +109,17 @@ func resourceArmApiManagementProductApiRead(d *schema.ResourceData, meta interfa
 
 func resourceArmApiManagementProductApiDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductApisClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductApiID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	productId := id.Path["products"]
-	apiName := id.Path["apis"]
+	serviceName := id.ServiceName
+	productId := id.ProductName
+	apiName := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, apiName); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
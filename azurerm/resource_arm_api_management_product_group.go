@@ -3,20 +3,27 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementProductGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementProductGroupCreate,
-		Read:   resourceArmApiManagementProductGroupRead,
-		Delete: resourceArmApiManagementProductGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementProductGroupCreate,
+		Read:     resourceArmApiManagementProductGroupRead,
+		Delete:   resourceArmApiManagementProductGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementProductGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,7 +40,8 @@ func resourceArmApiManagementProductGroup() *schema.Resource {
 
 func resourceArmApiManagementProductGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -68,16 +76,17 @@ func resourceArmApiManagementProductGroupCreate(d *schema.ResourceData, meta int
 
 func resourceArmApiManagementProductGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	groupName := id.Path["groups"]
-	productId := id.Path["products"]
+	serviceName := id.ServiceName
+	groupName := id.Name
+	productId := id.ProductName
 
 	resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, productId, groupName)
 	if err != nil {
@@ -100,16 +109,17 @@ func resourceArmApiManagementProductGroupRead(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementProductGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ProductGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementProductGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	groupName := id.Path["groups"]
-	productId := id.Path["products"]
+	serviceName := id.ServiceName
+	groupName := id.Name
+	productId := id.ProductName
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, productId, groupName); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementSubscriptionCreateUpdate,
-		Read:   resourceArmApiManagementSubscriptionRead,
-		Update: resourceArmApiManagementSubscriptionCreateUpdate,
-		Delete: resourceArmApiManagementSubscriptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementSubscriptionCreateUpdate,
+		Read:     resourceArmApiManagementSubscriptionRead,
+		Update:   resourceArmApiManagementSubscriptionCreateUpdate,
+		Delete:   resourceArmApiManagementSubscriptionDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementSubscriptionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,7 +88,8 @@ func resourceArmApiManagementSubscription() *schema.Resource {
 
 func resourceArmApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -142,15 +151,16 @@ func resourceArmApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, me
 
 func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	subscriptionId := id.Path["subscriptions"]
+	serviceName := id.ServiceName
+	subscriptionId := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, subscriptionId)
 	if err != nil {
@@ -181,15 +191,16 @@ func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	subscriptionId := id.Path["subscriptions"]
+	serviceName := id.ServiceName
+	subscriptionId := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, serviceName, subscriptionId, ""); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApiManagementUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementUserCreateUpdate,
-		Read:   resourceArmApiManagementUserRead,
-		Update: resourceArmApiManagementUserCreateUpdate,
-		Delete: resourceArmApiManagementUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApiManagementUserCreateUpdate,
+		Read:     resourceArmApiManagementUserRead,
+		Update:   resourceArmApiManagementUserCreateUpdate,
+		Delete:   resourceArmApiManagementUserDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApiManagementUserID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,7 +93,8 @@ func resourceArmApiManagementUser() *schema.Resource {
 
 func resourceArmApiManagementUserCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.UsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for API Management User creation.")
 
@@ -155,16 +164,17 @@ func resourceArmApiManagementUserCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementUserRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.UsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementUserID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	userId := id.Path["users"]
+	serviceName := id.ServiceName
+	userId := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serviceName, userId)
 	if err != nil {
@@ -194,15 +204,16 @@ func resourceArmApiManagementUserRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmApiManagementUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.UsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApiManagementUserID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	userId := id.Path["users"]
+	serviceName := id.ServiceName
+	userId := id.Name
 
 	log.Printf("[DEBUG] Deleting User %q (API Management Service %q / Resource Grouo %q)", userId, serviceName, resourceGroup)
 	deleteSubscriptions := utils.Bool(true)
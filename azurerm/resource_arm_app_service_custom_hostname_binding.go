@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,11 +18,15 @@ var appServiceCustomHostnameBindingResourceName = "azurerm_app_service_custom_ho
 
 func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceCustomHostnameBindingCreate,
-		Read:   resourceArmAppServiceCustomHostnameBindingRead,
-		Delete: resourceArmAppServiceCustomHostnameBindingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAppServiceCustomHostnameBindingCreate,
+		Read:     resourceArmAppServiceCustomHostnameBindingRead,
+		Delete:   resourceArmAppServiceCustomHostnameBindingDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAppServiceCustomHostnameBindingID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +49,8 @@ func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 
 func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.AppServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Hostname Binding creation.")
 
@@ -92,16 +100,17 @@ func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, me
 func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.AppServicesClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAppServiceCustomHostnameBindingID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	hostname := id.Path["hostNameBindings"]
+	appServiceName := id.SiteName
+	hostname := id.Name
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -122,20 +131,21 @@ func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta
 func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).web.AppServicesClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAppServiceCustomHostnameBindingID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	hostname := id.Path["hostNameBindings"]
+	appServiceName := id.SiteName
+	hostname := id.Name
 
 	azureRMLockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 	defer azureRMUnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.DeleteHostNameBinding(ctx, resGroup, appServiceName, hostname)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,6 +12,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,12 +28,17 @@ func possibleArmApplicationGatewaySslCipherSuiteValues() []string {
 
 func resourceArmApplicationGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationGatewayCreateUpdate,
-		Read:   resourceArmApplicationGatewayRead,
-		Update: resourceArmApplicationGatewayCreateUpdate,
-		Delete: resourceArmApplicationGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationGatewayCreateUpdate,
+		Read:     resourceArmApplicationGatewayRead,
+		Update:   resourceArmApplicationGatewayCreateUpdate,
+		Delete:   resourceArmApplicationGatewayDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApplicationGatewayID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1294,7 +1302,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.applicationGatewayClient
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
@@ -1357,7 +1366,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		Location: utils.String(location),
 		Zones:    zones,
 
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
 			AuthenticationCertificates:    authenticationCertificates,
 			BackendAddressPools:           backendAddressPools,
@@ -1462,14 +1471,15 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["applicationGateways"]
+	name := id.Name
 
 	applicationGateway, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -1603,14 +1613,15 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationGatewayID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["applicationGateways"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -10,16 +11,22 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationInsightsAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationInsightsAPIKeyCreate,
-		Read:   resourceArmApplicationInsightsAPIKeyRead,
-		Delete: resourceArmApplicationInsightsAPIKeyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationInsightsAPIKeyCreate,
+		Read:     resourceArmApplicationInsightsAPIKeyRead,
+		Delete:   resourceArmApplicationInsightsAPIKeyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApplicationInsightsAPIKeyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,20 +77,21 @@ func resourceArmApplicationInsightsAPIKey() *schema.Resource {
 
 func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.APIKeyClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights API key creation.")
 
 	name := d.Get("name").(string)
 	appInsightsID := d.Get("application_insights_id").(string)
 
-	id, err := parseAzureResourceID(appInsightsID)
+	id, err := resourceid.ParseApplicationInsightsID(appInsightsID)
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	appInsightsName := id.Path["components"]
+	appInsightsName := id.Name
 
 	if requireResourcesToBeImported {
 		var existing insights.ApplicationInsightsComponentAPIKey
@@ -124,9 +132,10 @@ func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta int
 
 func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.APIKeyClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationInsightsAPIKeyID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -134,8 +143,8 @@ func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Reading AzureRM Application Insights API key '%s'", id)
 
 	resGroup := id.ResourceGroup
-	appInsightsName := id.Path["components"]
-	keyID := id.Path["apikeys"]
+	appInsightsName := id.ComponentName
+	keyID := id.Name
 
 	result, err := client.Get(ctx, resGroup, appInsightsName, keyID)
 	if err != nil {
@@ -164,15 +173,16 @@ func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta inter
 
 func resourceArmApplicationInsightsAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.APIKeyClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationInsightsAPIKeyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	appInsightsName := id.Path["components"]
-	keyID := id.Path["apikeys"]
+	appInsightsName := id.ComponentName
+	keyID := id.Name
 
 	log.Printf("[DEBUG] Deleting AzureRM Application Insights API key '%s' (resource group '%s')", keyID, resGroup)
 
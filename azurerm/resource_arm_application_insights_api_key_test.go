@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMApplicationInsightsAPIKey_no_permission(t *testing.T) {
@@ -191,12 +192,12 @@ func testCheckAzureRMApplicationInsightsAPIKeyDestroy(s *terraform.State) error
 		}
 
 		name := rs.Primary.Attributes["name"]
-		id, err := parseAzureResourceID(rs.Primary.Attributes["id"])
+		id, err := resourceid.ParseApplicationInsightsAPIKeyID(rs.Primary.Attributes["id"])
 		if err != nil {
 			return err
 		}
 		resGroup := id.ResourceGroup
-		appInsightsName := id.Path["components"]
+		appInsightsName := id.ComponentName
 
 		resp, err := conn.Get(ctx, resGroup, appInsightsName, name)
 
@@ -220,13 +221,13 @@ func testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName string) resour
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		id, err := parseAzureResourceID(rs.Primary.Attributes["id"])
+		id, err := resourceid.ParseApplicationInsightsAPIKeyID(rs.Primary.Attributes["id"])
 		if err != nil {
 			return err
 		}
-		keyID := id.Path["APIKeys"]
+		keyID := id.Name
 		resGroup := id.ResourceGroup
-		appInsightsName := id.Path["components"]
+		appInsightsName := id.ComponentName
 
 		conn := testAccProvider.Meta().(*ArmClient).appInsights.APIKeyClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
@@ -14,17 +15,24 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationInsightsWebTests() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationInsightsWebTestsCreateUpdate,
-		Read:   resourceArmApplicationInsightsWebTestsRead,
-		Update: resourceArmApplicationInsightsWebTestsCreateUpdate,
-		Delete: resourceArmApplicationInsightsWebTestsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationInsightsWebTestsCreateUpdate,
+		Read:     resourceArmApplicationInsightsWebTestsRead,
+		Update:   resourceArmApplicationInsightsWebTestsCreateUpdate,
+		Delete:   resourceArmApplicationInsightsWebTestsDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApplicationInsightsWebtestID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,7 +126,8 @@ func resourceArmApplicationInsightsWebTests() *schema.Resource {
 
 func resourceArmApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.WebTestsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights WebTest creation.")
 
@@ -126,12 +135,12 @@ func resourceArmApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData,
 	resGroup := d.Get("resource_group_name").(string)
 	appInsightsID := d.Get("application_insights_id").(string)
 
-	id, err := parseAzureResourceID(appInsightsID)
+	id, err := resourceid.ParseApplicationInsightsID(appInsightsID)
 	if err != nil {
 		return err
 	}
 
-	appInsightsName := id.Path["components"]
+	appInsightsName := id.Name
 
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -179,7 +188,7 @@ func resourceArmApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData,
 				WebTest: &testConf,
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	resp, err := client.CreateOrUpdate(ctx, resGroup, name, webTest)
@@ -194,9 +203,10 @@ func resourceArmApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData,
 
 func resourceArmApplicationInsightsWebTestsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.WebTestsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationInsightsWebtestID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -204,7 +214,7 @@ func resourceArmApplicationInsightsWebTestsRead(d *schema.ResourceData, meta int
 	log.Printf("[DEBUG] Reading AzureRM Application Insights WebTests '%s'", id)
 
 	resGroup := id.ResourceGroup
-	name := id.Path["webtests"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -256,14 +266,15 @@ func resourceArmApplicationInsightsWebTestsRead(d *schema.ResourceData, meta int
 
 func resourceArmApplicationInsightsWebTestsDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsights.WebTestsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationInsightsWebtestID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["webtests"]
+	name := id.Name
 
 	log.Printf("[DEBUG] Deleting AzureRM Application Insights WebTest '%s' (resource group '%s')", name, resGroup)
 
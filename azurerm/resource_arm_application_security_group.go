@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationSecurityGroupCreateUpdate,
-		Read:   resourceArmApplicationSecurityGroupRead,
-		Update: resourceArmApplicationSecurityGroupCreateUpdate,
-		Delete: resourceArmApplicationSecurityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationSecurityGroupCreateUpdate,
+		Read:     resourceArmApplicationSecurityGroupRead,
+		Update:   resourceArmApplicationSecurityGroupCreateUpdate,
+		Delete:   resourceArmApplicationSecurityGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateApplicationSecurityGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,7 +48,8 @@ func resourceArmApplicationSecurityGroup() *schema.Resource {
 
 func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -63,7 +72,7 @@ func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, met
 
 	securityGroup := network.ApplicationSecurityGroup{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, securityGroup)
 	if err != nil {
@@ -89,14 +98,15 @@ func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationSecurityGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["applicationSecurityGroups"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -120,14 +130,15 @@ func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interf
 
 func resourceArmApplicationSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseApplicationSecurityGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["applicationSecurityGroups"]
+	name := id.Name
 
 	log.Printf("[DEBUG] Deleting Application Security Group %q (resource group %q)", name, resourceGroup)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAutomationAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAutomationAccountCreateUpdate,
-		Read:   resourceArmAutomationAccountRead,
-		Update: resourceArmAutomationAccountCreateUpdate,
-		Delete: resourceArmAutomationAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAutomationAccountCreateUpdate,
+		Read:     resourceArmAutomationAccountRead,
+		Update:   resourceArmAutomationAccountCreateUpdate,
+		Delete:   resourceArmAutomationAccountDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,7 +102,8 @@ func resourceArmAutomationAccount() *schema.Resource {
 
 func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// Remove in 2.0
 	var sku automation.Sku
@@ -143,7 +152,7 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 			Sku: &sku,
 		},
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
@@ -167,14 +176,15 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.AccountClient
 	registrationClient := meta.(*ArmClient).automation.AgentRegistrationInfoClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["automationAccounts"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -232,14 +242,15 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmAutomationAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["automationAccounts"]
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 
@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,8 +21,13 @@ func resourceArmAutomationCredential() *schema.Resource {
 		Update: resourceArmAutomationCredentialCreateUpdate,
 		Delete: resourceArmAutomationCredentialDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationCredentialID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,7 +66,8 @@ func resourceArmAutomationCredential() *schema.Resource {
 
 func resourceArmAutomationCredentialCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.CredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Credential creation.")
 
@@ -112,15 +121,16 @@ func resourceArmAutomationCredentialCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmAutomationCredentialRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.CredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationCredentialID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["credentials"]
+	accName := id.AutomationAccountName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, accName, name)
 	if err != nil {
@@ -145,15 +155,16 @@ func resourceArmAutomationCredentialRead(d *schema.ResourceData, meta interface{
 
 func resourceArmAutomationCredentialDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.CredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationCredentialID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["credentials"]
+	accName := id.AutomationAccountName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resGroup, accName, name)
 	if err != nil {
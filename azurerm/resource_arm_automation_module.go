@@ -11,6 +11,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,8 +23,13 @@ func resourceArmAutomationModule() *schema.Resource {
 		Update: resourceArmAutomationModuleCreateUpdate,
 		Delete: resourceArmAutomationModuleDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationModuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,7 +86,8 @@ func resourceArmAutomationModule() *schema.Resource {
 
 func resourceArmAutomationModuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ModuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Module creation.")
 
@@ -170,15 +178,16 @@ func resourceArmAutomationModuleCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmAutomationModuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ModuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationModuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["modules"]
+	accName := id.AutomationAccountName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, accName, name)
 	if err != nil {
@@ -199,15 +208,16 @@ func resourceArmAutomationModuleRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmAutomationModuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ModuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationModuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["modules"]
+	accName := id.AutomationAccountName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resGroup, accName, name)
 	if err != nil {
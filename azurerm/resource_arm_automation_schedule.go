@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,8 +28,13 @@ func resourceArmAutomationSchedule() *schema.Resource {
 		Update: resourceArmAutomationScheduleCreateUpdate,
 		Delete: resourceArmAutomationScheduleDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationScheduleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -218,7 +225,8 @@ func resourceArmAutomationSchedule() *schema.Resource {
 
 func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Schedule creation.")
 
@@ -311,16 +319,17 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["schedules"]
+	name := id.Name
 	resGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName := id.AutomationAccountName
 
 	resp, err := client.Get(ctx, resGroup, accountName, name)
 	if err != nil {
@@ -371,16 +380,17 @@ func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmAutomationScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automation.ScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAutomationScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["schedules"]
+	name := id.Name
 	resGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName := id.AutomationAccountName
 
 	resp, err := client.Delete(ctx, resGroup, accountName, name)
 	if err != nil {
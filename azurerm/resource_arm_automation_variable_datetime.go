@@ -3,6 +3,8 @@ package azurerm
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"time"
 )
 
 func resourceArmAutomationVariableDateTime() *schema.Resource {
@@ -12,8 +14,13 @@ func resourceArmAutomationVariableDateTime() *schema.Resource {
 		Update: resourceArmAutomationVariableDateTimeCreateUpdate,
 		Delete: resourceArmAutomationVariableDateTimeDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationVariableID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(schema.TypeString, validate.RFC3339Time),
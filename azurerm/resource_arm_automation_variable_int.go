@@ -2,6 +2,8 @@ package azurerm
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"time"
 )
 
 func resourceArmAutomationVariableInt() *schema.Resource {
@@ -11,8 +13,13 @@ func resourceArmAutomationVariableInt() *schema.Resource {
 		Update: resourceArmAutomationVariableIntCreateUpdate,
 		Delete: resourceArmAutomationVariableIntDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAutomationVariableID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(schema.TypeInt, nil),
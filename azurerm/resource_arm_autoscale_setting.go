@@ -14,6 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,12 +28,17 @@ Information on migrating to the renamed resource can be found here: https://terr
 As such the existing 'azurerm_autoscale_setting' resource is deprecated and will be removed in the next major version of the AzureRM Provider (2.0).
 `,
 
-		Create: resourceArmAutoScaleSettingCreateUpdate,
-		Read:   resourceArmAutoScaleSettingRead,
-		Update: resourceArmAutoScaleSettingCreateUpdate,
-		Delete: resourceArmAutoScaleSettingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAutoScaleSettingCreateUpdate,
+		Read:     resourceArmAutoScaleSettingRead,
+		Update:   resourceArmAutoScaleSettingCreateUpdate,
+		Delete:   resourceArmAutoScaleSettingDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMonitorAutoscaleSettingID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -349,7 +356,8 @@ As such the existing 'azurerm_autoscale_setting' resource is deprecated and will
 
 func resourceArmAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -381,7 +389,7 @@ func resourceArmAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interf
 	}
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	parameters := insights.AutoscaleSettingResource{
 		Location: utils.String(location),
@@ -413,14 +421,15 @@ func resourceArmAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorAutoscaleSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["autoscalesettings"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -464,14 +473,15 @@ func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmAutoScaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorAutoscaleSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["autoscalesettings"]
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -4,23 +4,31 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAvailabilitySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAvailabilitySetCreateUpdate,
-		Read:   resourceArmAvailabilitySetRead,
-		Update: resourceArmAvailabilitySetCreateUpdate,
-		Delete: resourceArmAvailabilitySetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAvailabilitySetCreateUpdate,
+		Read:     resourceArmAvailabilitySetRead,
+		Update:   resourceArmAvailabilitySetCreateUpdate,
+		Delete:   resourceArmAvailabilitySetDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateAvailabilitySetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,6 +65,8 @@ func resourceArmAvailabilitySet() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"proximity_placement_group_id": proximityPlacementGroupIDSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -64,7 +74,8 @@ func resourceArmAvailabilitySet() *schema.Resource {
 
 func resourceArmAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Availability Set creation.")
 
@@ -96,8 +107,9 @@ func resourceArmAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interfa
 		AvailabilitySetProperties: &compute.AvailabilitySetProperties{
 			PlatformFaultDomainCount:  utils.Int32(int32(faultDomainCount)),
 			PlatformUpdateDomainCount: utils.Int32(int32(updateDomainCount)),
+			ProximityPlacementGroup:   expandProximityPlacementGroupID(d.Get("proximity_placement_group_id").(string)),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if managed {
@@ -119,14 +131,15 @@ func resourceArmAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAvailabilitySetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -149,6 +162,7 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 	if props := resp.AvailabilitySetProperties; props != nil {
 		d.Set("platform_update_domain_count", props.PlatformUpdateDomainCount)
 		d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
+		d.Set("proximity_placement_group_id", flattenProximityPlacementGroupID(props.ProximityPlacementGroup))
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -158,14 +172,15 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmAvailabilitySetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseAvailabilitySetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name := id.Name
 
 	_, err = client.Delete(ctx, resGroup, name)
 
@@ -184,6 +184,32 @@ func TestAccAzureRMAvailabilitySet_managed(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAvailabilitySet_proximityPlacementGroup(t *testing.T) {
+	resourceName := "azurerm_availability_set.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMAvailabilitySet_proximityPlacementGroup(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAvailabilitySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAvailabilitySetExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "proximity_placement_group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAvailabilitySetExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -367,3 +393,26 @@ resource "azurerm_availability_set" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMAvailabilitySet_proximityPlacementGroup(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_proximity_placement_group" "test" {
+  name                = "acctestPPG-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_availability_set" "test" {
+  name                         = "acctestavset-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  proximity_placement_group_id = "${azurerm_proximity_placement_group.test.id}"
+  managed                      = true
+}
+`, rInt, location, rInt, rInt)
+}
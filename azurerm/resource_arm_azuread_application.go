@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,12 +22,17 @@ Information on migrating to the new AzureAD Provider can be found here: https://
 
 As such the Azure Active Directory resources within the AzureRM Provider are now deprecated and will be removed in v2.0 of the AzureRM Provider.
 `,
-		Create: resourceArmActiveDirectoryApplicationCreate,
-		Read:   resourceArmActiveDirectoryApplicationRead,
-		Update: resourceArmActiveDirectoryApplicationUpdate,
-		Delete: resourceArmActiveDirectoryApplicationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmActiveDirectoryApplicationCreate,
+		Read:     resourceArmActiveDirectoryApplicationRead,
+		Update:   resourceArmActiveDirectoryApplicationUpdate,
+		Delete:   resourceArmActiveDirectoryApplicationDelete,
+		Importer: resourceid.ValidatingImporter(validate.UUID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,7 +90,8 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 
 func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// NOTE: name isn't the Resource ID here, so we don't check it exists
 	name := d.Get("name").(string)
@@ -112,7 +121,8 @@ func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta in
 
 func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 
@@ -153,7 +163,8 @@ func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta in
 
 func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, d.Id())
 	if err != nil {
@@ -193,7 +204,8 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 
 func resourceArmActiveDirectoryApplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// in order to delete an application which is available to other tenants, we first have to disable this setting
 	availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
@@ -3,11 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,11 +25,15 @@ Information on migrating to the new AzureAD Provider can be found here: https://
 
 As such the Azure Active Directory resources within the AzureRM Provider are now deprecated and will be removed in v2.0 of the AzureRM Provider.
 `,
-		Create: resourceArmActiveDirectoryServicePrincipalCreate,
-		Read:   resourceArmActiveDirectoryServicePrincipalRead,
-		Delete: resourceArmActiveDirectoryServicePrincipalDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmActiveDirectoryServicePrincipalCreate,
+		Read:     resourceArmActiveDirectoryServicePrincipalRead,
+		Delete:   resourceArmActiveDirectoryServicePrincipalDelete,
+		Importer: resourceid.ValidatingImporter(validate.UUID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,7 +52,8 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 
 func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -94,7 +103,8 @@ func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, me
 
 func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	objectId := d.Id()
 	app, err := client.Get(ctx, objectId)
@@ -115,7 +125,8 @@ func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta
 
 func resourceArmActiveDirectoryServicePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	applicationId := d.Id()
 	app, err := client.Delete(ctx, applicationId)
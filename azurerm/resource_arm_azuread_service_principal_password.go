@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,11 +25,15 @@ Information on migrating to the new AzureAD Provider can be found here: https://
 
 As such the Azure Active Directory resources within the AzureRM Provider are now deprecated and will be removed in v2.0 of the AzureRM Provider.
 `,
-		Create: resourceArmActiveDirectoryServicePrincipalPasswordCreate,
-		Read:   resourceArmActiveDirectoryServicePrincipalPasswordRead,
-		Delete: resourceArmActiveDirectoryServicePrincipalPasswordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmActiveDirectoryServicePrincipalPasswordCreate,
+		Read:     resourceArmActiveDirectoryServicePrincipalPasswordRead,
+		Delete:   resourceArmActiveDirectoryServicePrincipalPasswordDelete,
+		Importer: resourceid.ValidatingImporter(validateAzureADServicePrincipalPasswordID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,9 +77,31 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 	}
 }
 
+func validateAzureADServicePrincipalPasswordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	segments := strings.Split(v, "/")
+	if len(segments) != 2 {
+		errors = append(errors, fmt.Errorf("Expected %q to be in the format {objectId}/{keyId} but got %q", k, v))
+		return
+	}
+
+	warnings, errors = validate.UUID(segments[0], k)
+	if len(errors) > 0 {
+		return warnings, errors
+	}
+
+	return validate.UUID(segments[1], k)
+}
+
 func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	objectId := d.Get("service_principal_id").(string)
 	value := d.Get("value").(string)
@@ -144,7 +172,8 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 
 func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
@@ -206,7 +235,8 @@ func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceDa
 
 func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
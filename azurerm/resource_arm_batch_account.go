@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
@@ -13,17 +14,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmBatchAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmBatchAccountCreate,
-		Read:   resourceArmBatchAccountRead,
-		Update: resourceArmBatchAccountUpdate,
-		Delete: resourceArmBatchAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmBatchAccountCreate,
+		Read:     resourceArmBatchAccountRead,
+		Update:   resourceArmBatchAccountUpdate,
+		Delete:   resourceArmBatchAccountDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateBatchAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,7 +102,8 @@ func resourceArmBatchAccount() *schema.Resource {
 
 func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account creation.")
 
@@ -123,7 +132,7 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 		AccountCreateProperties: &batch.AccountCreateProperties{
 			PoolAllocationMode: batch.PoolAllocationMode(poolAllocationMode),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	// if pool allocation mode is UserSubscription, a key vault reference needs to be set
@@ -172,13 +181,14 @@ func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -224,15 +234,16 @@ func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch account update.")
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	storageAccountId := d.Get("storage_account_id").(string)
@@ -244,7 +255,7 @@ func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) err
 				StorageAccountID: &storageAccountId,
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err = client.Update(ctx, resourceGroup, name, parameters); err != nil {
@@ -267,13 +278,14 @@ func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.AccountClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["batchAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroup, name)
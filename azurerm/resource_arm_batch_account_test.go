@@ -301,7 +301,7 @@ resource "azurerm_batch_account" "test" {
 
 func testAccAzureRMBatchAccount_userSubscription(rInt int, batchAccountSuffix string, location string, tenantID string, subscriptionID string) string {
 	return fmt.Sprintf(`
-data "azuread_service_principal" "test" {
+data "azurerm_azuread_service_principal" "test" {
 	display_name = "Microsoft Azure Batch"
 }
 
@@ -325,7 +325,7 @@ resource "azurerm_key_vault" "test" {
 
   access_policy {
     tenant_id = "%s"
-    object_id = "${data.azuread_service_principal.test.object_id}"
+    object_id = "${data.azurerm_azuread_service_principal.test.object_id}"
    
     secret_permissions = [
   	  "get",
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,8 +22,13 @@ func resourceArmBatchApplication() *schema.Resource {
 		Update: resourceArmBatchApplicationUpdate,
 		Delete: resourceArmBatchApplicationDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateBatchApplicationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,7 +71,8 @@ func resourceArmBatchApplication() *schema.Resource {
 
 func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -111,15 +120,16 @@ func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchApplicationID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["batchAccounts"]
-	name := id.Path["applications"]
+	accountName := id.BatchAccountName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -145,7 +155,8 @@ func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmBatchApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -171,15 +182,16 @@ func resourceArmBatchApplicationUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmBatchApplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchApplicationID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["batchAccounts"]
-	name := id.Path["applications"]
+	accountName := id.BatchAccountName
+	name := id.Name
 
 	if _, err := client.Delete(ctx, resourceGroup, accountName, name); err != nil {
 		return fmt.Errorf("Error deleting Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
@@ -17,17 +17,24 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmBatchPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmBatchPoolCreate,
-		Read:   resourceArmBatchPoolRead,
-		Update: resourceArmBatchPoolUpdate,
-		Delete: resourceArmBatchPoolDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmBatchPoolCreate,
+		Read:     resourceArmBatchPoolRead,
+		Update:   resourceArmBatchPoolUpdate,
+		Delete:   resourceArmBatchPoolDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateBatchPoolID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -336,7 +343,8 @@ func resourceArmBatchPool() *schema.Resource {
 
 func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.PoolClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch pool creation.")
 
@@ -467,16 +475,17 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.BatchAccountName
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
@@ -555,16 +564,17 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.BatchAccountName
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
@@ -617,16 +627,17 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseBatchPoolID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	poolName := id.Path["pools"]
-	accountName := id.Path["batchAccounts"]
+	poolName := id.Name
+	accountName := id.BatchAccountName
 
 	future, err := client.Delete(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
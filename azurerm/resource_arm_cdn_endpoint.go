@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmCdnEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCdnEndpointCreate,
-		Read:   resourceArmCdnEndpointRead,
-		Update: resourceArmCdnEndpointUpdate,
-		Delete: resourceArmCdnEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCdnEndpointCreate,
+		Read:     resourceArmCdnEndpointRead,
+		Update:   resourceArmCdnEndpointUpdate,
+		Delete:   resourceArmCdnEndpointDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateCdnEndpointID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -187,7 +195,8 @@ func resourceArmCdnEndpoint() *schema.Resource {
 
 func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN EndPoint creation.")
 
@@ -236,7 +245,7 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(originHostHeader),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if optimizationType != "" {
@@ -278,7 +287,8 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	endpointsClient := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -309,7 +319,7 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(hostHeader),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if optimizationType != "" {
@@ -336,18 +346,16 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCdnEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["endpoints"]
-	profileName := id.Path["profiles"]
-	if profileName == "" {
-		profileName = id.Path["Profiles"]
-	}
+	name := id.Name
+	profileName := id.ProfileName
 	log.Printf("[INFO] Retrieving CDN Endpoint %q (Profile %q / Resource Group %q)", name, profileName, resourceGroup)
 	resp, err := client.Get(ctx, resourceGroup, profileName, name)
 	if err != nil {
@@ -401,18 +409,16 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCdnEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	profileName := id.Path["profiles"]
-	if profileName == "" {
-		profileName = id.Path["Profiles"]
-	}
-	name := id.Path["endpoints"]
+	profileName := id.ProfileName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, profileName, name)
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmCdnProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCdnProfileCreate,
-		Read:   resourceArmCdnProfileRead,
-		Update: resourceArmCdnProfileUpdate,
-		Delete: resourceArmCdnProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCdnProfileCreate,
+		Read:     resourceArmCdnProfileRead,
+		Update:   resourceArmCdnProfileUpdate,
+		Delete:   resourceArmCdnProfileDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateCdnProfileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,7 +63,8 @@ func resourceArmCdnProfile() *schema.Resource {
 
 func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN Profile creation.")
 
@@ -81,7 +90,7 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
@@ -111,9 +120,10 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	if !d.HasChange("tags") {
+	if !hasTagsChange(d) {
 		return nil
 	}
 
@@ -122,7 +132,7 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: expandTags(newTags),
+		Tags: expandTagsWithDefaults(meta, newTags),
 	}
 
 	future, err := client.Update(ctx, resourceGroup, name, props)
@@ -139,14 +149,15 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCdnProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["profiles"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -174,15 +185,16 @@ func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmCdnProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCdnProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["profiles"]
+	name := id.Name
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
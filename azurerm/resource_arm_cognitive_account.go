@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,20 +12,25 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-type cognitiveServicesPropertiesStruct struct{}
-
 func resourceArmCognitiveAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCognitiveAccountCreate,
-		Read:   resourceArmCognitiveAccountRead,
-		Update: resourceArmCognitiveAccountUpdate,
-		Delete: resourceArmCognitiveAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCognitiveAccountCreate,
+		Read:     resourceArmCognitiveAccountRead,
+		Update:   resourceArmCognitiveAccountUpdate,
+		Delete:   resourceArmCognitiveAccountDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateCognitiveAccountID),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -121,7 +127,8 @@ func resourceArmCognitiveAccount() *schema.Resource {
 
 func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -144,12 +151,12 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 	tags := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
-	properties := cognitiveservices.AccountCreateParameters{
+	properties := cognitiveservices.Account{
 		Kind:       utils.String(kind),
 		Location:   utils.String(location),
 		Sku:        sku,
-		Properties: &cognitiveServicesPropertiesStruct{},
-		Tags:       expandTags(tags),
+		Properties: &cognitiveservices.AccountProperties{},
+		Tags:       expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, properties); err != nil {
@@ -168,22 +175,23 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCognitiveAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name := id.Name
 
 	tags := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
-	properties := cognitiveservices.AccountUpdateParameters{
+	properties := cognitiveservices.Account{
 		Sku:  sku,
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	_, err = client.Update(ctx, resourceGroup, name, properties)
@@ -196,15 +204,16 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCognitiveAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name := id.Name
 
 	resp, err := client.GetProperties(ctx, resourceGroup, name)
 
@@ -229,7 +238,7 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
-	if props := resp.AccountProperties; props != nil {
+	if props := resp.Properties; props != nil {
 		d.Set("endpoint", props.Endpoint)
 	}
 
@@ -255,15 +264,16 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCognitiveAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 
@@ -13,6 +14,8 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmConnectionMonitor() *schema.Resource {
@@ -22,9 +25,7 @@ func resourceArmConnectionMonitor() *schema.Resource {
 		Update: resourceArmConnectionMonitorCreateUpdate,
 		Delete: resourceArmConnectionMonitorDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateConnectionMonitorID),
 
 		DeprecationMessage: `The 'azurerm_connection_monitor' resource is deprecated in favour of the renamed version 'azurerm_network_connection_monitor'.
 
@@ -33,6 +34,13 @@ Information on migrating to the renamed resource can be found here: https://terr
 As such the existing 'azurerm_connection_monitor' resource is deprecated and will be removed in the next major version of the AzureRM Provider (2.0).
 `,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -120,7 +128,8 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 
 func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -156,7 +165,7 @@ func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta inter
 
 	properties := network.ConnectionMonitor{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		ConnectionMonitorParameters: &network.ConnectionMonitorParameters{
 			Source:                      source,
 			Destination:                 dest,
@@ -189,15 +198,16 @@ func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseConnectionMonitorID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["connectionMonitors"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -237,15 +247,16 @@ func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmConnectionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseConnectionMonitorID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["connectionMonitors"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, watcherName, name)
 	if err != nil {
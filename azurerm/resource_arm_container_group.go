@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
@@ -15,16 +16,22 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmContainerGroupCreate,
-		Read:   resourceArmContainerGroupRead,
-		Delete: resourceArmContainerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmContainerGroupCreate,
+		Read:     resourceArmContainerGroupRead,
+		Delete:   resourceArmContainerGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateContainerGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -420,7 +427,8 @@ func resourceArmContainerGroup() *schema.Resource {
 
 func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -495,16 +503,17 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containers.GroupsClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseContainerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["containerGroups"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -558,16 +567,17 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containers.GroupsClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseContainerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["containerGroups"]
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -1,9 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"strings"
 
@@ -14,21 +16,28 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmContainerRegistry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmContainerRegistryCreate,
-		Read:   resourceArmContainerRegistryRead,
-		Update: resourceArmContainerRegistryUpdate,
-		Delete: resourceArmContainerRegistryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmContainerRegistryCreate,
+		Read:          resourceArmContainerRegistryRead,
+		Update:        resourceArmContainerRegistryUpdate,
+		Delete:        resourceArmContainerRegistryDelete,
+		Importer:      resourceid.ValidatingImporter(resourceid.ValidateContainerRegistryID),
 		MigrateState:  resourceAzureRMContainerRegistryMigrateState,
 		SchemaVersion: 2,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -174,7 +183,8 @@ func resourceArmContainerRegistry() *schema.Resource {
 
 func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry creation.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -215,7 +225,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 			NetworkRuleSet:   networkRuleSet,
 		},
 
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -245,7 +255,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 	if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 {
 		// the ACR is being created so no previous geo-replication locations
 		oldGeoReplicationLocations := []interface{}{}
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations, geoReplicationLocations.List())
+		err = applyGeoReplicationLocations(ctx, meta, resourceGroup, name, oldGeoReplicationLocations, geoReplicationLocations.List())
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -267,7 +277,8 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry update.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -296,7 +307,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 			Name: containerregistry.SkuName(sku),
 			Tier: containerregistry.SkuTier(sku),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -320,7 +331,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 
 	// if the registry had replications and is updated to another Sku than premium - remove old locations
 	if !strings.EqualFold(sku, string(containerregistry.Premium)) && oldGeoReplicationLocations != nil && oldGeoReplicationLocations.Len() > 0 {
-		err := applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
+		err := applyGeoReplicationLocations(ctx, meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -336,7 +347,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	if strings.EqualFold(sku, string(containerregistry.Premium)) && hasGeoReplicationChanges {
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
+		err = applyGeoReplicationLocations(ctx, meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -356,9 +367,8 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	return resourceArmContainerRegistryRead(d, meta)
 }
 
-func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name string, oldGeoReplicationLocations []interface{}, newGeoReplicationLocations []interface{}) error {
+func applyGeoReplicationLocations(ctx context.Context, meta interface{}, resourceGroup string, name string, oldGeoReplicationLocations []interface{}, newGeoReplicationLocations []interface{}) error {
 	replicationClient := meta.(*ArmClient).containers.ReplicationsClient
-	ctx := meta.(*ArmClient).StopContext
 	log.Printf("[INFO] preparing to apply geo-replications for AzureRM Container Registry.")
 
 	createLocations := make(map[string]bool)
@@ -428,14 +438,15 @@ func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name s
 func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
 	replicationClient := meta.(*ArmClient).containers.ReplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseContainerRegistryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -517,14 +528,15 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseContainerRegistryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -93,15 +93,20 @@ func updateV1ToV2StorageAccountName(is *terraform.InstanceState, meta interface{
 func findAzureStorageAccountIdFromName(name string, meta interface{}) (string, error) {
 	ctx := meta.(*ArmClient).StopContext
 	client := meta.(*ArmClient).storageServiceClient
-	accounts, err := client.List(ctx)
+	accounts, err := client.ListComplete(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	for _, account := range *accounts.Value {
+	for accounts.NotDone() {
+		account := accounts.Value()
 		if strings.EqualFold(*account.Name, name) {
 			return *account.ID, nil
 		}
+
+		if err := accounts.NextWithContext(ctx); err != nil {
+			return "", err
+		}
 	}
 
 	return "", fmt.Errorf("Unable to find ID for Storage Account %q", name)
@@ -20,17 +20,24 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmCosmosDbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCosmosDbAccountCreate,
-		Read:   resourceArmCosmosDbAccountRead,
-		Update: resourceArmCosmosDbAccountUpdate,
-		Delete: resourceArmCosmosDbAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCosmosDbAccountCreate,
+		Read:     resourceArmCosmosDbAccountRead,
+		Update:   resourceArmCosmosDbAccountUpdate,
+		Delete:   resourceArmCosmosDbAccountDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateCosmosDBAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -299,7 +306,8 @@ func resourceArmCosmosDbAccount() *schema.Resource {
 
 func resourceArmCosmosDbAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account creation.")
 
 	name := d.Get("name").(string)
@@ -370,7 +378,7 @@ func resourceArmCosmosDbAccountCreate(d *schema.ResourceData, meta interface{})
 			VirtualNetworkRules:           expandAzureRmCosmosDBAccountVirtualNetworkRules(d),
 			EnableMultipleWriteLocations:  utils.Bool(enableMultipleWriteLocations),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	// additional validation on MaxStalenessPrefix as it varies depending on if the DB is multi region or not
@@ -416,7 +424,8 @@ func resourceArmCosmosDbAccountCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account update.")
 
 	//move to function
@@ -485,7 +494,7 @@ func resourceArmCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{})
 			VirtualNetworkRules:           expandAzureRmCosmosDBAccountVirtualNetworkRules(d),
 			EnableMultipleWriteLocations:  utils.Bool(enableMultipleWriteLocations),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err = resourceArmCosmosDbAccountApiUpsert(client, ctx, resourceGroup, name, account); err != nil {
@@ -564,14 +573,15 @@ func resourceArmCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCosmosDbAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCosmosDBAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["databaseAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -712,15 +722,16 @@ func resourceArmCosmosDbAccountRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmCosmosDbAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseCosmosDBAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["databaseAccounts"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,8 +23,13 @@ func resourceArmDataFactory() *schema.Resource {
 		Update: resourceArmDataFactoryCreateOrUpdate,
 		Delete: resourceArmDataFactoryDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDataFactoryID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -151,7 +159,8 @@ func resourceArmDataFactory() *schema.Resource {
 
 func resourceArmDataFactoryCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.FactoriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -173,7 +182,7 @@ func resourceArmDataFactoryCreateOrUpdate(d *schema.ResourceData, meta interface
 
 	dataFactory := datafactory.Factory{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 
 	if v, ok := d.GetOk("identity.0.type"); ok {
@@ -213,14 +222,15 @@ func resourceArmDataFactoryCreateOrUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.FactoriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataFactoryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["factories"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -267,14 +277,15 @@ func resourceArmDataFactoryRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmDataFactoryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.FactoriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataFactoryID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["factories"]
+	name := id.Name
 
 	response, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
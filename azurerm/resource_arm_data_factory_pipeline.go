@@ -4,23 +4,31 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDataFactoryPipeline() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDataFactoryPipelineCreateUpdate,
-		Read:   resourceArmDataFactoryPipelineRead,
-		Update: resourceArmDataFactoryPipelineCreateUpdate,
-		Delete: resourceArmDataFactoryPipelineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDataFactoryPipelineCreateUpdate,
+		Read:     resourceArmDataFactoryPipelineRead,
+		Update:   resourceArmDataFactoryPipelineCreateUpdate,
+		Delete:   resourceArmDataFactoryPipelineDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDataFactoryPipelineID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,7 +81,8 @@ func resourceArmDataFactoryPipeline() *schema.Resource {
 
 func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Data Factory Pipeline creation.")
 
@@ -133,14 +142,15 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataFactoryPipelineID(d.Id())
 	if err != nil {
 		return err
 	}
-	dataFactoryName := id.Path["factories"]
-	name := id.Path["pipelines"]
+	dataFactoryName := id.FactoryName
+	name := id.Name
 
 	resp, err := client.Get(ctx, id.ResourceGroup, dataFactoryName, name, "")
 	if err != nil {
@@ -181,14 +191,15 @@ func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDataFactoryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataFactoryPipelineID(d.Id())
 	if err != nil {
 		return err
 	}
-	dataFactoryName := id.Path["factories"]
-	name := id.Path["pipelines"]
+	dataFactoryName := id.FactoryName
+	name := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
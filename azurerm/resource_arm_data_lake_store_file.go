@@ -9,11 +9,14 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,8 +27,12 @@ func resourceArmDataLakeStoreFile() *schema.Resource {
 		Delete:        resourceArmDataLakeStoreFileDelete,
 		MigrateState:  resourceDataLakeStoreFileMigrateState,
 		SchemaVersion: 1,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer:      resourceid.ValidatingImporter(validateDataLakeStoreFileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,9 +58,31 @@ func resourceArmDataLakeStoreFile() *schema.Resource {
 	}
 }
 
+func validateDataLakeStoreFileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// Example: tomdevdls1.azuredatalakestore.net/test/example.txt
+	uri, err := url.Parse(fmt.Sprintf("https://%s", v))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Data Lake Store File ID: %+v", k, err))
+		return
+	}
+
+	if !strings.Contains(uri.Host, ".") || strings.Trim(uri.Path, "/") == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to be in the format {accountName}.{dnsSuffix}/{filePath} but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	chunkSize := 4 * 1024 * 1024
 
 	log.Printf("[INFO] preparing arguments for Date Lake Store File creation.")
@@ -112,7 +141,8 @@ func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}
 
 func resourceArmDataLakeStoreFileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
 	if err != nil {
@@ -138,7 +168,8 @@ func resourceArmDataLakeStoreFileRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmDataLakeStoreFileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDataLakeStoreFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
-		Read:   resourceArmDateLakeStoreAccountFirewallRuleRead,
-		Update: resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
-		Delete: resourceArmDateLakeStoreAccountFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
+		Read:     resourceArmDateLakeStoreAccountFirewallRuleRead,
+		Update:   resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
+		Delete:   resourceArmDateLakeStoreAccountFirewallRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDataLakeStoreFirewallRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +65,8 @@ func resourceArmDataLakeStoreFirewallRule() *schema.Resource {
 
 func resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	accountName := d.Get("account_name").(string)
@@ -107,15 +116,16 @@ func resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate(d *schema.ResourceD
 
 func resourceArmDateLakeStoreAccountFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataLakeStoreFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName := id.AccountName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -141,16 +151,17 @@ func resourceArmDateLakeStoreAccountFirewallRuleRead(d *schema.ResourceData, met
 
 func resourceArmDateLakeStoreAccountFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDataLakeStoreFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName := id.AccountName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,17 +13,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDatabricksWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDatabricksWorkspaceCreateUpdate,
-		Read:   resourceArmDatabricksWorkspaceRead,
-		Update: resourceArmDatabricksWorkspaceCreateUpdate,
-		Delete: resourceArmDatabricksWorkspaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDatabricksWorkspaceCreateUpdate,
+		Read:     resourceArmDatabricksWorkspaceRead,
+		Update:   resourceArmDatabricksWorkspaceCreateUpdate,
+		Delete:   resourceArmDatabricksWorkspaceDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDatabricksWorkspaceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +75,8 @@ func resourceArmDatabricksWorkspace() *schema.Resource {
 
 func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	subscriptionID := meta.(*ArmClient).subscriptionId
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Databricks Workspace creation.")
@@ -94,7 +103,7 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	if managedResourceGroupName == "" {
 		//no managed resource group name was provided, we use the default pattern
@@ -140,14 +149,15 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDatabricksWorkspaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -173,12 +183,12 @@ func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}
 	}
 
 	if props := resp.WorkspaceProperties; props != nil {
-		managedResourceGroupID, err := parseAzureResourceID(*props.ManagedResourceGroupID)
+		managedResourceGroupID, err := resourceid.ParseResourceGroupID(*props.ManagedResourceGroupID)
 		if err != nil {
 			return err
 		}
 		d.Set("managed_resource_group_id", props.ManagedResourceGroupID)
-		d.Set("managed_resource_group_name", managedResourceGroupID.ResourceGroup)
+		d.Set("managed_resource_group_name", managedResourceGroupID.Name)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -188,15 +198,16 @@ func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDatabricksWorkspaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmDedicatedHostCreateUpdate,
+		Read:     resourceArmDedicatedHostRead,
+		Update:   resourceArmDedicatedHostCreateUpdate,
+		Delete:   resourceArmDedicatedHostDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDedicatedHostID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"dedicated_host_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateDedicatedHostGroupID,
+				// the Compute API returns the Resource Group name within this ID in UPPERCASE
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			// this must be the same location as the Dedicated Host Group
+			"location": azure.SchemaLocation(),
+
+			// the available SKU's vary by region and are added regularly - so this is deliberately not validated
+			"sku_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"platform_fault_domain": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"auto_replace_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(compute.DedicatedHostLicenseTypesNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.DedicatedHostLicenseTypesNone),
+					string(compute.DedicatedHostLicenseTypesWindowsServerHybrid),
+					string(compute.DedicatedHostLicenseTypesWindowsServerPerpetual),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDedicatedHostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	hostGroupId, err := resourceid.ParseDedicatedHostGroupID(d.Get("dedicated_host_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, hostGroupId.ResourceGroup, hostGroupId.Name, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Dedicated Host %q (Host Group %q / Resource Group %q): %s", name, hostGroupId.Name, hostGroupId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dedicated_host", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := compute.DedicatedHost{
+		Location: utils.String(location),
+		DedicatedHostProperties: &compute.DedicatedHostProperties{
+			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
+			LicenseType:          compute.DedicatedHostLicenseTypes(d.Get("license_type").(string)),
+			PlatformFaultDomain:  utils.Int32(int32(d.Get("platform_fault_domain").(int))),
+		},
+		Sku: &compute.Sku{
+			Name: utils.String(d.Get("sku_name").(string)),
+		},
+		Tags: expandTagsWithDefaults(meta, t),
+	}
+
+	log.Printf("[DEBUG] Creating/Updating Dedicated Host %q (Host Group %q / Resource Group %q)..", name, hostGroupId.Name, hostGroupId.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, hostGroupId.ResourceGroup, hostGroupId.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, hostGroupId.Name, hostGroupId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, hostGroupId.Name, hostGroupId.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, hostGroupId.ResourceGroup, hostGroupId.Name, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): %+v", name, hostGroupId.Name, hostGroupId.ResourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Dedicated Host %q (Host Group %q / Resource Group %q)", name, hostGroupId.Name, hostGroupId.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDedicatedHostRead(d, meta)
+}
+
+func resourceArmDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDedicatedHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Dedicated Host %q was not found in Host Group %q / Resource Group %q - removing from state!", id.Name, id.HostGroupName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("dedicated_host_group_id", resourceid.NewDedicatedHostGroupID(id.SubscriptionId, id.ResourceGroup, id.HostGroupName).String())
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if props := resp.DedicatedHostProperties; props != nil {
+		d.Set("auto_replace_on_failure", props.AutoReplaceOnFailure)
+		d.Set("license_type", string(props.LicenseType))
+
+		platformFaultDomain := 0
+		if props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+		d.Set("platform_fault_domain", platformFaultDomain)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDedicatedHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Dedicated Host %q (Host Group %q / Resource Group %q)..", id.Name, id.HostGroupName, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.HostGroupName, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Dedicated Host %q (Host Group %q / Resource Group %q): %+v", id.Name, id.HostGroupName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// dedicatedHostIDSchema is the `dedicated_host_id` field used by the Virtual Machine resources
+func dedicatedHostIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: resourceid.ValidateDedicatedHostID,
+		// the Compute API returns the Resource Group name within this ID in UPPERCASE
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
+
+func expandDedicatedHostID(input string) *compute.SubResource {
+	if input == "" {
+		return nil
+	}
+
+	return &compute.SubResource{
+		ID: utils.String(input),
+	}
+}
+
+func flattenDedicatedHostID(input *compute.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
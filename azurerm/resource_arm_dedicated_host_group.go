@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDedicatedHostGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmDedicatedHostGroupCreateUpdate,
+		Read:     resourceArmDedicatedHostGroupRead,
+		Update:   resourceArmDedicatedHostGroupCreateUpdate,
+		Delete:   resourceArmDedicatedHostGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDedicatedHostGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"platform_fault_domain_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+
+			// Virtual Machine Scale Sets can only be placed within a Dedicated Host Group with automatic placement enabled
+			"automatic_placement_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaSingleZone(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDedicatedHostGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Dedicated Host Group %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dedicated_host_group", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := compute.DedicatedHostGroup{
+		Location: utils.String(location),
+		DedicatedHostGroupProperties: &compute.DedicatedHostGroupProperties{
+			PlatformFaultDomainCount:  utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
+			SupportAutomaticPlacement: utils.Bool(d.Get("automatic_placement_enabled").(bool)),
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		Tags:  expandTagsWithDefaults(meta, t),
+	}
+
+	log.Printf("[DEBUG] Creating/Updating Dedicated Host Group %q (Resource Group %q)..", name, resourceGroup)
+	resp, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Dedicated Host Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Dedicated Host Group %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDedicatedHostGroupRead(d, meta)
+}
+
+func resourceArmDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDedicatedHostGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Dedicated Host Group %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	platformFaultDomainCount := 0
+	automaticPlacementEnabled := false
+	if props := resp.DedicatedHostGroupProperties; props != nil {
+		if props.PlatformFaultDomainCount != nil {
+			platformFaultDomainCount = int(*props.PlatformFaultDomainCount)
+		}
+		if props.SupportAutomaticPlacement != nil {
+			automaticPlacementEnabled = *props.SupportAutomaticPlacement
+		}
+	}
+	d.Set("platform_fault_domain_count", platformFaultDomainCount)
+	d.Set("automatic_placement_enabled", automaticPlacementEnabled)
+	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDedicatedHostGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dedicatedHostGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDedicatedHostGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Dedicated Host Group %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Dedicated Host Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// dedicatedHostGroupIDSchema is the `host_group_id` field used by the Virtual Machine Scale Set resources
+func dedicatedHostGroupIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: resourceid.ValidateDedicatedHostGroupID,
+		// the Compute API returns the Resource Group name within this ID in UPPERCASE
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
+
+func expandDedicatedHostGroupID(input string) *compute.SubResource {
+	if input == "" {
+		return nil
+	}
+
+	return &compute.SubResource{
+		ID: utils.String(input),
+	}
+}
+
+func flattenDedicatedHostGroupID(input *compute.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
@@ -0,0 +1,217 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDedicatedHostGroup_basic(t *testing.T) {
+	resourceName := "azurerm_dedicated_host_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHostGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "platform_fault_domain_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_placement_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHostGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dedicated_host_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHostGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDedicatedHostGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dedicated_host_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHostGroup_complete(t *testing.T) {
+	resourceName := "azurerm_dedicated_host_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHostGroup_complete(ri, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "platform_fault_domain_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_placement_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zones.0", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDedicatedHostGroup_complete(ri, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDedicatedHostGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseDedicatedHostGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dedicatedHostGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Dedicated Host Group %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on dedicatedHostGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDedicatedHostGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dedicatedHostGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dedicated_host_group" {
+			continue
+		}
+
+		id, err := resourceid.ParseDedicatedHostGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Dedicated Host Group %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDedicatedHostGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctestDHG-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  platform_fault_domain_count = 2
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDedicatedHostGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHostGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host_group" "import" {
+  name                        = "${azurerm_dedicated_host_group.test.name}"
+  resource_group_name         = "${azurerm_dedicated_host_group.test.resource_group_name}"
+  location                    = "${azurerm_dedicated_host_group.test.location}"
+  platform_fault_domain_count = "${azurerm_dedicated_host_group.test.platform_fault_domain_count}"
+}
+`, template)
+}
+
+func testAccAzureRMDedicatedHostGroup_complete(rInt int, location, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctestDHG-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  platform_fault_domain_count = 1
+  automatic_placement_enabled = true
+  zones                       = ["1"]
+
+  tags = {
+    environment = "%s"
+  }
+}
+`, rInt, location, rInt, environment)
+}
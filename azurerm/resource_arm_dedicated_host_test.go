@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDedicatedHost_basic(t *testing.T) {
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "DSv3-Type1"),
+					resource.TestCheckResourceAttr(resourceName, "platform_fault_domain", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHost_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDedicatedHost_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_dedicated_host"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDedicatedHost_update(t *testing.T) {
+	resourceName := "azurerm_dedicated_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDedicatedHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMDedicatedHost_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDedicatedHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_replace_on_failure", "false"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "Windows_Server_Hybrid"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDedicatedHostExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseDedicatedHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dedicatedHostsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Dedicated Host %q (Host Group %q / Resource Group %q) does not exist", id.Name, id.HostGroupName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on dedicatedHostsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDedicatedHostDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dedicatedHostsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dedicated_host" {
+			continue
+		}
+
+		id, err := resourceid.ParseDedicatedHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Dedicated Host %q (Host Group %q / Resource Group %q) still exists", id.Name, id.HostGroupName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDedicatedHost_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctestDHG-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  platform_fault_domain_count = 2
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDedicatedHost_basic(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctestDH-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host_group.test.id}"
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDedicatedHost_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "import" {
+  name                    = "${azurerm_dedicated_host.test.name}"
+  location                = "${azurerm_dedicated_host.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host.test.dedicated_host_group_id}"
+  sku_name                = "${azurerm_dedicated_host.test.sku_name}"
+  platform_fault_domain   = "${azurerm_dedicated_host.test.platform_fault_domain}"
+}
+`, template)
+}
+
+func testAccAzureRMDedicatedHost_complete(rInt int, location string) string {
+	template := testAccAzureRMDedicatedHost_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctestDH-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host_group.test.id}"
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+  auto_replace_on_failure = false
+  license_type            = "Windows_Server_Hybrid"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestLab() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestLabCreateUpdate,
-		Read:   resourceArmDevTestLabRead,
-		Update: resourceArmDevTestLabCreateUpdate,
-		Delete: resourceArmDevTestLabDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestLabCreateUpdate,
+		Read:     resourceArmDevTestLabRead,
+		Update:   resourceArmDevTestLabCreateUpdate,
+		Delete:   resourceArmDevTestLabDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestLabID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,7 +92,8 @@ func resourceArmDevTestLab() *schema.Resource {
 
 func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.LabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Lab creation")
 
@@ -110,7 +119,7 @@ func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	parameters := dtl.Lab{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		LabProperties: &dtl.LabProperties{
 			LabStorageType: dtl.StorageType(storageType),
 		},
@@ -141,14 +150,15 @@ func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.LabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestLabID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["labs"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -186,14 +196,15 @@ func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.LabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestLabID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["labs"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestLabSchedules() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestLabSchedulesCreateUpdate,
-		Read:   resourceArmDevTestLabSchedulesRead,
-		Update: resourceArmDevTestLabSchedulesCreateUpdate,
-		Delete: resourceArmDevTestLabSchedulesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestLabSchedulesCreateUpdate,
+		Read:     resourceArmDevTestLabSchedulesRead,
+		Update:   resourceArmDevTestLabSchedulesCreateUpdate,
+		Delete:   resourceArmDevTestLabSchedulesDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestScheduleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -168,7 +176,8 @@ func resourceArmDevTestLabSchedules() *schema.Resource {
 func resourceArmDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.LabSchedulesClient
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	devTestLabName := d.Get("lab_name").(string)
@@ -193,7 +202,7 @@ func resourceArmDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta int
 	schedule := dtl.Schedule{
 		Location:           &location,
 		ScheduleProperties: &dtl.ScheduleProperties{},
-		Tags:               expandTags(tags),
+		Tags:               expandTagsWithDefaults(meta, tags),
 	}
 
 	switch status := d.Get("status"); status {
@@ -254,15 +263,16 @@ func resourceArmDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDevTestLabSchedulesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.LabSchedulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	devTestLabName := id.Path["labs"]
-	name := id.Path["schedules"]
+	devTestLabName := id.LabName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, devTestLabName, name, "")
 
@@ -312,15 +322,16 @@ func resourceArmDevTestLabSchedulesRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDevTestLabSchedulesDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmExtensionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["schedules"]
-	devTestLabName := id.Path["labs"]
+	name := id.Name
+	devTestLabName := id.LabName
 
 	future, err := client.Delete(ctx, resGroup, devTestLabName, name)
 	if err != nil {
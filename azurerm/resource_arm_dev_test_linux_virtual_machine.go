@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Read:   resourceArmDevTestLinuxVirtualMachineRead,
-		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Delete: resourceArmDevTestLinuxVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Read:     resourceArmDevTestLinuxVirtualMachineRead,
+		Update:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Delete:   resourceArmDevTestLinuxVirtualMachineDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestVirtualMachineID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,7 +141,8 @@ func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
 
 func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Linux Virtual Machine creation")
 
@@ -204,7 +213,7 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, m
 			StorageType:                utils.String(storageType),
 			UserName:                   utils.String(username),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
@@ -232,15 +241,16 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -285,15 +295,16 @@ func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta inte
 
 func resourceArmDevTestLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
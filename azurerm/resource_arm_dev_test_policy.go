@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestPolicyCreateUpdate,
-		Read:   resourceArmDevTestPolicyRead,
-		Update: resourceArmDevTestPolicyCreateUpdate,
-		Delete: resourceArmDevTestPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestPolicyCreateUpdate,
+		Read:     resourceArmDevTestPolicyRead,
+		Update:   resourceArmDevTestPolicyCreateUpdate,
+		Delete:   resourceArmDevTestPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,7 +98,8 @@ func resourceArmDevTestPolicy() *schema.Resource {
 
 func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.PoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Policy creation")
 
@@ -120,7 +129,7 @@ func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface
 	tags := d.Get("tags").(map[string]interface{})
 
 	parameters := dtl.Policy{
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		PolicyProperties: &dtl.PolicyProperties{
 			FactName:      dtl.PolicyFactName(name),
 			FactData:      utils.String(factData),
@@ -150,16 +159,17 @@ func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmDevTestPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.PoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	policySetName := id.Path["policysets"]
-	name := id.Path["policies"]
+	labName := id.LabName
+	policySetName := id.PolicysetName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, policySetName, name, "")
 	if err != nil {
@@ -191,16 +201,17 @@ func resourceArmDevTestPolicyRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmDevTestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.PoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	policySetName := id.Path["policysets"]
-	name := id.Path["policies"]
+	labName := id.LabName
+	policySetName := id.PolicysetName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, policySetName, labName, name, "")
 	if err != nil {
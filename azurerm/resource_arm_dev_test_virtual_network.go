@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestVirtualNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestVirtualNetworkCreate,
-		Read:   resourceArmDevTestVirtualNetworkRead,
-		Update: resourceArmDevTestVirtualNetworkUpdate,
-		Delete: resourceArmDevTestVirtualNetworkDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestVirtualNetworkCreate,
+		Read:     resourceArmDevTestVirtualNetworkRead,
+		Update:   resourceArmDevTestVirtualNetworkUpdate,
+		Delete:   resourceArmDevTestVirtualNetworkDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestVirtualNetworkID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,7 +98,8 @@ func resourceArmDevTestVirtualNetwork() *schema.Resource {
 
 func resourceArmDevTestVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Virtual Network creation")
 
@@ -119,7 +128,7 @@ func resourceArmDevTestVirtualNetworkCreate(d *schema.ResourceData, meta interfa
 	subnets := expandDevTestVirtualNetworkSubnets(subnetsRaw, subscriptionId, resourceGroup, name)
 
 	parameters := dtl.VirtualNetwork{
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		VirtualNetworkProperties: &dtl.VirtualNetworkProperties{
 			Description:     utils.String(description),
 			SubnetOverrides: subnets,
@@ -151,15 +160,16 @@ func resourceArmDevTestVirtualNetworkCreate(d *schema.ResourceData, meta interfa
 
 func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualnetworks"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -195,7 +205,8 @@ func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface
 
 func resourceArmDevTestVirtualNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Virtual Network creation")
 
@@ -211,7 +222,7 @@ func resourceArmDevTestVirtualNetworkUpdate(d *schema.ResourceData, meta interfa
 	subnets := expandDevTestVirtualNetworkSubnets(subnetsRaw, subscriptionId, resourceGroup, name)
 
 	parameters := dtl.VirtualNetwork{
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		VirtualNetworkProperties: &dtl.VirtualNetworkProperties{
 			Description:     utils.String(description),
 			SubnetOverrides: subnets,
@@ -243,15 +254,16 @@ func resourceArmDevTestVirtualNetworkUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmDevTestVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualnetworks"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
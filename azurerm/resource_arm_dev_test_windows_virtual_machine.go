@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestWindowsVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
-		Read:   resourceArmDevTestWindowsVirtualMachineRead,
-		Update: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
-		Delete: resourceArmDevTestWindowsVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevTestWindowsVirtualMachineCreateUpdate,
+		Read:     resourceArmDevTestWindowsVirtualMachineRead,
+		Update:   resourceArmDevTestWindowsVirtualMachineCreateUpdate,
+		Delete:   resourceArmDevTestWindowsVirtualMachineDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevTestVirtualMachineID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -125,7 +133,8 @@ func resourceArmDevTestWindowsVirtualMachine() *schema.Resource {
 
 func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Windows Virtual Machine creation")
 
@@ -193,7 +202,7 @@ func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *schema.ResourceData,
 			StorageType:                utils.String(storageType),
 			UserName:                   utils.String(username),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
@@ -221,15 +230,16 @@ func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *schema.ResourceData,
 
 func resourceArmDevTestWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -274,15 +284,16 @@ func resourceArmDevTestWindowsVirtualMachineRead(d *schema.ResourceData, meta in
 
 func resourceArmDevTestWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabs.VirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevTestVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName := id.LabName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -3,24 +3,32 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
+	"github.com/Azure/azure-sdk-for-go/services/devspaces/mgmt/2019-04-01/devspaces"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevSpaceController() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevSpaceControllerCreate,
-		Read:   resourceArmDevSpaceControllerRead,
-		Update: resourceArmDevSpaceControllerUpdate,
-		Delete: resourceArmDevSpaceControllerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDevSpaceControllerCreate,
+		Read:     resourceArmDevSpaceControllerRead,
+		Update:   resourceArmDevSpaceControllerUpdate,
+		Delete:   resourceArmDevSpaceControllerDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDevSpaceControllerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,7 +104,8 @@ func resourceArmDevSpaceController() *schema.Resource {
 
 func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevSpace Controller creation")
 
@@ -127,7 +136,7 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 
 	controller := devspaces.Controller{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		Sku:      sku,
 		ControllerProperties: &devspaces.ControllerProperties{
 			HostSuffix:                           &hostSuffix,
@@ -160,14 +169,15 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevSpaceControllerID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroupName := id.ResourceGroup
-	name := id.Path["controllers"]
+	name := id.Name
 
 	result, err := client.Get(ctx, resGroupName, name)
 	if err != nil {
@@ -203,7 +213,8 @@ func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevSpace Controller updating")
 
@@ -212,7 +223,7 @@ func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{
 	tags := d.Get("tags").(map[string]interface{})
 
 	params := devspaces.ControllerUpdateParameters{
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	result, err := client.Update(ctx, resGroupName, name, params)
@@ -230,14 +241,15 @@ func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{
 
 func resourceArmDevSpaceControllerDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDevSpaceControllerID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroupName := id.ResourceGroup
-	name := id.Path["controllers"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroupName, name)
 	if err != nil {
@@ -0,0 +1,320 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDiskEncryptionSet() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmDiskEncryptionSetCreateUpdate,
+		Read:     resourceArmDiskEncryptionSetRead,
+		Update:   resourceArmDiskEncryptionSetCreateUpdate,
+		Delete:   resourceArmDiskEncryptionSetDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDiskEncryptionSetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			// this is the versioned ID of the Key - Disk Encryption Sets don't support automatic rotation
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								// this is the only type supported by Disk Encryption Sets at this time
+								string(compute.SystemAssigned),
+							}, false),
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDiskEncryptionSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskEncryptionSetsClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Disk Encryption Set %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_disk_encryption_set", *existing.ID)
+		}
+	}
+
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+	keyId, err := azure.ParseKeyVaultChildID(keyVaultKeyId)
+	if err != nil {
+		return err
+	}
+
+	keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultsClient, keyId.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("Error looking up Key Vault ID from Key Vault URI %q: %+v", keyId.KeyVaultBaseUrl, err)
+	}
+	if keyVaultId == nil {
+		return fmt.Errorf("Unable to determine the Key Vault ID from Key Vault URI %q", keyId.KeyVaultBaseUrl)
+	}
+
+	// the Disks encrypted using this Disk Encryption Set become unusable if the Key is (permanently) deleted
+	if err := azure.KeyVaultIsRecoverable(ctx, vaultsClient, *keyVaultId); err != nil {
+		return fmt.Errorf("Error validating Key Vault %q for Disk Encryption Set %q (Resource Group %q): %+v", *keyVaultId, name, resourceGroup, err)
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := compute.DiskEncryptionSet{
+		Location: utils.String(location),
+		Identity: expandDiskEncryptionSetIdentity(d.Get("identity").([]interface{})),
+		EncryptionSetProperties: &compute.EncryptionSetProperties{
+			ActiveKey: &compute.KeyVaultAndKeyReference{
+				KeyURL: utils.String(keyVaultKeyId),
+				SourceVault: &compute.SourceVault{
+					ID: keyVaultId,
+				},
+			},
+		},
+		Tags: expandTagsWithDefaults(meta, t),
+	}
+
+	log.Printf("[DEBUG] Creating/Updating Disk Encryption Set %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Disk Encryption Set %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDiskEncryptionSetRead(d, meta)
+}
+
+func resourceArmDiskEncryptionSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskEncryptionSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDiskEncryptionSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Disk Encryption Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	keyVaultKeyId := ""
+	if props := resp.EncryptionSetProperties; props != nil && props.ActiveKey != nil && props.ActiveKey.KeyURL != nil {
+		keyVaultKeyId = *props.ActiveKey.KeyURL
+	}
+	d.Set("key_vault_key_id", keyVaultKeyId)
+
+	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDiskEncryptionSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskEncryptionSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseDiskEncryptionSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Disk Encryption Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandDiskEncryptionSetIdentity(input []interface{}) *compute.EncryptionSetIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.EncryptionSetIdentity{
+		Type: compute.DiskEncryptionSetIdentityType(raw["type"].(string)),
+	}
+}
+
+func flattenDiskEncryptionSetIdentity(input *compute.EncryptionSetIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+// diskEncryptionSetIDSchema is the `disk_encryption_set_id` field used by the Managed Disk, Snapshot and
+// Image resources and the Disks of the Virtual Machine and Virtual Machine Scale Set resources
+func diskEncryptionSetIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: resourceid.ValidateDiskEncryptionSetID,
+		// the Compute API returns the Resource Group name within this ID in UPPERCASE
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
+
+func expandDiskEncryptionSetParameters(input string) *compute.DiskEncryptionSetParameters {
+	if input == "" {
+		return nil
+	}
+
+	return &compute.DiskEncryptionSetParameters{
+		ID: utils.String(input),
+	}
+}
+
+func flattenDiskEncryptionSetParameters(input *compute.DiskEncryptionSetParameters) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
+
+// expandDiskEncryption returns the encryption at rest settings of a Managed Disk (or Snapshot), which uses
+// a Platform Managed Key unless a Disk Encryption Set is specified
+func expandDiskEncryption(diskEncryptionSetId string) *compute.Encryption {
+	if diskEncryptionSetId == "" {
+		return &compute.Encryption{
+			Type: compute.EncryptionAtRestWithPlatformKey,
+		}
+	}
+
+	return &compute.Encryption{
+		DiskEncryptionSetID: utils.String(diskEncryptionSetId),
+		Type:                compute.EncryptionAtRestWithCustomerKey,
+	}
+}
+
+func flattenDiskEncryption(input *compute.Encryption) string {
+	if input == nil || input.DiskEncryptionSetID == nil {
+		return ""
+	}
+
+	return *input.DiskEncryptionSetID
+}
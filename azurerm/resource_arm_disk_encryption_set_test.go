@@ -0,0 +1,358 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDiskEncryptionSet_basic(t *testing.T) {
+	resourceName := "azurerm_disk_encryption_set.test"
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDiskEncryptionSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDiskEncryptionSet_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDiskEncryptionSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_vault_key_id", "azurerm_key_vault_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDiskEncryptionSet_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_disk_encryption_set.test"
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDiskEncryptionSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDiskEncryptionSet_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDiskEncryptionSetExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDiskEncryptionSet_requiresImport(rs, location),
+				ExpectError: testRequiresImportError("azurerm_disk_encryption_set"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDiskEncryptionSet_update(t *testing.T) {
+	resourceName := "azurerm_disk_encryption_set.test"
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDiskEncryptionSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDiskEncryptionSet_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDiskEncryptionSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// rotating to a different Key and adding Tags
+				Config: testAccAzureRMDiskEncryptionSet_updated(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDiskEncryptionSetExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_vault_key_id", "azurerm_key_vault_key.second", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Hello", "World"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDiskEncryptionSet_keyVaultNotRecoverable(t *testing.T) {
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDiskEncryptionSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMDiskEncryptionSet_keyVaultNotRecoverable(rs, location),
+				ExpectError: regexp.MustCompile("must have Purge Protection enabled"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDiskEncryptionSetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseDiskEncryptionSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).diskEncryptionSetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Disk Encryption Set %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on diskEncryptionSetsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDiskEncryptionSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).diskEncryptionSetsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_disk_encryption_set" {
+			continue
+		}
+
+		id, err := resourceid.ParseDiskEncryptionSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Disk Encryption Set %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDiskEncryptionSet_basic(rString, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_dependencies(rString, location, true)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                = "acctestDES-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMDiskEncryptionSet_requiresImport(rString, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "import" {
+  name                = "${azurerm_disk_encryption_set.test.name}"
+  resource_group_name = "${azurerm_disk_encryption_set.test.resource_group_name}"
+  location            = "${azurerm_disk_encryption_set.test.location}"
+  key_vault_key_id    = "${azurerm_disk_encryption_set.test.key_vault_key_id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDiskEncryptionSet_updated(rString, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_dependencies(rString, location, true)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "second" {
+  name         = "second"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.client"]
+}
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                = "acctestDES-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  key_vault_key_id    = "${azurerm_key_vault_key.second.id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    Hello = "World"
+  }
+}
+`, template, rString)
+}
+
+func testAccAzureRMDiskEncryptionSet_keyVaultNotRecoverable(rString, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_dependencies(rString, location, false)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                = "acctestDES-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, rString)
+}
+
+// testAccAzureRMDiskEncryptionSet_template is a Disk Encryption Set which has been granted access to
+// the Key Vault, for use by the resources which encrypt Disks using it
+func testAccAzureRMDiskEncryptionSet_template(rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-des-%s"
+  location = "%s"
+}
+
+%s
+`, rString, location, testAccAzureRMDiskEncryptionSet_resources(rString))
+}
+
+// testAccAzureRMDiskEncryptionSet_resources is the same as testAccAzureRMDiskEncryptionSet_template but
+// uses the existing `azurerm_resource_group.test` defined by the caller
+func testAccAzureRMDiskEncryptionSet_resources(rString string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                = "acctestDES-%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "disk-encryption" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${azurerm_disk_encryption_set.test.identity.0.tenant_id}"
+  object_id    = "${azurerm_disk_encryption_set.test.identity.0.principal_id}"
+
+  key_permissions = ["get", "wrapkey", "unwrapkey"]
+}
+`, testAccAzureRMDiskEncryptionSet_keyVault(rString, true), rString)
+}
+
+func testAccAzureRMDiskEncryptionSet_dependencies(rString, location string, purgeProtectionEnabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-des-%s"
+  location = "%s"
+}
+
+%s
+`, rString, location, testAccAzureRMDiskEncryptionSet_keyVault(rString, purgeProtectionEnabled))
+}
+
+func testAccAzureRMDiskEncryptionSet_keyVault(rString string, purgeProtectionEnabled bool) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name                 = "standard"
+  soft_delete_enabled      = true
+  purge_protection_enabled = %t
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions    = ["get", "create", "delete", "list", "restore", "recover", "unwrapkey", "wrapkey", "purge", "encrypt", "decrypt", "sign", "verify"]
+  secret_permissions = ["get"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "first"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.client"]
+}
+`, rString, purgeProtectionEnabled)
+}
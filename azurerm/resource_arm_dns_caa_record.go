@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -11,17 +12,24 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsCaaRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsCaaRecordCreateUpdate,
-		Read:   resourceArmDnsCaaRecordRead,
-		Update: resourceArmDnsCaaRecordCreateUpdate,
-		Delete: resourceArmDnsCaaRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDnsCaaRecordCreateUpdate,
+		Read:     resourceArmDnsCaaRecordRead,
+		Update:   resourceArmDnsCaaRecordCreateUpdate,
+		Delete:   resourceArmDnsCaaRecordDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDnsCAARecordID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,7 +88,8 @@ func resourceArmDnsCaaRecord() *schema.Resource {
 
 func resourceArmDnsCaaRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -133,16 +142,17 @@ func resourceArmDnsCaaRecordCreateUpdate(d *schema.ResourceData, meta interface{
 
 func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDnsCAARecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CAA"]
-	zoneName := id.Path["dnszones"]
+	name := id.Name
+	zoneName := id.DnszoneName
 
 	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.CAA)
 	if err != nil {
@@ -168,16 +178,17 @@ func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDnsCAARecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CAA"]
-	zoneName := id.Path["dnszones"]
+	name := id.Name
+	zoneName := id.DnszoneName
 
 	resp, err := client.Delete(ctx, resGroup, zoneName, name, dns.CAA, "")
 	if resp.StatusCode != http.StatusOK {
@@ -5,23 +5,31 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsMxRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsMxRecordCreateUpdate,
-		Read:   resourceArmDnsMxRecordRead,
-		Update: resourceArmDnsMxRecordCreateUpdate,
-		Delete: resourceArmDnsMxRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmDnsMxRecordCreateUpdate,
+		Read:     resourceArmDnsMxRecordRead,
+		Update:   resourceArmDnsMxRecordCreateUpdate,
+		Delete:   resourceArmDnsMxRecordDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateDnsMXRecordID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,7 +78,8 @@ func resourceArmDnsMxRecord() *schema.Resource {
 
 func resourceArmDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -123,16 +132,17 @@ func resourceArmDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDnsMXRecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["MX"]
-	zoneName := id.Path["dnszones"]
+	name := id.Name
+	zoneName := id.DnszoneName
 
 	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.MX)
 	if err != nil {
@@ -158,16 +168,17 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseDnsMXRecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["MX"]
-	zoneName := id.Path["dnszones"]
+	name := id.Name
+	zoneName := id.DnszoneName
 
 	resp, err := client.Delete(ctx, resGroup, zoneName, name, dns.MX, "")
 	if resp.StatusCode != http.StatusOK {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventGridDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventGridDomainCreateUpdate,
-		Read:   resourceArmEventGridDomainRead,
-		Update: resourceArmEventGridDomainCreateUpdate,
-		Delete: resourceArmEventGridDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventGridDomainCreateUpdate,
+		Read:     resourceArmEventGridDomainRead,
+		Update:   resourceArmEventGridDomainCreateUpdate,
+		Delete:   resourceArmEventGridDomainDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateEventGridDomainID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -125,7 +133,8 @@ func resourceArmEventGridDomain() *schema.Resource {
 
 func resourceArmEventGridDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.DomainsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -154,7 +163,7 @@ func resourceArmEventGridDomainCreateUpdate(d *schema.ResourceData, meta interfa
 	domain := eventgrid.Domain{
 		Location:         &location,
 		DomainProperties: domainProperties,
-		Tags:             expandTags(tags),
+		Tags:             expandTagsWithDefaults(meta, tags),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Domain creation with Properties: %+v", domain)
@@ -183,14 +192,15 @@ func resourceArmEventGridDomainCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmEventGridDomainRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.DomainsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventGridDomainID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["domains"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -238,14 +248,15 @@ func resourceArmEventGridDomainRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmEventGridDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.DomainsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventGridDomainID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["domains"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
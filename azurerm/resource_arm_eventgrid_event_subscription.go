@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,17 +13,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventGridEventSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventGridEventSubscriptionCreateUpdate,
-		Read:   resourceArmEventGridEventSubscriptionRead,
-		Update: resourceArmEventGridEventSubscriptionCreateUpdate,
-		Delete: resourceArmEventGridEventSubscriptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventGridEventSubscriptionCreateUpdate,
+		Read:     resourceArmEventGridEventSubscriptionRead,
+		Update:   resourceArmEventGridEventSubscriptionCreateUpdate,
+		Delete:   resourceArmEventGridEventSubscriptionDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.EventGrid/eventSubscriptions")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -212,7 +220,8 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 
 func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -278,7 +287,8 @@ func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -366,7 +376,8 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 
 func resourceArmEventGridEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventGridTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventGridTopicCreateUpdate,
-		Read:   resourceArmEventGridTopicRead,
-		Update: resourceArmEventGridTopicCreateUpdate,
-		Delete: resourceArmEventGridTopicDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventGridTopicCreateUpdate,
+		Read:     resourceArmEventGridTopicRead,
+		Update:   resourceArmEventGridTopicCreateUpdate,
+		Delete:   resourceArmEventGridTopicDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateEventGridTopicID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +65,8 @@ func resourceArmEventGridTopic() *schema.Resource {
 
 func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.TopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -81,7 +90,7 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 	properties := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: &eventgrid.TopicProperties{},
-		Tags:            expandTags(tags),
+		Tags:            expandTagsWithDefaults(meta, tags),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Topic creation with Properties: %+v.", properties)
@@ -110,14 +119,15 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.TopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventGridTopicID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["topics"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -155,14 +165,15 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmEventGridTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.TopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventGridTopicID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["topics"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
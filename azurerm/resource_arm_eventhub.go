@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"strings"
 
@@ -12,17 +13,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventHubCreateUpdate,
-		Read:   resourceArmEventHubRead,
-		Update: resourceArmEventHubCreateUpdate,
-		Delete: resourceArmEventHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventHubCreateUpdate,
+		Read:     resourceArmEventHubRead,
+		Update:   resourceArmEventHubCreateUpdate,
+		Delete:   resourceArmEventHubDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateEventHubID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -143,7 +151,8 @@ func resourceArmEventHub() *schema.Resource {
 
 func resourceArmEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.EventHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub creation.")
 
 	name := d.Get("name").(string)
@@ -202,16 +211,17 @@ func resourceArmEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.EventHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -241,15 +251,16 @@ func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmEventHubDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.EventHubsClient
-	ctx := meta.(*ArmClient).StopContext
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	id, err := resourceid.ParseEventHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 
 	if err != nil {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventHubConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventHubConsumerGroupCreateUpdate,
-		Read:   resourceArmEventHubConsumerGroupRead,
-		Update: resourceArmEventHubConsumerGroupCreateUpdate,
-		Delete: resourceArmEventHubConsumerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventHubConsumerGroupCreateUpdate,
+		Read:     resourceArmEventHubConsumerGroupRead,
+		Update:   resourceArmEventHubConsumerGroupCreateUpdate,
+		Delete:   resourceArmEventHubConsumerGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateEventHubConsumerGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,7 +67,8 @@ func resourceArmEventHubConsumerGroup() *schema.Resource {
 
 func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.ConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Consumer Group creation.")
 
 	name := d.Get("name").(string)
@@ -110,16 +119,17 @@ func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.ConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName := id.NamespaceName
+	eventHubName := id.EventhubName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, namespaceName, eventHubName, name)
 	if err != nil {
@@ -141,16 +151,17 @@ func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface
 
 func resourceArmEventHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventhub.ConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseEventHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName := id.NamespaceName
+	eventHubName := id.EventhubName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resGroup, namespaceName, eventHubName, name)
 
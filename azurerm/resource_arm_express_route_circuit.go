@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,12 +19,17 @@ var expressRouteCircuitResourceName = "azurerm_express_route_circuit"
 
 func resourceArmExpressRouteCircuit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmExpressRouteCircuitCreateUpdate,
-		Read:   resourceArmExpressRouteCircuitRead,
-		Update: resourceArmExpressRouteCircuitCreateUpdate,
-		Delete: resourceArmExpressRouteCircuitDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmExpressRouteCircuitCreateUpdate,
+		Read:     resourceArmExpressRouteCircuitRead,
+		Update:   resourceArmExpressRouteCircuitCreateUpdate,
+		Delete:   resourceArmExpressRouteCircuitDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateExpressRouteCircuitID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -107,7 +115,8 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 
 func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit creation.")
 
@@ -137,7 +146,7 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	// There is the potential for the express route circuit to become out of sync when the service provider updates
 	// the express route circuit. We'll get and update the resource in place as per https://aka.ms/erRefresh
@@ -209,7 +218,10 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
-	resp, resourceGroup, err := retrieveErcByResourceId(d.Id(), meta)
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resp, resourceGroup, err := retrieveErcByResourceId(ctx, d.Id(), meta)
 	if err != nil {
 		return err
 	}
@@ -250,7 +262,8 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup, name, err := extractResourceGroupAndErcName(d.Id())
 	if err != nil {
@@ -2,22 +2,29 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmExpressRouteCircuitAuthorizationCreate,
-		Read:   resourceArmExpressRouteCircuitAuthorizationRead,
-		Delete: resourceArmExpressRouteCircuitAuthorizationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmExpressRouteCircuitAuthorizationCreate,
+		Read:     resourceArmExpressRouteCircuitAuthorizationRead,
+		Delete:   resourceArmExpressRouteCircuitAuthorizationDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateExpressRouteCircuitAuthorizationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +58,8 @@ func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 
 func resourceArmExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -98,16 +106,17 @@ func resourceArmExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, m
 
 func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseExpressRouteCircuitAuthorizationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName := id.ExpressRouteCircuitName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, circuitName, name)
 	if err != nil {
@@ -132,16 +141,17 @@ func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, met
 
 func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseExpressRouteCircuitAuthorizationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName := id.ExpressRouteCircuitName
+	name := id.Name
 
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
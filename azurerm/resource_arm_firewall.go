@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,12 +20,17 @@ var azureFirewallResourceName = "azurerm_firewall"
 
 func resourceArmFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallCreateUpdate,
-		Read:   resourceArmFirewallRead,
-		Update: resourceArmFirewallCreateUpdate,
-		Delete: resourceArmFirewallDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmFirewallCreateUpdate,
+		Read:     resourceArmFirewallRead,
+		Update:   resourceArmFirewallCreateUpdate,
+		Delete:   resourceArmFirewallDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateFirewallID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,7 +92,8 @@ func resourceArmFirewall() *schema.Resource {
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
@@ -122,7 +131,7 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 	parameters := network.AzureFirewall{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
 			IPConfigurations: ipConfigs,
 		},
@@ -170,14 +179,15 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["azureFirewalls"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -210,14 +220,15 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["azureFirewalls"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -239,17 +250,17 @@ func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 					continue
 				}
 
-				parsedSubnetId, err2 := parseAzureResourceID(*config.Subnet.ID)
+				parsedSubnetId, err2 := resourceid.ParseSubnetID(*config.Subnet.ID)
 				if err2 != nil {
 					return err2
 				}
-				subnetName := parsedSubnetId.Path["subnets"]
+				subnetName := parsedSubnetId.Name
 
 				if !sliceContainsValue(subnetNamesToLock, subnetName) {
 					subnetNamesToLock = append(subnetNamesToLock, subnetName)
 				}
 
-				virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+				virtualNetworkName := parsedSubnetId.VirtualNetworkName
 				if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
 					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 				}
@@ -298,13 +309,13 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 			return nil, nil, nil, fmt.Errorf("one of `ip_configuration.0.internal_public_ip_address_id` or `ip_configuration.0.public_ip_address_id` must be set")
 		}
 
-		subnetID, err := parseAzureResourceID(subnetId)
+		subnetID, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return nil, nil, nil, err
 		}
 
-		subnetName := subnetID.Path["subnets"]
-		virtualNetworkName := subnetID.Path["virtualNetworks"]
+		subnetName := subnetID.Name
+		virtualNetworkName := subnetID.VirtualNetworkName
 
 		if !sliceContainsValue(subnetNamesToLock, subnetName) {
 			subnetNamesToLock = append(subnetNamesToLock, subnetName)
@@ -382,12 +393,12 @@ func validateAzureFirewallName(v interface{}, k string) (warnings []string, erro
 }
 
 func validateAzureFirewallSubnetName(v interface{}, k string) (warnings []string, errors []error) {
-	parsed, err := parseAzureResourceID(v.(string))
+	parsed, err := resourceid.ParseSubnetID(v.(string))
 	if err != nil {
 		errors = append(errors, fmt.Errorf("Error parsing Azure Resource ID %q", v.(string)))
 		return warnings, errors
 	}
-	subnetName := parsed.Path["subnets"]
+	subnetName := parsed.Name
 	if subnetName != "AzureFirewallSubnet" {
 		errors = append(errors, fmt.Errorf("The name of the Subnet for %q must be exactly 'AzureFirewallSubnet' to be used for the Azure Firewall resource", k))
 
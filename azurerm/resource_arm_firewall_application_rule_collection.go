@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -12,17 +13,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmFirewallApplicationRuleCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
-		Read:   resourceArmFirewallApplicationRuleCollectionRead,
-		Update: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
-		Delete: resourceArmFirewallApplicationRuleCollectionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Read:     resourceArmFirewallApplicationRuleCollectionRead,
+		Update:   resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Delete:   resourceArmFirewallApplicationRuleCollectionDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateFirewallApplicationRuleCollectionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,7 +129,8 @@ func resourceArmFirewallApplicationRuleCollection() *schema.Resource {
 
 func resourceArmFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	firewallName := d.Get("azure_firewall_name").(string)
@@ -233,16 +242,17 @@ func resourceArmFirewallApplicationRuleCollectionCreateUpdate(d *schema.Resource
 
 func resourceArmFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallApplicationRuleCollectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["applicationRuleCollections"]
+	firewallName := id.AzureFirewallName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -305,16 +315,17 @@ func resourceArmFirewallApplicationRuleCollectionRead(d *schema.ResourceData, me
 
 func resourceArmFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallApplicationRuleCollectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["applicationRuleCollections"]
+	firewallName := id.AzureFirewallName
+	name := id.Name
 
 	azureRMLockByName(firewallName, azureFirewallResourceName)
 	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
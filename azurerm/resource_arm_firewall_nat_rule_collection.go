@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmFirewallNatRuleCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallNatRuleCollectionCreateUpdate,
-		Read:   resourceArmFirewallNatRuleCollectionRead,
-		Update: resourceArmFirewallNatRuleCollectionCreateUpdate,
-		Delete: resourceArmFirewallNatRuleCollectionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmFirewallNatRuleCollectionCreateUpdate,
+		Read:     resourceArmFirewallNatRuleCollectionRead,
+		Update:   resourceArmFirewallNatRuleCollectionCreateUpdate,
+		Delete:   resourceArmFirewallNatRuleCollectionDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateFirewallNatRuleCollectionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -120,7 +128,8 @@ func resourceArmFirewallNatRuleCollection() *schema.Resource {
 
 func resourceArmFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	firewallName := d.Get("azure_firewall_name").(string)
@@ -230,16 +239,17 @@ func resourceArmFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, me
 
 func resourceArmFirewallNatRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallNatRuleCollectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["natRuleCollections"]
+	firewallName := id.AzureFirewallName
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -302,16 +312,17 @@ func resourceArmFirewallNatRuleCollectionRead(d *schema.ResourceData, meta inter
 
 func resourceArmFirewallNatRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseFirewallNatRuleCollectionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["natRuleCollections"]
+	firewallName := id.AzureFirewallName
+	name := id.Name
 
 	azureRMLockByName(firewallName, azureFirewallResourceName)
 	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -38,12 +41,17 @@ var hdInsightInteractiveQueryClusterZookeeperNodeDefinition = azure.HDInsightNod
 
 func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmHDInsightInteractiveQueryClusterCreate,
-		Read:   resourceArmHDInsightInteractiveQueryClusterRead,
-		Update: hdinsightClusterUpdate("Interactive Query", resourceArmHDInsightInteractiveQueryClusterRead),
-		Delete: hdinsightClusterDelete("Interactive Query"),
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmHDInsightInteractiveQueryClusterCreate,
+		Read:     resourceArmHDInsightInteractiveQueryClusterRead,
+		Update:   hdinsightClusterUpdate("Interactive Query", resourceArmHDInsightInteractiveQueryClusterRead),
+		Delete:   hdinsightClusterDelete("Interactive Query"),
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateHDInsightClusterID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,7 +116,8 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 
 func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).hdinsight.ClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -171,7 +180,7 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 				Roles: roles,
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
 	if err != nil {
@@ -199,15 +208,16 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, meta interface{}) error {
 	clustersClient := meta.(*ArmClient).hdinsight.ClustersClient
 	configurationsClient := meta.(*ArmClient).hdinsight.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseHDInsightClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["clusters"]
+	name := id.Name
 
 	resp, err := clustersClient.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -3,25 +3,33 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmImageCreateUpdate,
-		Read:   resourceArmImageRead,
-		Update: resourceArmImageCreateUpdate,
-		Delete: resourceArmImageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmImageCreateUpdate,
+		Read:     resourceArmImageRead,
+		Update:   resourceArmImageCreateUpdate,
+		Delete:   resourceArmImageDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateImageID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -109,6 +117,8 @@ func resourceArmImage() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.NoZeroValues,
 						},
+
+						"disk_encryption_set_id": diskEncryptionSetIDSchema(),
 					},
 				},
 			},
@@ -156,6 +166,8 @@ func resourceArmImage() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.NoZeroValues,
 						},
+
+						"disk_encryption_set_id": diskEncryptionSetIDSchema(),
 					},
 				},
 			},
@@ -167,7 +179,8 @@ func resourceArmImage() *schema.Resource {
 
 func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Image creation.")
 
@@ -189,7 +202,7 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	expandedTags := expandTags(d.Get("tags").(map[string]interface{}))
+	expandedTags := expandTagsWithDefaults(meta, d.Get("tags").(map[string]interface{}))
 
 	properties := compute.ImageProperties{}
 
@@ -265,14 +278,15 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseImageID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -314,14 +328,15 @@ func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseImageID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -345,6 +360,7 @@ func flattenAzureRmImageOSDisk(osDisk *compute.ImageOSDisk) []interface{} {
 			result["managed_disk_id"] = *disk.ID
 		}
 		result["caching"] = string(osDisk.Caching)
+		result["disk_encryption_set_id"] = flattenDiskEncryptionSetParameters(osDisk.DiskEncryptionSet)
 		result["os_type"] = osDisk.OsType
 		result["os_state"] = osDisk.OsState
 	}
@@ -362,6 +378,7 @@ func flattenAzureRmImageDataDisks(diskImages *[]compute.ImageDataDisk) []interfa
 				l["blob_uri"] = *disk.BlobURI
 			}
 			l["caching"] = string(disk.Caching)
+			l["disk_encryption_set_id"] = flattenDiskEncryptionSetParameters(disk.DiskEncryptionSet)
 			if disk.DiskSizeGB != nil {
 				l["size_gb"] = *disk.DiskSizeGB
 			}
@@ -415,6 +432,8 @@ func expandAzureRmImageOsDisk(d *schema.ResourceData) (*compute.ImageOSDisk, err
 			diskSize := int32(size.(int))
 			osDisk.DiskSizeGB = &diskSize
 		}
+
+		osDisk.DiskEncryptionSet = expandDiskEncryptionSetParameters(config["disk_encryption_set_id"].(string))
 	}
 
 	return osDisk, nil
@@ -434,8 +453,9 @@ func expandAzureRmImageDataDisks(d *schema.ResourceData) ([]compute.ImageDataDis
 		lun := int32(config["lun"].(int))
 
 		dataDisk := compute.ImageDataDisk{
-			Lun:     &lun,
-			BlobURI: &blobURI,
+			Lun:               &lun,
+			BlobURI:           &blobURI,
+			DiskEncryptionSet: expandDiskEncryptionSetParameters(config["disk_encryption_set_id"].(string)),
 		}
 
 		if size := config["size_gb"]; size != 0 {
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -95,6 +96,33 @@ func TestAccAzureRMImage_standaloneImageZoneRedundant(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMImage_diskEncryptionSet(t *testing.T) {
+	resourceName := "azurerm_image.test"
+	rs := acctest.RandString(4)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMImage_diskEncryptionSet(rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMImageExists(resourceName, true),
+					resource.TestCheckResourceAttrPair(resourceName, "os_disk.0.disk_encryption_set_id", "azurerm_disk_encryption_set.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "data_disk.0.disk_encryption_set_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "data_disk.1.disk_encryption_set_id", "azurerm_disk_encryption_set.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMImage_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -1429,3 +1457,55 @@ resource "azurerm_virtual_machine_scale_set" "testdestination" {
 }
 `, rInt, location, rInt, rInt, rInt, hostName, rInt, rInt, userName, password, rInt, userName, password, rInt)
 }
+
+func testAccAzureRMImage_diskEncryptionSet(rString string, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "os" {
+  name                 = "acctestmd-os-%s"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "30"
+}
+
+resource "azurerm_managed_disk" "data" {
+  count                = 2
+  name                 = "acctestmd-data-%s-${count.index}"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_image" "test" {
+  name                = "acctestimage-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  os_disk {
+    os_type                = "Linux"
+    os_state               = "Generalized"
+    managed_disk_id        = "${azurerm_managed_disk.os.id}"
+    disk_encryption_set_id = "${azurerm_disk_encryption_set.test.id}"
+  }
+
+  data_disk {
+    lun             = 0
+    managed_disk_id = "${azurerm_managed_disk.data.0.id}"
+  }
+
+  data_disk {
+    lun                    = 1
+    managed_disk_id        = "${azurerm_managed_disk.data.1.id}"
+    disk_encryption_set_id = "${azurerm_disk_encryption_set.test.id}"
+  }
+
+  depends_on = ["azurerm_key_vault_access_policy.disk-encryption"]
+}
+`, template, rString, rString, rString)
+}
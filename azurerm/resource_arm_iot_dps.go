@@ -18,6 +18,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,8 +30,13 @@ func resourceArmIotDPS() *schema.Resource {
 		Update: resourceArmIotDPSCreateOrUpdate,
 		Delete: resourceArmIotDPSDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateIotDpsID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,7 +143,8 @@ func resourceArmIotDPS() *schema.Resource {
 
 func resourceArmIotDPSCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -161,7 +169,7 @@ func resourceArmIotDPSCreateOrUpdate(d *schema.ResourceData, meta interface{}) e
 		Properties: &iothub.IotDpsPropertiesDescription{
 			IotHubs: expandIoTDPSIoTHubs(d.Get("linked_hub").([]interface{})),
 		},
-		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		Tags: expandTagsWithDefaults(meta, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, iotdps)
@@ -189,14 +197,15 @@ func resourceArmIotDPSCreateOrUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmIotDPSRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotDpsID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["provisioningServices"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, name, resourceGroup)
 	if err != nil {
@@ -231,14 +240,15 @@ func resourceArmIotDPSRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmIotDPSDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotDpsID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["provisioningServices"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, name, resourceGroup)
 	if err != nil {
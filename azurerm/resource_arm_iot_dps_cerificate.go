@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,8 +22,13 @@ func resourceArmIotDPSCertificate() *schema.Resource {
 		Update: resourceArmIotDPSCertificateCreateOrUpdate,
 		Delete: resourceArmIotDPSCertificateDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateIotDpsCertificateID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +60,8 @@ func resourceArmIotDPSCertificate() *schema.Resource {
 
 func resourceArmIotDPSCertificateCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -95,15 +104,16 @@ func resourceArmIotDPSCertificateCreateOrUpdate(d *schema.ResourceData, meta int
 
 func resourceArmIotDPSCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotDpsCertificateID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	iotDPSName := id.Path["provisioningServices"]
-	name := id.Path["certificates"]
+	iotDPSName := id.ProvisioningServiceName
+	name := id.Name
 
 	resp, err := client.Get(ctx, name, resourceGroup, iotDPSName, "")
 	if err != nil {
@@ -124,15 +134,16 @@ func resourceArmIotDPSCertificateRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmIotDPSCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSCertificateClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotDpsCertificateID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	iotDPSName := id.Path["provisioningServices"]
-	name := id.Path["certificates"]
+	iotDPSName := id.ProvisioningServiceName
+	name := id.Name
 
 	resp, err := client.Get(ctx, name, resourceGroup, iotDPSName, "")
 	if err != nil {
@@ -19,6 +19,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -45,12 +47,17 @@ func supressWhenAll(fs ...schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppre
 
 func resourceArmIotHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmIotHubCreateUpdate,
-		Read:   resourceArmIotHubRead,
-		Update: resourceArmIotHubCreateUpdate,
-		Delete: resourceArmIotHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmIotHubCreateUpdate,
+		Read:     resourceArmIotHubRead,
+		Update:   resourceArmIotHubCreateUpdate,
+		Delete:   resourceArmIotHubDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateIotHubID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -419,7 +426,8 @@ func resourceArmIotHub() *schema.Resource {
 
 func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	subscriptionID := meta.(*ArmClient).subscriptionId
 
 	name := d.Get("name").(string)
@@ -488,7 +496,7 @@ func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) err
 			MessagingEndpoints:            messagingEndpoints,
 			EnableFileUploadNotifications: &enableFileUploadNotifications,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties, "")
@@ -512,14 +520,15 @@ func resourceArmIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["IotHubs"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 	hub, err := client.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
@@ -605,15 +614,16 @@ func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmIotHubDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	name := id.Path["IotHubs"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	azureRMLockByName(name, iothubResourceName)
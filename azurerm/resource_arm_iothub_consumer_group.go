@@ -3,21 +3,28 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmIotHubConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmIotHubConsumerGroupCreate,
-		Read:   resourceArmIotHubConsumerGroupRead,
-		Delete: resourceArmIotHubConsumerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmIotHubConsumerGroupCreate,
+		Read:     resourceArmIotHubConsumerGroupRead,
+		Delete:   resourceArmIotHubConsumerGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateIotHubConsumerGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +55,8 @@ func resourceArmIotHubConsumerGroup() *schema.Resource {
 
 func resourceArmIotHubConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM IoTHub Consumer Group creation.")
 
 	name := d.Get("name").(string)
@@ -89,16 +97,17 @@ func resourceArmIotHubConsumerGroupCreate(d *schema.ResourceData, meta interface
 
 func resourceArmIotHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	iotHubName := id.Path["IotHubs"]
-	endpointName := id.Path["eventHubEndpoints"]
-	name := id.Path["ConsumerGroups"]
+	iotHubName := id.IotHubName
+	endpointName := id.EventHubEndpointName
+	name := id.Name
 
 	resp, err := client.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
 	if err != nil {
@@ -120,16 +129,17 @@ func resourceArmIotHubConsumerGroupRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmIotHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseIotHubConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	iotHubName := id.Path["IotHubs"]
-	endpointName := id.Path["eventHubEndpoints"]
-	name := id.Path["ConsumerGroups"]
+	iotHubName := id.IotHubName
+	endpointName := id.EventHubEndpointName
+	name := id.Name
 
 	resp, err := client.DeleteEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
 
@@ -5,6 +5,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/iothub/mgmt/2018-12-01-preview/devices"
 	"github.com/hashicorp/go-multierror"
@@ -13,17 +14,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmIotHubSharedAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmIotHubSharedAccessPolicyCreateUpdate,
-		Read:   resourceArmIotHubSharedAccessPolicyRead,
-		Update: resourceArmIotHubSharedAccessPolicyCreateUpdate,
-		Delete: resourceArmIotHubSharedAccessPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmIotHubSharedAccessPolicyCreateUpdate,
+		Read:     resourceArmIotHubSharedAccessPolicyRead,
+		Update:   resourceArmIotHubSharedAccessPolicyCreateUpdate,
+		Delete:   resourceArmIotHubSharedAccessPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateIotHubSharedAccessPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,7 +127,8 @@ func iothubSharedAccessPolicyCustomizeDiff(d *schema.ResourceDiff, _ interface{}
 
 func resourceArmIotHubSharedAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -189,17 +198,18 @@ func resourceArmIotHubSharedAccessPolicyCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmIotHubSharedAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	parsedIothubSAPId, err := parseAzureResourceID(d.Id())
+	parsedIothubSAPId, err := resourceid.ParseIotHubSharedAccessPolicyID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := parsedIothubSAPId.ResourceGroup
-	iothubName := parsedIothubSAPId.Path["IotHubs"]
-	keyName := parsedIothubSAPId.Path["IotHubKeys"]
+	iothubName := parsedIothubSAPId.IotHubName
+	keyName := parsedIothubSAPId.Name
 
 	accessPolicy, err := client.GetKeysForKeyName(ctx, resourceGroup, iothubName, keyName)
 	if err != nil {
@@ -241,17 +251,18 @@ func resourceArmIotHubSharedAccessPolicyRead(d *schema.ResourceData, meta interf
 
 func resourceArmIotHubSharedAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.ResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	parsedIothubSAPId, err := parseAzureResourceID(d.Id())
+	parsedIothubSAPId, err := resourceid.ParseIotHubSharedAccessPolicyID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := parsedIothubSAPId.ResourceGroup
-	iothubName := parsedIothubSAPId.Path["IotHubs"]
-	keyName := parsedIothubSAPId.Path["IotHubKeys"]
+	iothubName := parsedIothubSAPId.IotHubName
+	keyName := parsedIothubSAPId.Name
 
 	azureRMLockByName(iothubName, iothubResourceName)
 	defer azureRMUnlockByName(iothubName, iothubResourceName)
@@ -8,8 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -178,12 +178,12 @@ func testCheckAzureRMIotHubSharedAccessPolicyExists(resourceName string) resourc
 		if !ok {
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
-		parsedIothubId, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		parsedIothubId, err := resourceid.ParseIotHubSharedAccessPolicyID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
-		iothubName := parsedIothubId.Path["IotHubs"]
-		keyName := parsedIothubId.Path["IotHubKeys"]
+		iothubName := parsedIothubId.IotHubName
+		keyName := parsedIothubId.Name
 		resourceGroup := parsedIothubId.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).iothub.ResourceClient
@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -33,13 +35,20 @@ func resourceArmKeyVault() *schema.Resource {
 		Update: resourceArmKeyVaultCreateUpdate,
 		Delete: resourceArmKeyVaultDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateKeyVaultID),
 
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceArmKeyVaultValidateSoftDeleteAndPurgeProtection,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -141,6 +150,18 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -186,7 +207,8 @@ func resourceArmKeyVault() *schema.Resource {
 
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// Remove in 2.0
 	var sku keyvault.Sku
@@ -234,6 +256,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
@@ -256,7 +280,15 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			EnabledForTemplateDeployment: &enabledForTemplateDeployment,
 			NetworkAcls:                  networkAcls,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
+	}
+
+	// the API doesn't accept `false` for these fields, so they're only sent when enabled
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
 	}
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
@@ -267,12 +299,12 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 	virtualNetworkNames := make([]string, 0)
 	for _, v := range subnetIds {
-		id, err2 := parseAzureResourceID(v)
+		id, err2 := resourceid.ParseSubnetID(v)
 		if err2 != nil {
 			return err2
 		}
 
-		virtualNetworkName := id.Path["virtualNetworks"]
+		virtualNetworkName := id.VirtualNetworkName
 		if !sliceContainsValue(virtualNetworkNames, virtualNetworkName) {
 			virtualNetworkNames = append(virtualNetworkNames, virtualNetworkName)
 		}
@@ -319,16 +351,38 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceArmKeyVaultRead(d, meta)
 }
 
+// resourceArmKeyVaultValidateSoftDeleteAndPurgeProtection ensures that Purge Protection is only enabled alongside
+// Soft Delete - and since neither can be disabled once enabled, that this is raised during the plan rather than
+// when the Key Vault is being updated
+func resourceArmKeyVaultValidateSoftDeleteAndPurgeProtection(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("purge_protection_enabled").(bool) && !d.Get("soft_delete_enabled").(bool) {
+		return fmt.Errorf("`purge_protection_enabled` can only be set when `soft_delete_enabled` is also enabled")
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, field := range []string{"soft_delete_enabled", "purge_protection_enabled"} {
+		if old, new := d.GetChange(field); old.(bool) && !new.(bool) {
+			return fmt.Errorf("`%s` cannot be disabled once it has been enabled", field)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKeyVaultID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["vaults"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -353,6 +407,18 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
 		d.Set("vault_uri", props.VaultURI)
 
+		softDeleteEnabled := false
+		if props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+		d.Set("soft_delete_enabled", softDeleteEnabled)
+
+		purgeProtectionEnabled := false
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
 		if sku := props.Sku; sku != nil {
 			// Remove in 2.0
 			if err := d.Set("sku", flattenKeyVaultSku(sku)); err != nil {
@@ -382,14 +448,15 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKeyVaultID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["vaults"]
+	name := id.Name
 
 	azureRMLockByName(name, keyVaultResourceName)
 	defer azureRMUnlockByName(name, keyVaultResourceName)
@@ -413,12 +480,12 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 						continue
 					}
 
-					id, err2 := parseAzureResourceID(*v.ID)
+					id, err2 := resourceid.ParseSubnetID(*v.ID)
 					if err2 != nil {
 						return err2
 					}
 
-					virtualNetworkName := id.Path["virtualNetworks"]
+					virtualNetworkName := id.VirtualNetworkName
 					if !sliceContainsValue(virtualNetworkNames, virtualNetworkName) {
 						virtualNetworkNames = append(virtualNetworkNames, virtualNetworkName)
 					}
@@ -437,6 +504,38 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// when Soft Delete is enabled the Key Vault remains in a recoverable state until it's purged
+	if meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		softDeleteEnabled := false
+		if props := read.Properties; props != nil && props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+
+		purgeProtectionEnabled := false
+		if props := read.Properties; props != nil && props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+
+		// a Key Vault with Purge Protection enabled can only be purged by the service once the retention period has elapsed
+		if purgeProtectionEnabled {
+			log.Printf("[DEBUG] Skipping purging Soft-Deleted Key Vault %q since Purge Protection is enabled", name)
+		}
+
+		if softDeleteEnabled && !purgeProtectionEnabled && read.Location != nil {
+			location := *read.Location
+			log.Printf("[DEBUG] Purging Soft-Deleted Key Vault %q (Location %q)..", name, location)
+			future, err := client.PurgeDeleted(ctx, name, location)
+			if err != nil {
+				return fmt.Errorf("Error purging Soft-Deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for purge of Soft-Deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+			log.Printf("[DEBUG] Purged Soft-Deleted Key Vault %q (Location %q).", name, location)
+		}
+	}
+
 	return nil
 }
 
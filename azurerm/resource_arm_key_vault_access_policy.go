@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -13,17 +15,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKeyVaultAccessPolicyCreate,
-		Read:   resourceArmKeyVaultAccessPolicyRead,
-		Update: resourceArmKeyVaultAccessPolicyUpdate,
-		Delete: resourceArmKeyVaultAccessPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmKeyVaultAccessPolicyCreate,
+		Read:     resourceArmKeyVaultAccessPolicyRead,
+		Update:   resourceArmKeyVaultAccessPolicyUpdate,
+		Delete:   resourceArmKeyVaultAccessPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateKeyVaultAccessPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,7 +117,15 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 
 func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta interface{}, action keyvault.AccessPolicyUpdateKind) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if action == keyvault.Remove {
+		ctx, cancel = timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	} else {
+		ctx, cancel = timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	}
+	defer cancel()
+
 	log.Printf("[INFO] Preparing arguments for Key Vault Access Policy: %s.", action)
 
 	vaultId := d.Get("key_vault_id").(string)
@@ -128,18 +145,13 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		if vaultId == "" {
 			return fmt.Errorf("one of `key_vault_id` or `vault_name` must be set")
 		}
-		id, err2 := azure.ParseAzureResourceID(vaultId)
+		id, err2 := resourceid.ParseKeyVaultID(vaultId)
 		if err2 != nil {
 			return err2
 		}
 
 		resourceGroup = id.ResourceGroup
-
-		vaultNameTemp, ok := id.Path["vaults"]
-		if !ok {
-			return fmt.Errorf("key_value_id does not contain `vaults`: %q", vaultId)
-		}
-		vaultName = vaultNameTemp
+		vaultName = id.Name
 
 	} else if resourceGroup == "" {
 		return fmt.Errorf("one of `resource_group_name` must be set when `vault_name` is used")
@@ -276,17 +288,17 @@ func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
-
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
+	id, err := resourceid.ParseKeyVaultAccessPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vaultName := id.Path["vaults"]
-	objectId := id.Path["objectId"]
-	applicationId := id.Path["applicationId"]
+	vaultName := id.VaultName
+	objectId := id.ObjectId
+	applicationId := id.ApplicationId
 
 	resp, err := client.Get(ctx, resGroup, vaultName)
 	if err != nil {
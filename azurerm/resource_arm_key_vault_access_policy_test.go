@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -205,13 +206,12 @@ func testCheckAzureRMKeyVaultAccessPolicyExists(resourceName string) resource.Te
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		id, err := parseAzureResourceID(rs.Primary.ID)
-
+		id, err := resourceid.ParseKeyVaultAccessPolicyID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 		resGroup := id.ResourceGroup
-		vaultName := id.Path["vaults"]
+		vaultName := id.VaultName
 
 		objectId := rs.Primary.Attributes["object_id"]
 		applicationId := rs.Primary.Attributes["application_id"]
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"time"
 
@@ -16,13 +17,16 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 //todo refactor and find a home for this wayward func
 func resourceArmKeyVaultChildResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -39,6 +43,29 @@ func resourceArmKeyVaultChildResourceImporter(d *schema.ResourceData, meta inter
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateKeyVaultChildResourceID returns a SchemaValidateFunc which validates that the specified value
+// is the ID of a Key Vault Child Resource of the specified type (e.g. `certificates`)
+func validateKeyVaultChildResourceID(childType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		if warnings, errors = azure.ValidateKeyVaultChildId(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		// example: https://tharvey-keyvault.vault.azure.net/type/bird/fdf067c93bbb4b22bff4d8b7a9a56217
+		idURL, err := url.ParseRequestURI(i.(string))
+		if err != nil {
+			errors = append(errors, fmt.Errorf("Cannot parse %q as a Key Vault Child ID: %+v", k, err))
+			return warnings, errors
+		}
+
+		if actualType := strings.Split(strings.Trim(idURL.Path, "/"), "/")[0]; actualType != childType {
+			errors = append(errors, fmt.Errorf("Expected %q to be the ID of a Key Vault %s but got %q", k, childType, actualType))
+		}
+
+		return warnings, errors
+	}
+}
+
 func resourceArmKeyVaultCertificate() *schema.Resource {
 	return &schema.Resource{
 		// TODO: support Updating once we have more information about what can be updated
@@ -46,8 +73,12 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 		Read:   resourceArmKeyVaultCertificateRead,
 		Delete: resourceArmKeyVaultCertificateDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: resourceArmKeyVaultChildResourceImporter,
+		Importer: resourceid.ValidatingImporterWithState(validateKeyVaultChildResourceID("certificates"), resourceArmKeyVaultChildResourceImporter),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -328,7 +359,7 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags": tagsForceNewSchema(),
 		},
 	}
 }
@@ -336,7 +367,8 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	keyVaultId := d.Get("key_vault_id").(string)
@@ -440,7 +472,8 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client keyvault
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -510,7 +543,8 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
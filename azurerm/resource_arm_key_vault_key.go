@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKeyVaultKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKeyVaultKeyCreate,
-		Read:   resourceArmKeyVaultKeyRead,
-		Update: resourceArmKeyVaultKeyUpdate,
-		Delete: resourceArmKeyVaultKeyDelete,
-		Importer: &schema.ResourceImporter{
-			State: resourceArmKeyVaultChildResourceImporter,
+		Create:   resourceArmKeyVaultKeyCreate,
+		Read:     resourceArmKeyVaultKeyRead,
+		Update:   resourceArmKeyVaultKeyUpdate,
+		Delete:   resourceArmKeyVaultKeyDelete,
+		Importer: resourceid.ValidatingImporterWithState(validateKeyVaultChildResourceID("keys"), resourceArmKeyVaultChildResourceImporter),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -144,7 +152,8 @@ func resourceArmKeyVaultKey() *schema.Resource {
 func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key creation.")
 	name := d.Get("name").(string)
@@ -230,7 +239,8 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -276,7 +286,8 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -350,7 +361,8 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
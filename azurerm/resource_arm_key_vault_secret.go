@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKeyVaultSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKeyVaultSecretCreate,
-		Read:   resourceArmKeyVaultSecretRead,
-		Update: resourceArmKeyVaultSecretUpdate,
-		Delete: resourceArmKeyVaultSecretDelete,
-		Importer: &schema.ResourceImporter{
-			State: resourceArmKeyVaultChildResourceImporter,
+		Create:   resourceArmKeyVaultSecretCreate,
+		Read:     resourceArmKeyVaultSecretRead,
+		Update:   resourceArmKeyVaultSecretUpdate,
+		Delete:   resourceArmKeyVaultSecretDelete,
+		Importer: resourceid.ValidatingImporterWithState(validateKeyVaultChildResourceID("secrets"), resourceArmKeyVaultChildResourceImporter),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +82,8 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret creation.")
 
@@ -145,7 +154,8 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret update.")
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
@@ -216,7 +226,8 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -273,7 +284,8 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyvault.VaultsClient
 	client := meta.(*ArmClient).keyvault.ManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
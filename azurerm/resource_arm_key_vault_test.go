@@ -98,6 +98,51 @@ func TestAccAzureRMKeyVault_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDeleteAndPurgeProtection(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVault_softDeleteAndPurgeProtection(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// omitting these once enabled shouldn't show a diff
+				Config:   testAccAzureRMKeyVault_basic(ri, location),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccAzureRMKeyVault_softDeleteAndPurgeProtectionDisabled(ri, location),
+				ExpectError: regexp.MustCompile("cannot be disabled once it has been enabled"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 // Remove in 2.0
 func TestAccAzureRMKeyVault_basicNotDefined(t *testing.T) {
 	ri := tf.AccRandTimeInt()
@@ -917,3 +962,73 @@ access_policy {
 }
 `, accountNum)
 }
+
+func testAccAzureRMKeyVault_softDeleteAndPurgeProtection(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "vault%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+
+  sku_name = "premium"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.client_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMKeyVault_softDeleteAndPurgeProtectionDisabled(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "vault%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled      = false
+  purge_protection_enabled = false
+
+  sku_name = "premium"
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.client_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+`, rInt, location, rInt)
+}
@@ -2,11 +2,14 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -15,52 +18,43 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// kubernetesClusterManagedIdentityClientId is the Service Principal Client ID used by Kubernetes Clusters which use a Managed Identity
+const kubernetesClusterManagedIdentityClientId = "msi"
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKubernetesClusterCreateUpdate,
-		Read:   resourceArmKubernetesClusterRead,
-		Update: resourceArmKubernetesClusterCreateUpdate,
-		Delete: resourceArmKubernetesClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmKubernetesClusterCreateUpdate,
+		Read:     resourceArmKubernetesClusterRead,
+		Update:   resourceArmKubernetesClusterCreateUpdate,
+		Delete:   resourceArmKubernetesClusterDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateKubernetesClusterID),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
-			if v, exists := diff.GetOk("network_profile"); exists {
-				rawProfiles := v.([]interface{})
-				if len(rawProfiles) == 0 {
-					return nil
-				}
-
-				// then ensure the conditionally-required fields are set
-				profile := rawProfiles[0].(map[string]interface{})
-				networkPlugin := profile["network_plugin"].(string)
-
-				if networkPlugin != "kubenet" && networkPlugin != "azure" {
-					return nil
-				}
-
-				dockerBridgeCidr := profile["docker_bridge_cidr"].(string)
-				dnsServiceIP := profile["dns_service_ip"].(string)
-				serviceCidr := profile["service_cidr"].(string)
-
-				// All empty values.
-				if dockerBridgeCidr == "" && dnsServiceIP == "" && serviceCidr == "" {
-					return nil
-				}
+			if err := resourceArmKubernetesClusterValidateIdentity(diff); err != nil {
+				return err
+			}
 
-				// All set values.
-				if dockerBridgeCidr != "" && dnsServiceIP != "" && serviceCidr != "" {
-					return nil
-				}
+			if err := resourceArmKubernetesClusterValidateNetworkProfile(diff); err != nil {
+				return err
+			}
 
-				return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
+			if err := resourceArmKubernetesClusterValidateAzureActiveDirectory(diff); err != nil {
+				return err
 			}
 
-			return nil
+			return resourceArmKubernetesClusterValidateVersions(diff)
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -204,15 +198,24 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+
+						// NOTE: this is intentionally not Computed - Node Pools without an `orchestrator_version`
+						// follow the `kubernetes_version`, which we can only detect when it's not in the state
+						"orchestrator_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
 					},
 				},
 			},
 
 			// TODO: 2.0 - we should be able to make this a List to be able to detect changes in the Client Secret
 			"service_principal": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MaxItems: 1,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"identity"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"client_id": {
@@ -234,6 +237,34 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				Set: resourceKubernetesClusterServicePrincipalProfileHash,
 			},
 
+			"identity": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"service_principal"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.SystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			// Optional
 			"addon_profile": {
 				Type:     schema.TypeList,
@@ -452,16 +483,33 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"managed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+
+									"admin_group_object_ids": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.UUID,
+										},
+									},
+
 									"client_app_id": {
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
 										ForceNew:     true,
 										ValidateFunc: validate.UUID,
 									},
 
 									"server_app_id": {
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
 										ForceNew:     true,
 										ValidateFunc: validate.UUID,
 									},
@@ -469,7 +517,7 @@ func resourceArmKubernetesCluster() *schema.Resource {
 									"server_app_secret": {
 										Type:         schema.TypeString,
 										ForceNew:     true,
-										Required:     true,
+										Optional:     true,
 										Sensitive:    true,
 										ValidateFunc: validate.NoEmptyStrings,
 									},
@@ -529,6 +577,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"exec":     kubernetesClusterKubeConfigExecSchema(),
+						"contexts": kubernetesClusterKubeConfigContextsSchema(),
 					},
 				},
 			},
@@ -571,6 +621,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"exec":     kubernetesClusterKubeConfigExecSchema(),
+						"contexts": kubernetesClusterKubeConfigContextsSchema(),
 					},
 				},
 			},
@@ -581,6 +633,27 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"kubelet_identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_assigned_identity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"node_resource_group": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -602,7 +675,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	tenantId := meta.(*ArmClient).tenantId
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
@@ -634,6 +708,13 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	}
 	windowsProfile := expandKubernetesClusterWindowsProfile(d)
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
+	identity := expandKubernetesClusterManagedClusterIdentity(d.Get("identity").([]interface{}))
+	if identity != nil {
+		// clusters using a Managed Identity signal this to the API using the Client ID `msi`
+		servicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: utils.String(kubernetesClusterManagedIdentityClientId),
+		}
+	}
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandKubernetesClusterAddonProfiles(d)
 
@@ -647,24 +728,58 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 	nodeResourceGroup := d.Get("node_resource_group").(string)
 
+	nodePoolUpgrades := make(map[string]string)
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.ManagedClusterProperties; props != nil {
+			if d.HasChange("kubernetes_version") && props.KubernetesVersion != nil && kubernetesVersion != "" {
+				servicesClient := meta.(*ArmClient).containers.ServicesClient
+				_, orchestrators, err := kubernetesServiceOrchestratorVersions(ctx, servicesClient, location)
+				if err != nil {
+					return err
+				}
+
+				if err := validateKubernetesServiceUpgradeIsAvailable(orchestrators, *props.KubernetesVersion, kubernetesVersion); err != nil {
+					return fmt.Errorf("Error upgrading Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+				}
+			}
+
+			// Node Pools managed outside of this resource (e.g. by `azurerm_kubernetes_cluster_node_pool`) need to be
+			// sent back to the API unchanged, otherwise they'd be removed from the cluster
+			oldProfilesRaw, _ := d.GetChange("agent_pool_profile")
+			previousNames := kubernetesClusterAgentPoolProfileNames(oldProfilesRaw.([]interface{}))
+			agentProfiles = appendExternallyManagedAgentPoolProfiles(agentProfiles, props.AgentPoolProfiles, previousNames)
+
+			// the Control Plane is upgraded first, so the Node Pools are upgraded separately once that's completed
+			nodePoolUpgrades = deferKubernetesClusterAgentPoolUpgrades(agentProfiles, props.AgentPoolProfiles, kubernetesVersion)
+		}
+	}
+
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
+		Identity: identity,
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
-			APIServerAuthorizedIPRanges: apiServerAuthorizedIPRanges,
-			AadProfile:                  azureADProfile,
-			AddonProfiles:               addonProfiles,
-			AgentPoolProfiles:           &agentProfiles,
-			DNSPrefix:                   utils.String(dnsPrefix),
-			EnableRBAC:                  utils.Bool(rbacEnabled),
-			KubernetesVersion:           utils.String(kubernetesVersion),
-			LinuxProfile:                linuxProfile,
-			WindowsProfile:              windowsProfile,
-			NetworkProfile:              networkProfile,
-			ServicePrincipalProfile:     servicePrincipalProfile,
-			NodeResourceGroup:           utils.String(nodeResourceGroup),
+			APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{
+				AuthorizedIPRanges: apiServerAuthorizedIPRanges,
+			},
+			AadProfile:              azureADProfile,
+			AddonProfiles:           addonProfiles,
+			AgentPoolProfiles:       &agentProfiles,
+			DNSPrefix:               utils.String(dnsPrefix),
+			EnableRBAC:              utils.Bool(rbacEnabled),
+			KubernetesVersion:       utils.String(kubernetesVersion),
+			LinuxProfile:            linuxProfile,
+			WindowsProfile:          windowsProfile,
+			NetworkProfile:          networkProfile,
+			ServicePrincipalProfile: servicePrincipalProfile,
+			NodeResourceGroup:       utils.String(nodeResourceGroup),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
@@ -676,6 +791,13 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	if len(nodePoolUpgrades) > 0 {
+		poolsClient := meta.(*ArmClient).containers.AgentPoolsClient
+		if err := upgradeKubernetesClusterAgentPools(ctx, poolsClient, resGroup, name, nodePoolUpgrades); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
@@ -692,14 +814,15 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -729,7 +852,10 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("kubernetes_version", props.KubernetesVersion)
 		d.Set("node_resource_group", props.NodeResourceGroup)
 
-		apiServerAuthorizedIPRanges := utils.FlattenStringSlice(props.APIServerAuthorizedIPRanges)
+		apiServerAuthorizedIPRanges := make([]interface{}, 0)
+		if profile := props.APIServerAccessProfile; profile != nil {
+			apiServerAuthorizedIPRanges = utils.FlattenStringSlice(profile.AuthorizedIPRanges)
+		}
 		if err := d.Set("api_server_authorized_ip_ranges", apiServerAuthorizedIPRanges); err != nil {
 			return fmt.Errorf("Error setting `api_server_authorized_ip_ranges`: %+v", err)
 		}
@@ -739,7 +865,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn)
+		// Node Pools managed outside of this resource are ignored, unless this resource is being imported
+		configuredNames := kubernetesClusterAgentPoolProfileNames(d.Get("agent_pool_profile").([]interface{}))
+		agentPoolProfilesToFlatten := filterKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, configuredNames)
+		versionedNames := kubernetesClusterAgentPoolProfileNamesWithVersion(d.Get("agent_pool_profile").([]interface{}))
+		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(agentPoolProfilesToFlatten, resp.Fqdn, versionedNames)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -769,6 +899,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
 		}
 
+		kubeletIdentity := flattenKubernetesClusterKubeletIdentity(props.IdentityProfile)
+		if err := d.Set("kubelet_identity", kubeletIdentity); err != nil {
+			return fmt.Errorf("Error setting `kubelet_identity`: %+v", err)
+		}
+
 		// adminProfile is only available for RBAC enabled clusters with AAD
 		if props.AadProfile != nil {
 			adminProfile, err := client.GetAccessProfile(ctx, resGroup, name, "clusterAdmin")
@@ -787,6 +922,10 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := d.Set("identity", flattenKubernetesClusterManagedClusterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(profile)
 	d.Set("kube_config_raw", kubeConfigRaw)
 	if err := d.Set("kube_config", kubeConfig); err != nil {
@@ -800,14 +939,15 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -821,6 +961,155 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceArmKubernetesClusterValidateIdentity(diff *schema.ResourceDiff) error {
+	// the Service Principal may be interpolated from a resource which hasn't been created yet
+	if !diff.NewValueKnown("service_principal") {
+		return nil
+	}
+
+	_, hasServicePrincipal := diff.GetOk("service_principal")
+	_, hasIdentity := diff.GetOk("identity")
+
+	if !hasServicePrincipal && !hasIdentity {
+		return fmt.Errorf("either a `service_principal` or an `identity` block must be specified")
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterValidateAzureActiveDirectory(diff *schema.ResourceDiff) error {
+	rbacRaw := diff.Get("role_based_access_control").([]interface{})
+	if len(rbacRaw) == 0 || rbacRaw[0] == nil {
+		return nil
+	}
+
+	rbac := rbacRaw[0].(map[string]interface{})
+	azureADsRaw := rbac["azure_active_directory"].([]interface{})
+	if len(azureADsRaw) == 0 || azureADsRaw[0] == nil {
+		return nil
+	}
+
+	azureAD := azureADsRaw[0].(map[string]interface{})
+	appFields := []string{"client_app_id", "server_app_id", "server_app_secret"}
+
+	if azureAD["managed"].(bool) {
+		if !rbac["enabled"].(bool) {
+			return fmt.Errorf("`enabled` must be set to `true` within the `role_based_access_control` block when `managed` is set to `true`")
+		}
+
+		for _, field := range appFields {
+			if azureAD[field].(string) != "" {
+				return fmt.Errorf("`client_app_id`, `server_app_id` and `server_app_secret` cannot be specified when `managed` is set to `true`")
+			}
+		}
+
+		return nil
+	}
+
+	if len(azureAD["admin_group_object_ids"].([]interface{})) > 0 {
+		return fmt.Errorf("`admin_group_object_ids` can only be specified when `managed` is set to `true`")
+	}
+
+	for _, field := range appFields {
+		// these may be interpolated from a resource which hasn't been created yet
+		if !diff.NewValueKnown(fmt.Sprintf("role_based_access_control.0.azure_active_directory.0.%s", field)) {
+			continue
+		}
+
+		if azureAD[field].(string) == "" {
+			return fmt.Errorf("`client_app_id`, `server_app_id` and `server_app_secret` must be specified when `managed` is set to `false`")
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterValidateNetworkProfile(diff *schema.ResourceDiff) error {
+	if v, exists := diff.GetOk("network_profile"); exists {
+		rawProfiles := v.([]interface{})
+		if len(rawProfiles) == 0 {
+			return nil
+		}
+
+		// then ensure the conditionally-required fields are set
+		profile := rawProfiles[0].(map[string]interface{})
+		networkPlugin := profile["network_plugin"].(string)
+
+		if networkPlugin != "kubenet" && networkPlugin != "azure" {
+			return nil
+		}
+
+		dockerBridgeCidr := profile["docker_bridge_cidr"].(string)
+		dnsServiceIP := profile["dns_service_ip"].(string)
+		serviceCidr := profile["service_cidr"].(string)
+
+		// All empty values.
+		if dockerBridgeCidr == "" && dnsServiceIP == "" && serviceCidr == "" {
+			return nil
+		}
+
+		// All set values.
+		if dockerBridgeCidr != "" && dnsServiceIP != "" && serviceCidr != "" {
+			return nil
+		}
+
+		return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
+	}
+
+	return nil
+}
+
+// resourceArmKubernetesClusterValidateVersions surfaces Kubernetes Version changes which Azure won't allow during the plan,
+// rather than part-way through an upgrade
+func resourceArmKubernetesClusterValidateVersions(diff *schema.ResourceDiff) error {
+	if diff.HasChange("kubernetes_version") {
+		oldRaw, newRaw := diff.GetChange("kubernetes_version")
+		oldVersion := oldRaw.(string)
+		newVersion := newRaw.(string)
+
+		if oldVersion != "" && newVersion != "" {
+			if err := kubernetes.ValidateControlPlaneUpgrade(oldVersion, newVersion); err != nil {
+				return fmt.Errorf("Error validating `kubernetes_version`: %+v", err)
+			}
+		}
+	}
+
+	// the Control Plane version is unknown until it's been created, when it's not specified
+	kubernetesVersion := diff.Get("kubernetes_version").(string)
+	if kubernetesVersion == "" {
+		return nil
+	}
+
+	profiles := diff.Get("agent_pool_profile").([]interface{})
+	for i, raw := range profiles {
+		if raw == nil {
+			continue
+		}
+
+		profile := raw.(map[string]interface{})
+		name := profile["name"].(string)
+		orchestratorVersion := profile["orchestrator_version"].(string)
+		if orchestratorVersion == "" {
+			continue
+		}
+
+		// Node Pools using Availability Sets are always upgraded alongside the Control Plane
+		if profile["type"].(string) != string(containerservice.VirtualMachineScaleSets) {
+			if diff.HasChange(fmt.Sprintf("agent_pool_profile.%d.orchestrator_version", i)) && orchestratorVersion != kubernetesVersion {
+				return fmt.Errorf("`orchestrator_version` for Node Pool %q must match `kubernetes_version` (%q) since only Node Pools of type %q can be upgraded independently of the Control Plane", name, kubernetesVersion, string(containerservice.VirtualMachineScaleSets))
+			}
+
+			continue
+		}
+
+		if err := kubernetes.ValidateNodePoolVersionSkew(kubernetesVersion, orchestratorVersion); err != nil {
+			return fmt.Errorf("Error validating `orchestrator_version` for Node Pool %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
 func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClusterAccessProfile) (*string, []interface{}) {
 	if accessProfile := profile.AccessProfile; accessProfile != nil {
 		if kubeConfigRaw := accessProfile.KubeConfig; kubeConfigRaw != nil {
@@ -1031,13 +1320,190 @@ func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) ([]contain
 			profile.NodeTaints = nodeTaints
 		}
 
+		// only Node Pools using Virtual Machine Scale Sets can run a different version to the Control Plane
+		if orchestratorVersion := config["orchestrator_version"].(string); orchestratorVersion != "" && profile.Type == containerservice.VirtualMachineScaleSets {
+			profile.OrchestratorVersion = utils.String(orchestratorVersion)
+		}
+
 		profiles = append(profiles, profile)
 	}
 
 	return profiles, nil
 }
 
-func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string) []interface{} {
+// kubernetesClusterAgentPoolProfileNames returns the names of the Node Pools defined in the `agent_pool_profile` block
+func kubernetesClusterAgentPoolProfileNames(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+
+		v := raw.(map[string]interface{})
+		if name, ok := v["name"].(string); ok && name != "" {
+			names[strings.ToLower(name)] = struct{}{}
+		}
+	}
+	return names
+}
+
+// kubernetesClusterAgentPoolProfileNamesWithVersion returns the names of the Node Pools defined in the `agent_pool_profile`
+// block which specify an `orchestrator_version` - the remaining Node Pools follow the `kubernetes_version`
+func kubernetesClusterAgentPoolProfileNamesWithVersion(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+
+		v := raw.(map[string]interface{})
+		name, ok := v["name"].(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		if version, ok := v["orchestrator_version"].(string); ok && version != "" {
+			names[strings.ToLower(name)] = struct{}{}
+		}
+	}
+	return names
+}
+
+// filterKubernetesClusterAgentPoolProfiles returns only the Node Pools with one of the specified names - or all of the
+// Node Pools when no names are specified (for example when importing)
+func filterKubernetesClusterAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile, names map[string]struct{}) *[]containerservice.ManagedClusterAgentPoolProfile {
+	if input == nil || len(names) == 0 {
+		return input
+	}
+
+	output := make([]containerservice.ManagedClusterAgentPoolProfile, 0)
+	for _, profile := range *input {
+		if profile.Name == nil {
+			continue
+		}
+
+		if _, ok := names[strings.ToLower(*profile.Name)]; ok {
+			output = append(output, profile)
+		}
+	}
+	return &output
+}
+
+// appendExternallyManagedAgentPoolProfiles appends any existing Node Pools which aren't defined in the `agent_pool_profile`
+// block - and weren't previously (in which case they've been removed intentionally) - to the specified Node Pools
+func appendExternallyManagedAgentPoolProfiles(profiles []containerservice.ManagedClusterAgentPoolProfile, existing *[]containerservice.ManagedClusterAgentPoolProfile, previousNames map[string]struct{}) []containerservice.ManagedClusterAgentPoolProfile {
+	if existing == nil {
+		return profiles
+	}
+
+	configuredNames := make(map[string]struct{})
+	for _, profile := range profiles {
+		if profile.Name != nil {
+			configuredNames[strings.ToLower(*profile.Name)] = struct{}{}
+		}
+	}
+
+	for _, profile := range *existing {
+		if profile.Name == nil {
+			continue
+		}
+
+		name := strings.ToLower(*profile.Name)
+		if _, ok := configuredNames[name]; ok {
+			continue
+		}
+		if _, ok := previousNames[name]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Retaining Node Pool %q which is managed outside of the `agent_pool_profile` block", *profile.Name)
+		profiles = append(profiles, profile)
+	}
+
+	return profiles
+}
+
+// deferKubernetesClusterAgentPoolUpgrades retains the current version of each existing Node Pool using Virtual Machine
+// Scale Sets, returning the versions which each Node Pool needs to be upgraded to once the Control Plane has been upgraded.
+// Node Pools without an `orchestrator_version` follow the Control Plane, and so are upgraded to the `kubernetes_version`.
+func deferKubernetesClusterAgentPoolUpgrades(profiles []containerservice.ManagedClusterAgentPoolProfile, existing *[]containerservice.ManagedClusterAgentPoolProfile, kubernetesVersion string) map[string]string {
+	upgrades := make(map[string]string)
+	if existing == nil {
+		return upgrades
+	}
+
+	currentVersions := make(map[string]string)
+	for _, profile := range *existing {
+		if profile.Name == nil || profile.OrchestratorVersion == nil {
+			continue
+		}
+
+		currentVersions[strings.ToLower(*profile.Name)] = *profile.OrchestratorVersion
+	}
+
+	for i, profile := range profiles {
+		if profile.Name == nil || profile.Type != containerservice.VirtualMachineScaleSets {
+			continue
+		}
+
+		currentVersion, ok := currentVersions[strings.ToLower(*profile.Name)]
+		if !ok {
+			continue
+		}
+
+		desiredVersion := kubernetesVersion
+		if profile.OrchestratorVersion != nil {
+			desiredVersion = *profile.OrchestratorVersion
+		}
+
+		if desiredVersion != "" && desiredVersion != currentVersion {
+			upgrades[*profile.Name] = desiredVersion
+		}
+
+		profiles[i].OrchestratorVersion = utils.String(currentVersion)
+	}
+
+	return upgrades
+}
+
+// upgradeKubernetesClusterAgentPools upgrades each of the specified Node Pools (in name order) to the specified version
+func upgradeKubernetesClusterAgentPools(ctx context.Context, client containerservice.AgentPoolsClient, resourceGroup string, clusterName string, upgrades map[string]string) error {
+	names := make([]string, 0)
+	for name := range upgrades {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		orchestratorVersion := upgrades[name]
+
+		existing, err := client.Get(ctx, resourceGroup, clusterName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+		}
+		if existing.ManagedClusterAgentPoolProfileProperties == nil {
+			return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): `properties` was nil", name, clusterName, resourceGroup)
+		}
+
+		// the API doesn't allow some read-only fields to be re-submitted
+		existing.ManagedClusterAgentPoolProfileProperties.ProvisioningState = nil
+		existing.ManagedClusterAgentPoolProfileProperties.OrchestratorVersion = utils.String(orchestratorVersion)
+
+		log.Printf("[DEBUG] Upgrading Node Pool %q (Kubernetes Cluster %q / Resource Group %q) to %q..", name, clusterName, resourceGroup, orchestratorVersion)
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, clusterName, name, existing)
+		if err != nil {
+			return fmt.Errorf("Error upgrading Node Pool %q (Kubernetes Cluster %q / Resource Group %q) to %q: %+v", name, clusterName, resourceGroup, orchestratorVersion, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for upgrade of Node Pool %q (Kubernetes Cluster %q / Resource Group %q) to %q: %+v", name, clusterName, resourceGroup, orchestratorVersion, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string, versionedNames map[string]struct{}) []interface{} {
 	if profiles == nil {
 		return []interface{}{}
 	}
@@ -1102,6 +1568,13 @@ func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.Mana
 			agentPoolProfile["node_taints"] = *profile.NodeTaints
 		}
 
+		// Node Pools without an `orchestrator_version` follow the `kubernetes_version`, so it's only set when specified
+		if profile.OrchestratorVersion != nil && profile.Name != nil {
+			if _, ok := versionedNames[strings.ToLower(*profile.Name)]; ok {
+				agentPoolProfile["orchestrator_version"] = *profile.OrchestratorVersion
+			}
+		}
+
 		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
 	}
 
@@ -1301,20 +1774,28 @@ func expandKubernetesClusterRoleBasedAccessControl(input []interface{}, provider
 	if len(azureADsRaw) > 0 {
 		azureAdRaw := azureADsRaw[0].(map[string]interface{})
 
-		clientAppId := azureAdRaw["client_app_id"].(string)
-		serverAppId := azureAdRaw["server_app_id"].(string)
-		serverAppSecret := azureAdRaw["server_app_secret"].(string)
 		tenantId := azureAdRaw["tenant_id"].(string)
-
 		if tenantId == "" {
 			tenantId = providerTenantId
 		}
 
-		aad = &containerservice.ManagedClusterAADProfile{
-			ClientAppID:     utils.String(clientAppId),
-			ServerAppID:     utils.String(serverAppId),
-			ServerAppSecret: utils.String(serverAppSecret),
-			TenantID:        utils.String(tenantId),
+		if azureAdRaw["managed"].(bool) {
+			aad = &containerservice.ManagedClusterAADProfile{
+				Managed:             utils.Bool(true),
+				AdminGroupObjectIDs: utils.ExpandStringSlice(azureAdRaw["admin_group_object_ids"].([]interface{})),
+				TenantID:            utils.String(tenantId),
+			}
+		} else {
+			clientAppId := azureAdRaw["client_app_id"].(string)
+			serverAppId := azureAdRaw["server_app_id"].(string)
+			serverAppSecret := azureAdRaw["server_app_secret"].(string)
+
+			aad = &containerservice.ManagedClusterAADProfile{
+				ClientAppID:     utils.String(clientAppId),
+				ServerAppID:     utils.String(serverAppId),
+				ServerAppSecret: utils.String(serverAppSecret),
+				TenantID:        utils.String(tenantId),
+			}
 		}
 	}
 
@@ -1331,6 +1812,20 @@ func flattenKubernetesClusterRoleBasedAccessControl(input *containerservice.Mana
 	if profile := input.AadProfile; profile != nil {
 		output := make(map[string]interface{})
 
+		managed := false
+		if profile.Managed != nil {
+			managed = *profile.Managed
+		}
+		output["managed"] = managed
+
+		adminGroupObjectIds := make([]interface{}, 0)
+		if profile.AdminGroupObjectIDs != nil {
+			for _, v := range *profile.AdminGroupObjectIDs {
+				adminGroupObjectIds = append(adminGroupObjectIds, v)
+			}
+		}
+		output["admin_group_object_ids"] = adminGroupObjectIds
+
 		if profile.ClientAppID != nil {
 			output["client_app_id"] = *profile.ClientAppID
 		}
@@ -1341,7 +1836,7 @@ func flattenKubernetesClusterRoleBasedAccessControl(input *containerservice.Mana
 
 		// since input.ServerAppSecret isn't returned we're pulling this out of the existing state (which won't work for Imports)
 		// role_based_access_control.0.azure_active_directory.0.server_app_secret
-		if existing, ok := d.GetOk("role_based_access_control"); ok {
+		if existing, ok := d.GetOk("role_based_access_control"); ok && !managed {
 			rbacRawVals := existing.([]interface{})
 			if len(rbacRawVals) > 0 {
 				rbacRawVal := rbacRawVals[0].(map[string]interface{})
@@ -1396,6 +1891,11 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerse
 		return nil
 	}
 
+	// clusters using a Managed Identity don't have a Service Principal
+	if profile.ClientID != nil && strings.EqualFold(*profile.ClientID, kubernetesClusterManagedIdentityClientId) {
+		return nil
+	}
+
 	servicePrincipalProfiles := &schema.Set{
 		F: resourceKubernetesClusterServicePrincipalProfileHash,
 	}
@@ -1414,6 +1914,71 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerse
 	return servicePrincipalProfiles
 }
 
+func expandKubernetesClusterManagedClusterIdentity(input []interface{}) *containerservice.ManagedClusterIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &containerservice.ManagedClusterIdentity{
+		Type: containerservice.ResourceIdentityType(v["type"].(string)),
+	}
+}
+
+func flattenKubernetesClusterManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) []interface{} {
+	if input == nil || input.Type == containerservice.None {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func flattenKubernetesClusterKubeletIdentity(input map[string]*containerservice.ManagedClusterPropertiesIdentityProfileValue) []interface{} {
+	kubeletIdentity, ok := input["kubeletidentity"]
+	if !ok || kubeletIdentity == nil {
+		return []interface{}{}
+	}
+
+	clientId := ""
+	if kubeletIdentity.ClientID != nil {
+		clientId = *kubeletIdentity.ClientID
+	}
+
+	objectId := ""
+	if kubeletIdentity.ObjectID != nil {
+		objectId = *kubeletIdentity.ObjectID
+	}
+
+	userAssignedIdentityId := ""
+	if kubeletIdentity.ResourceID != nil {
+		userAssignedIdentityId = *kubeletIdentity.ResourceID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_id":                 clientId,
+			"object_id":                 objectId,
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
+
 func resourceKubernetesClusterServicePrincipalProfileHash(v interface{}) int {
 	// TODO: this method should be able to be removed in time
 	var buf bytes.Buffer
@@ -1429,9 +1994,10 @@ func flattenKubernetesClusterKubeConfig(config kubernetes.KubeConfig) []interfac
 	values := make(map[string]interface{})
 
 	// we don't size-check these since they're validated in the Parse method
-	cluster := config.Clusters[0].Cluster
-	user := config.Users[0].User
-	name := config.Users[0].Name
+	clusterIndex, userIndex := config.ContextIndices(config.CurrentContext, config.UserNames())
+	cluster := config.Clusters[clusterIndex].Cluster
+	user := config.Users[userIndex].User
+	name := config.Users[userIndex].Name
 
 	values["host"] = cluster.Server
 	values["username"] = name
@@ -1439,6 +2005,8 @@ func flattenKubernetesClusterKubeConfig(config kubernetes.KubeConfig) []interfac
 	values["client_certificate"] = user.ClientCertificteData
 	values["client_key"] = user.ClientKeyData
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(user.Exec)
+	values["contexts"] = flattenKubernetesClusterKubeConfigContexts(config.KubeConfigBase)
 
 	return []interface{}{values}
 }
@@ -1447,8 +2015,10 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 	values := make(map[string]interface{})
 
 	// we don't size-check these since they're validated in the Parse method
-	cluster := config.Clusters[0].Cluster
-	name := config.Users[0].Name
+	clusterIndex, userIndex := config.ContextIndices(config.CurrentContext, config.UserNames())
+	cluster := config.Clusters[clusterIndex].Cluster
+	user := config.Users[userIndex].User
+	name := config.Users[userIndex].Name
 
 	values["host"] = cluster.Server
 	values["username"] = name
@@ -1459,5 +2029,113 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
 
+	// the `azure` auth-provider has been removed from newer clients, so the equivalent `kubelogin` plugin is exposed
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(user.AuthProvider.KubeLoginExec())
+	values["contexts"] = flattenKubernetesClusterKubeConfigContexts(config.KubeConfigBase)
+
 	return []interface{}{values}
 }
+
+func kubernetesClusterKubeConfigExecSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"api_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"command": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"args": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"env": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func kubernetesClusterKubeConfigContextsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"host": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cluster_ca_certificate": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"namespace": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenKubernetesClusterKubeConfigExec(input *kubernetes.ExecConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	env := make(map[string]interface{})
+	for _, v := range input.Env {
+		env[v.Name] = v.Value
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"api_version": input.APIVersion,
+			"command":     input.Command,
+			"args":        utils.FlattenStringSlice(&input.Args),
+			"env":         env,
+		},
+	}
+}
+
+func flattenKubernetesClusterKubeConfigContexts(config kubernetes.KubeConfigBase) []interface{} {
+	contexts := make([]interface{}, 0)
+	for _, item := range config.Contexts {
+		host := ""
+		clusterCACertificate := ""
+		for _, cluster := range config.Clusters {
+			if cluster.Name == item.Context.Cluster {
+				host = cluster.Cluster.Server
+				clusterCACertificate = cluster.Cluster.ClusterAuthorityData
+				break
+			}
+		}
+
+		contexts = append(contexts, map[string]interface{}{
+			"name":                   item.Name,
+			"host":                   host,
+			"cluster_ca_certificate": clusterCACertificate,
+			"username":               item.Context.User,
+			"namespace":              item.Context.Namespace,
+		})
+	}
+	return contexts
+}
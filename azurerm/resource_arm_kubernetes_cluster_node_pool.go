@@ -0,0 +1,497 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKubernetesClusterNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmKubernetesClusterNodePoolCreate,
+		Read:     resourceArmKubernetesClusterNodePoolRead,
+		Update:   resourceArmKubernetesClusterNodePoolUpdate,
+		Delete:   resourceArmKubernetesClusterNodePoolDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateKubernetesClusterNodePoolID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesAgentPoolName,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateKubernetesClusterID,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"enable_auto_scaling": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"max_pods": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"node_taints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"os_disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"orchestrator_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerservice.Linux),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.Linux),
+					string(containerservice.Windows),
+				}, false),
+			},
+
+			"vnet_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	clustersClient := meta.(*ArmClient).containers.KubernetesClustersClient
+	poolsClient := meta.(*ArmClient).containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	clusterId, err := resourceid.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := clusterId.ResourceGroup
+	clusterName := clusterId.Name
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Retrieving Kubernetes Cluster %q (Resource Group %q)..", clusterName, resourceGroup)
+	cluster, err := clustersClient.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(cluster.Response) {
+			return fmt.Errorf("Kubernetes Cluster %q was not found in Resource Group %q!", clusterName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving existing Kubernetes Cluster %q (Resource Group %q): %+v", clusterName, resourceGroup, err)
+	}
+
+	// Node Pools can only be managed independently of the Cluster when the Cluster uses Virtual Machine Scale Sets
+	if props := cluster.ManagedClusterProperties; props != nil && props.AgentPoolProfiles != nil {
+		for _, profile := range *props.AgentPoolProfiles {
+			if profile.Type != containerservice.VirtualMachineScaleSets {
+				return fmt.Errorf("Node Pools can only be added to Kubernetes Clusters using Virtual Machine Scale Sets - but Kubernetes Cluster %q (Resource Group %q) has a Node Pool of type %q", clusterName, resourceGroup, string(profile.Type))
+			}
+		}
+	}
+
+	if requireResourcesToBeImported {
+		existing, err := poolsClient.Get(ctx, resourceGroup, clusterName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %s", name, clusterName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_kubernetes_cluster_node_pool", *existing.ID)
+		}
+	}
+
+	if orchestratorVersion := d.Get("orchestrator_version").(string); orchestratorVersion != "" {
+		if err := validateKubernetesClusterNodePoolVersion(cluster, orchestratorVersion); err != nil {
+			return fmt.Errorf("Error validating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+		}
+	}
+
+	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
+	osType := d.Get("os_type").(string)
+	vmSize := d.Get("vm_size").(string)
+
+	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
+		OsType:            containerservice.OSType(osType),
+		EnableAutoScaling: utils.Bool(enableAutoScaling),
+		Type:              containerservice.VirtualMachineScaleSets,
+		VMSize:            containerservice.VMSizeTypes(vmSize),
+	}
+
+	availabilityZonesRaw := d.Get("availability_zones").([]interface{})
+	if availabilityZones := utils.ExpandStringSlice(availabilityZonesRaw); len(*availabilityZones) > 0 {
+		profile.AvailabilityZones = availabilityZones
+	}
+
+	if maxPods := int32(d.Get("max_pods").(int)); maxPods > 0 {
+		profile.MaxPods = utils.Int32(maxPods)
+	}
+
+	nodeTaintsRaw := d.Get("node_taints").([]interface{})
+	if nodeTaints := utils.ExpandStringSlice(nodeTaintsRaw); len(*nodeTaints) > 0 {
+		profile.NodeTaints = nodeTaints
+	}
+
+	if osDiskSizeGB := d.Get("os_disk_size_gb").(int); osDiskSizeGB > 0 {
+		profile.OsDiskSizeGB = utils.Int32(int32(osDiskSizeGB))
+	}
+
+	if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
+		profile.VnetSubnetID = utils.String(vnetSubnetID)
+	}
+
+	if orchestratorVersion := d.Get("orchestrator_version").(string); orchestratorVersion != "" {
+		profile.OrchestratorVersion = utils.String(orchestratorVersion)
+	}
+
+	maxCount := d.Get("max_count").(int)
+	minCount := d.Get("min_count").(int)
+	nodeCount := d.Get("node_count").(int)
+
+	if enableAutoScaling {
+		if maxCount == 0 || minCount == 0 {
+			return fmt.Errorf("`max_count` and `min_count` must be set when `enable_auto_scaling` is enabled")
+		}
+
+		if minCount > maxCount {
+			return fmt.Errorf("`max_count` must be >= `min_count`")
+		}
+
+		profile.MaxCount = utils.Int32(int32(maxCount))
+		profile.MinCount = utils.Int32(int32(minCount))
+
+		// the initial Node Count must be within the range of the Auto Scaler
+		if nodeCount == 0 || nodeCount < minCount {
+			nodeCount = minCount
+		}
+		if nodeCount > maxCount {
+			nodeCount = maxCount
+		}
+	} else {
+		if maxCount != 0 || minCount != 0 {
+			return fmt.Errorf("`max_count` and `min_count` must be omitted when `enable_auto_scaling` is disabled")
+		}
+
+		if nodeCount == 0 {
+			nodeCount = 1
+		}
+	}
+	profile.Count = utils.Int32(int32(nodeCount))
+
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(name),
+		ManagedClusterAgentPoolProfileProperties: &profile,
+	}
+
+	future, err := poolsClient.CreateOrUpdate(ctx, resourceGroup, clusterName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, poolsClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	read, err := poolsClient.Get(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Node Pool %q (Kubernetes Cluster %q / Resource Group %q)", name, clusterName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	clustersClient := meta.(*ArmClient).containers.KubernetesClustersClient
+	client := meta.(*ArmClient).containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieving existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", id.Name, id.ManagedClusterName, id.ResourceGroup)
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Node Pool %q was not found in Kubernetes Cluster %q / Resource Group %q!", id.Name, id.ManagedClusterName, id.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	if existing.ManagedClusterAgentPoolProfileProperties == nil {
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): `properties` was nil", id.Name, id.ManagedClusterName, id.ResourceGroup)
+	}
+
+	props := existing.ManagedClusterAgentPoolProfileProperties
+
+	// the API doesn't allow some read-only fields to be re-submitted
+	props.ProvisioningState = nil
+
+	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
+	props.EnableAutoScaling = utils.Bool(enableAutoScaling)
+
+	if enableAutoScaling {
+		maxCount := d.Get("max_count").(int)
+		minCount := d.Get("min_count").(int)
+		if maxCount == 0 || minCount == 0 {
+			return fmt.Errorf("`max_count` and `min_count` must be set when `enable_auto_scaling` is enabled")
+		}
+
+		if minCount > maxCount {
+			return fmt.Errorf("`max_count` must be >= `min_count`")
+		}
+
+		props.MaxCount = utils.Int32(int32(maxCount))
+		props.MinCount = utils.Int32(int32(minCount))
+
+		// the Auto Scaler manages the Node Count, so the current value is submitted unless it falls outside of the new range
+		if props.Count != nil {
+			if int(*props.Count) < minCount {
+				props.Count = utils.Int32(int32(minCount))
+			}
+			if int(*props.Count) > maxCount {
+				props.Count = utils.Int32(int32(maxCount))
+			}
+		}
+	} else {
+		props.MaxCount = nil
+		props.MinCount = nil
+
+		if d.HasChange("node_count") || d.HasChange("enable_auto_scaling") {
+			if nodeCount := d.Get("node_count").(int); nodeCount > 0 {
+				props.Count = utils.Int32(int32(nodeCount))
+			}
+		}
+	}
+
+	if d.HasChange("orchestrator_version") {
+		orchestratorVersion := d.Get("orchestrator_version").(string)
+
+		log.Printf("[DEBUG] Retrieving Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+		cluster, err := clustersClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+
+		if err := validateKubernetesClusterNodePoolVersion(cluster, orchestratorVersion); err != nil {
+			return fmt.Errorf("Error validating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+		}
+
+		props.OrchestratorVersion = utils.String(orchestratorVersion)
+	}
+
+	log.Printf("[DEBUG] Updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", id.Name, id.ManagedClusterName, id.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name, existing)
+	if err != nil {
+		return fmt.Errorf("Error updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Node Pool %q was not found in Kubernetes Cluster %q / Resource Group %q - removing from state!", id.Name, id.ManagedClusterName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	clusterId := resourceid.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName)
+
+	d.Set("name", id.Name)
+	d.Set("kubernetes_cluster_id", clusterId.String())
+
+	if props := resp.ManagedClusterAgentPoolProfileProperties; props != nil {
+		if err := d.Set("availability_zones", utils.FlattenStringSlice(props.AvailabilityZones)); err != nil {
+			return fmt.Errorf("Error setting `availability_zones`: %+v", err)
+		}
+
+		d.Set("enable_auto_scaling", props.EnableAutoScaling)
+
+		maxCount := 0
+		if props.MaxCount != nil {
+			maxCount = int(*props.MaxCount)
+		}
+		d.Set("max_count", maxCount)
+
+		maxPods := 0
+		if props.MaxPods != nil {
+			maxPods = int(*props.MaxPods)
+		}
+		d.Set("max_pods", maxPods)
+
+		minCount := 0
+		if props.MinCount != nil {
+			minCount = int(*props.MinCount)
+		}
+		d.Set("min_count", minCount)
+
+		count := 0
+		if props.Count != nil {
+			count = int(*props.Count)
+		}
+		d.Set("node_count", count)
+
+		if err := d.Set("node_taints", utils.FlattenStringSlice(props.NodeTaints)); err != nil {
+			return fmt.Errorf("Error setting `node_taints`: %+v", err)
+		}
+
+		osDiskSizeGB := 0
+		if props.OsDiskSizeGB != nil {
+			osDiskSizeGB = int(*props.OsDiskSizeGB)
+		}
+		d.Set("os_disk_size_gb", osDiskSizeGB)
+		d.Set("orchestrator_version", props.OrchestratorVersion)
+		d.Set("os_type", string(props.OsType))
+		d.Set("vnet_subnet_id", props.VnetSubnetID)
+		d.Set("vm_size", string(props.VMSize))
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// validateKubernetesClusterNodePoolVersion ensures the Node Pool version is supported by the Control Plane of the Kubernetes Cluster
+func validateKubernetesClusterNodePoolVersion(cluster containerservice.ManagedCluster, orchestratorVersion string) error {
+	props := cluster.ManagedClusterProperties
+	if props == nil || props.KubernetesVersion == nil {
+		return fmt.Errorf("`kubernetes_version` was nil for the Kubernetes Cluster")
+	}
+
+	return kubernetes.ValidateNodePoolVersionSkew(*props.KubernetesVersion, orchestratorVersion)
+}
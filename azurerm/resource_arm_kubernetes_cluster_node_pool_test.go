@@ -0,0 +1,325 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKubernetesClusterNodePool_basic(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_basic(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					// the cluster should ignore the Node Pool managed by the separate resource
+					resource.TestCheckResourceAttr("azurerm_kubernetes_cluster.test", "agent_pool_profile.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_basic(ri, clientId, clientSecret, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_basic(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMKubernetesClusterNodePool_requiresImport(ri, clientId, clientSecret, location),
+				ExpectError: testRequiresImportError("azurerm_kubernetes_cluster_node_pool"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_autoScale(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_autoScale(ri, clientId, clientSecret, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "node_taints.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// disabling the auto scaler
+				Config: testAccAzureRMKubernetesClusterNodePool_basic(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "false"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_orchestratorVersion(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_orchestratorVersion(ri, clientId, clientSecret, location, "1.13.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "orchestrator_version", "1.13.10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_orchestratorVersion(ri, clientId, clientSecret, location, "1.14.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "orchestrator_version", "1.14.6"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseKubernetesClusterNodePoolID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).containers.AgentPoolsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Node Pool %q (Kubernetes Cluster %q / Resource Group %q) does not exist", id.Name, id.ManagedClusterName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on agentPoolsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).containers.AgentPoolsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_kubernetes_cluster_node_pool" {
+			continue
+		}
+
+		id, err := resourceid.ParseKubernetesClusterNodePoolID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Node Pool %q (Kubernetes Cluster %q / Resource Group %q) still exists", id.Name, id.ManagedClusterName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMKubernetesClusterNodePool_template(rInt int, clientId, clientSecret, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = 1
+    type    = "VirtualMachineScaleSets"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_basic(rInt int, clientId, clientSecret, location string, nodeCount int) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = %d
+}
+`, template, nodeCount)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_requiresImport(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_basic(rInt, clientId, clientSecret, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "import" {
+  name                  = "${azurerm_kubernetes_cluster_node_pool.test.name}"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster_node_pool.test.kubernetes_cluster_id}"
+  vm_size               = "${azurerm_kubernetes_cluster_node_pool.test.vm_size}"
+  node_count            = "${azurerm_kubernetes_cluster_node_pool.test.node_count}"
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_autoScale(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  enable_auto_scaling   = true
+  min_count             = 1
+  max_count             = 3
+  node_taints           = ["key=value:NoSchedule"]
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_orchestratorVersion(rInt int, clientId, clientSecret, location, orchestratorVersion string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = "1.14.6"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = 1
+    type    = "VirtualMachineScaleSets"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+  orchestrator_version  = "%s"
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret, orchestratorVersion)
+}
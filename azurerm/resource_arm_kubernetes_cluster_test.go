@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-06-01/containerservice"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
@@ -82,6 +86,38 @@ func TestAccAzureRMKubernetesCluster_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_managedIdentity(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMKubernetesCluster_managedIdentity(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_principal.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kubelet_identity.0.client_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kubelet_identity.0.object_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kubelet_identity.0.user_assigned_identity_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKubernetesCluster_roleBasedAccessControl(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := tf.AccRandTimeInt()
@@ -140,6 +176,8 @@ func TestAccAzureRMKubernetesCluster_roleBasedAccessControlAAD(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "role_based_access_control.0.azure_active_directory.0.tenant_id"),
 					resource.TestCheckResourceAttr(resourceName, "kube_admin_config.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config_raw"),
+					resource.TestCheckResourceAttr(resourceName, "kube_config.0.exec.0.command", "kubelogin"),
+					resource.TestCheckResourceAttr(resourceName, "kube_config.0.contexts.#", "1"),
 				),
 			},
 			{
@@ -166,6 +204,56 @@ func TestAccAzureRMKubernetesCluster_roleBasedAccessControlAAD(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_roleBasedAccessControlAADManaged(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_roleBasedAccessControlAADManaged(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.0.managed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.0.server_app_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "role_based_access_control.0.azure_active_directory.0.tenant_id"),
+					resource.TestCheckResourceAttr(resourceName, "kube_admin_config.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config_raw"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesCluster_roleBasedAccessControlAADManagedWithServerApp(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMKubernetesCluster_roleBasedAccessControlAADManagedWithServerApp(ri, location),
+				ExpectError: regexp.MustCompile("cannot be specified when `managed` is set to `true`"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKubernetesCluster_linuxProfile(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := tf.AccRandTimeInt()
@@ -298,6 +386,166 @@ func TestAccAzureRMKubernetesCluster_upgradeConfig(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_upgradeNodePoolVersion(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(ri, location, clientId, clientSecret, "1.13.10", "1.13.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_version", "1.13.10"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.orchestrator_version", "1.13.10"),
+				),
+			},
+			{
+				// the Control Plane is upgraded whilst the Node Pool remains on the previous version
+				Config: testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(ri, location, clientId, clientSecret, "1.14.6", "1.13.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_version", "1.14.6"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.orchestrator_version", "1.13.10"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(ri, location, clientId, clientSecret, "1.14.6", "1.14.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_version", "1.14.6"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.orchestrator_version", "1.14.6"),
+				),
+			},
+			{
+				// Node Pools cannot be newer than the Control Plane
+				Config:      testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(ri, location, clientId, clientSecret, "1.14.6", "1.15.3"),
+				ExpectError: regexp.MustCompile("cannot be newer than the Control Plane version"),
+			},
+			{
+				// minor versions cannot be skipped when upgrading the Control Plane
+				Config:      testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(ri, location, clientId, clientSecret, "1.16.0", "1.14.6"),
+				ExpectError: regexp.MustCompile("can only be upgraded one minor version at a time"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesCluster_upgradeControlPlaneOnly(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_upgradeControlPlaneOnly(ri, location, clientId, clientSecret, "1.13.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_version", "1.13.10"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.orchestrator_version", ""),
+					testCheckAzureRMKubernetesClusterNodePoolVersion(resourceName, "default", "1.13.10"),
+				),
+			},
+			{
+				// the Node Pool doesn't specify an `orchestrator_version`, so should be upgraded alongside the Control Plane
+				Config: testAccAzureRMKubernetesCluster_upgradeControlPlaneOnly(ri, location, clientId, clientSecret, "1.14.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_version", "1.14.6"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.orchestrator_version", ""),
+					testCheckAzureRMKubernetesClusterNodePoolVersion(resourceName, "default", "1.14.6"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeferKubernetesClusterAgentPoolUpgrades(t *testing.T) {
+	existing := []containerservice.ManagedClusterAgentPoolProfile{
+		{
+			Name:                utils.String("following"),
+			Type:                containerservice.VirtualMachineScaleSets,
+			OrchestratorVersion: utils.String("1.13.10"),
+		},
+		{
+			Name:                utils.String("pinned"),
+			Type:                containerservice.VirtualMachineScaleSets,
+			OrchestratorVersion: utils.String("1.13.10"),
+		},
+		{
+			Name:                utils.String("upgraded"),
+			Type:                containerservice.VirtualMachineScaleSets,
+			OrchestratorVersion: utils.String("1.13.10"),
+		},
+		{
+			Name:                utils.String("availabilityset"),
+			Type:                containerservice.AvailabilitySet,
+			OrchestratorVersion: utils.String("1.13.10"),
+		},
+	}
+
+	profiles := []containerservice.ManagedClusterAgentPoolProfile{
+		{
+			// no `orchestrator_version` is specified, so this should follow the Control Plane
+			Name: utils.String("following"),
+			Type: containerservice.VirtualMachineScaleSets,
+		},
+		{
+			Name:                utils.String("pinned"),
+			Type:                containerservice.VirtualMachineScaleSets,
+			OrchestratorVersion: utils.String("1.13.10"),
+		},
+		{
+			Name:                utils.String("upgraded"),
+			Type:                containerservice.VirtualMachineScaleSets,
+			OrchestratorVersion: utils.String("1.14.6"),
+		},
+		{
+			// upgraded alongside the Control Plane by the API
+			Name: utils.String("availabilityset"),
+			Type: containerservice.AvailabilitySet,
+		},
+		{
+			// a new Node Pool, which is created at the specified version
+			Name: utils.String("new"),
+			Type: containerservice.VirtualMachineScaleSets,
+		},
+	}
+
+	// only the Control Plane version has been bumped
+	upgrades := deferKubernetesClusterAgentPoolUpgrades(profiles, &existing, "1.14.6")
+
+	expected := map[string]string{
+		"following": "1.14.6",
+		"upgraded":  "1.14.6",
+	}
+	if !reflect.DeepEqual(upgrades, expected) {
+		t.Fatalf("Expected the upgrades to be %+v but got %+v", expected, upgrades)
+	}
+
+	// the existing Node Pools should remain on their current version until the Control Plane has been upgraded
+	for _, profile := range profiles[0:3] {
+		if profile.OrchestratorVersion == nil || *profile.OrchestratorVersion != "1.13.10" {
+			t.Fatalf("Expected Node Pool %q to remain at %q until the Control Plane was upgraded but got %v", *profile.Name, "1.13.10", profile.OrchestratorVersion)
+		}
+	}
+	if profiles[3].OrchestratorVersion != nil || profiles[4].OrchestratorVersion != nil {
+		t.Fatalf("Expected no version to be set for Node Pools which aren't upgraded separately")
+	}
+}
+
 func TestAccAzureRMKubernetesCluster_internalNetwork(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := tf.AccRandTimeInt()
@@ -882,6 +1130,32 @@ func testCheckAzureRMKubernetesClusterExists(resourceName string) resource.TestC
 	}
 }
 
+func testCheckAzureRMKubernetesClusterNodePoolVersion(resourceName string, nodePoolName string, expectedVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).containers.AgentPoolsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		pool, err := client.Get(ctx, resourceGroup, name, nodePoolName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on agentPoolsClient: %+v", err)
+		}
+
+		if props := pool.ManagedClusterAgentPoolProfileProperties; props == nil || props.OrchestratorVersion == nil || *props.OrchestratorVersion != expectedVersion {
+			return fmt.Errorf("Bad: expected Node Pool %q (Managed Kubernetes Cluster %q / Resource Group %q) to be running %q", nodePoolName, name, resourceGroup, expectedVersion)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMKubernetesClusterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).containers.KubernetesClustersClient
 
@@ -935,6 +1209,33 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, clientId, clientSecret)
 }
 
+func testAccAzureRMKubernetesCluster_managedIdentity(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    type    = "VirtualMachineScaleSets"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMKubernetesCluster_requiresImport(rInt int, clientId, clientSecret, location string) string {
 	template := testAccAzureRMKubernetesCluster_basic(rInt, clientId, clientSecret, location)
 	return fmt.Sprintf(`
@@ -1173,6 +1474,75 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, tenantId, rInt, location, rInt, rInt, rInt, clientId, clientSecret, clientId, clientSecret, clientId)
 }
 
+func testAccAzureRMKubernetesCluster_roleBasedAccessControlAADManaged(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  role_based_access_control {
+    enabled = true
+
+    azure_active_directory {
+      managed = true
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMKubernetesCluster_roleBasedAccessControlAADManagedWithServerApp(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  role_based_access_control {
+    enabled = true
+
+    azure_active_directory {
+      managed       = true
+      server_app_id = "00000000-0000-0000-0000-000000000000"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMKubernetesCluster_internalNetwork(rInt int, clientId string, clientSecret string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1448,6 +1818,65 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, version, rInt, clientId, clientSecret)
 }
 
+func testAccAzureRMKubernetesCluster_upgradeNodePoolVersion(rInt int, location, clientId, clientSecret, version, nodePoolVersion string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = "%s"
+
+  agent_pool_profile {
+    name                 = "default"
+    count                = "1"
+    type                 = "VirtualMachineScaleSets"
+    vm_size              = "Standard_DS2_v2"
+    orchestrator_version = "%s"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, version, nodePoolVersion, clientId, clientSecret)
+}
+
+func testAccAzureRMKubernetesCluster_upgradeControlPlaneOnly(rInt int, location, clientId, clientSecret, version string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = "%s"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    type    = "VirtualMachineScaleSets"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, version, clientId, clientSecret)
+}
+
 func testAccAzureRMKubernetesCluster_advancedNetworking(rInt int, clientId string, clientSecret string, location string, networkPlugin string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
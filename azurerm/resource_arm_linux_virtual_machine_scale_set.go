@@ -0,0 +1,684 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachineScaleSet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceArmLinuxVirtualMachineScaleSetCreate,
+		Read:          resourceArmLinuxVirtualMachineScaleSetRead,
+		Update:        resourceArmLinuxVirtualMachineScaleSetUpdate,
+		Delete:        resourceArmLinuxVirtualMachineScaleSetDelete,
+		Importer:      resourceid.ValidatingImporter(resourceid.ValidateVirtualMachineScaleSetID),
+		CustomizeDiff: virtualMachineScaleSetCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LinuxAdminUsername,
+			},
+
+			"capacity": virtualMachineScaleSetCapacitySchema(),
+
+			"network_interface": virtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": virtualMachineScaleSetOSDiskSchema(),
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// Optional
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.LinuxAdminPassword,
+			},
+
+			"admin_ssh_key": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.LinuxAdminUsername,
+						},
+					},
+				},
+			},
+
+			"automatic_instance_repair": virtualMachineScaleSetAutomaticRepairsPolicySchema(),
+
+			"automatic_os_upgrade_policy": virtualMachineScaleSetAutomaticOSUpgradePolicySchema(),
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// whilst the `name` field can be any length, the `computer_name_prefix` has a shorter limit,
+				// which is validated against when `computer_name_prefix` isn't set in Create
+				ValidateFunc: validate.LinuxComputerNamePrefix,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"data_disk": virtualMachineScaleSetDataDiskSchema(),
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": virtualMachineScaleSetEvictionPolicySchema(),
+
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"host_group_id": dedicatedHostGroupIDSchema(),
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"ignore_capacity_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_bid_price": virtualMachineScaleSetMaxBidPriceSchema(),
+
+			"overprovision": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"plan": virtualMachinePlanSchema(),
+
+			"priority": virtualMachineScaleSetPrioritySchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"proximity_placement_group_id": proximityPlacementGroupIDSchema(),
+
+			"rolling_upgrade_policy": virtualMachineScaleSetRollingUpgradePolicySchema(),
+
+			"scale_in_policy": virtualMachineScaleSetScaleInPolicySchema(),
+
+			"secret": virtualMachineSecretSchema(false),
+
+			"single_placement_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"source_image_id": virtualMachineScaleSetSourceImageIDSchema(),
+
+			"source_image_reference": virtualMachineScaleSetSourceImageReferenceSchema(),
+
+			"tags": tagsSchema(),
+
+			"terminate_notification": virtualMachineScaleSetTerminateNotificationSchema(),
+
+			"upgrade_mode": virtualMachineScaleSetUpgradeModeSchema(),
+
+			"zone_balance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaZones(),
+
+			// Computed
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existing Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine_scale_set", *existing.ID)
+		}
+	}
+
+	computerNamePrefix := d.Get("computer_name_prefix").(string)
+	if computerNamePrefix == "" {
+		if _, errs := validate.LinuxComputerNamePrefix(name, "computer_name_prefix"); len(errs) > 0 {
+			return fmt.Errorf("Unable to assume default computer name prefix %s. Please adjust the `name`, or specify an explicit `computer_name_prefix`", errs[0])
+		}
+		computerNamePrefix = name
+	}
+
+	adminUsername := d.Get("admin_username").(string)
+	adminPassword := d.Get("admin_password").(string)
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	sshKeys := expandLinuxVirtualMachineSSHKeys(d.Get("admin_ssh_key").(*schema.Set).List())
+
+	if disablePasswordAuthentication {
+		if len(sshKeys) == 0 {
+			return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
+		}
+
+		if adminPassword != "" {
+			return fmt.Errorf("An `admin_password` cannot be specified when `disable_password_authentication` is set to `true`")
+		}
+	} else if adminPassword == "" {
+		return fmt.Errorf("An `admin_password` must be specified when `disable_password_authentication` is set to `false`")
+	}
+
+	imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	networkInterfaces, err := expandVirtualMachineScaleSetNetworkInterfaces(d.Get("network_interface").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `network_interface`: %+v", err)
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	networkProfile := &compute.VirtualMachineScaleSetNetworkProfile{
+		NetworkInterfaceConfigurations: networkInterfaces,
+	}
+	if healthProbeId := d.Get("health_probe_id").(string); healthProbeId != "" {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(healthProbeId),
+		}
+	}
+
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+
+	params := compute.VirtualMachineScaleSet{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Plan:     expandVirtualMachinePlan(d.Get("plan").([]interface{})),
+		Sku: &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("capacity").(int))),
+
+			// whilst this looks like it's supported in the API, it's a legacy value which is always `Standard`
+			Tier: utils.String("Standard"),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			Overprovision:           utils.Bool(d.Get("overprovision").(bool)),
+			ProximityPlacementGroup: expandProximityPlacementGroupID(d.Get("proximity_placement_group_id").(string)),
+			SinglePlacementGroup:    utils.Bool(d.Get("single_placement_group").(bool)),
+			UpgradePolicy:           expandVirtualMachineScaleSetUpgradePolicy(d),
+			AutomaticRepairsPolicy:  expandVirtualMachineScaleSetAutomaticRepairsPolicy(d.Get("automatic_instance_repair").([]interface{})),
+			HostGroup:               expandDedicatedHostGroupID(d.Get("host_group_id").(string)),
+			ScaleInPolicy:           expandVirtualMachineScaleSetScaleInPolicy(d.Get("scale_in_policy").(string)),
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				BillingProfile:     expandVirtualMachineBillingProfile(priority, d.Get("max_bid_price").(float64)),
+				DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+				NetworkProfile:     networkProfile,
+				Priority:           priority,
+				OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+					AdminUsername:      utils.String(adminUsername),
+					ComputerNamePrefix: utils.String(computerNamePrefix),
+					LinuxConfiguration: &compute.LinuxConfiguration{
+						DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+						ProvisionVMAgent:              utils.Bool(d.Get("provision_vm_agent").(bool)),
+						SSH: &compute.SSHConfiguration{
+							PublicKeys: &sshKeys,
+						},
+					},
+					Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{}), false),
+				},
+				StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+					ImageReference: imageReference,
+					OsDisk:         expandVirtualMachineScaleSetOSDisk(d.Get("os_disk").([]interface{}), compute.Linux),
+					DataDisks:      expandVirtualMachineScaleSetDataDisks(d.Get("data_disk").([]interface{})),
+				},
+			},
+		},
+		Tags:  expandTagsWithDefaults(meta, t),
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+	}
+
+	if adminPassword != "" {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.OsProfile.AdminPassword = utils.String(adminPassword)
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.OsProfile.CustomData = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("eviction_policy"); ok {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(v.(string))
+	}
+
+	if identityRaw := d.Get("identity").([]interface{}); len(identityRaw) > 0 {
+		identity, err := expandVirtualMachineScaleSetIdentity(identityRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+		params.Identity = identity
+	}
+
+	if d.Get("zone_balance").(bool) {
+		if params.Zones == nil || len(*params.Zones) == 0 {
+			return fmt.Errorf("`zone_balance` can only be set to `true` when Availability Zones are specified")
+		}
+
+		params.VirtualMachineScaleSetProperties.ZoneBalance = utils.Bool(true)
+	}
+
+	if raw := d.Get("terminate_notification").([]interface{}); len(raw) > 0 {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.ScheduledEventsProfile = expandVirtualMachineScaleSetScheduledEventsProfile(raw)
+	}
+
+	log.Printf("[DEBUG] Creating Linux Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Linux Virtual Machine Scale Set %q (Resource Group %q) was created", name, resourceGroup)
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+	return resourceArmLinuxVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+
+		capacity := 0
+		if sku.Capacity != nil {
+			capacity = int(*sku.Capacity)
+		}
+		d.Set("capacity", capacity)
+	}
+
+	if err := d.Set("identity", flattenVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	if err := d.Set("zones", resp.Zones); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+
+	props := resp.VirtualMachineScaleSetProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	d.Set("overprovision", props.Overprovision)
+	d.Set("proximity_placement_group_id", flattenProximityPlacementGroupID(props.ProximityPlacementGroup))
+	d.Set("single_placement_group", props.SinglePlacementGroup)
+	d.Set("unique_id", props.UniqueID)
+	d.Set("zone_balance", props.ZoneBalance)
+	d.Set("scale_in_policy", flattenVirtualMachineScaleSetScaleInPolicy(props.ScaleInPolicy))
+
+	if err := d.Set("automatic_instance_repair", flattenVirtualMachineScaleSetAutomaticRepairsPolicy(props.AutomaticRepairsPolicy)); err != nil {
+		return fmt.Errorf("Error setting `automatic_instance_repair`: %+v", err)
+	}
+
+	if err := setVirtualMachineScaleSetUpgradePolicy(d, props.UpgradePolicy); err != nil {
+		return err
+	}
+
+	d.Set("host_group_id", flattenDedicatedHostGroupID(props.HostGroup))
+
+	if profile := props.VirtualMachineProfile; profile != nil {
+		if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		d.Set("eviction_policy", string(profile.EvictionPolicy))
+		d.Set("max_bid_price", flattenVirtualMachineBillingProfile(profile.BillingProfile))
+		d.Set("priority", flattenVirtualMachineScaleSetPriority(profile.Priority))
+
+		if err := d.Set("terminate_notification", flattenVirtualMachineScaleSetScheduledEventsProfile(profile.ScheduledEventsProfile)); err != nil {
+			return fmt.Errorf("Error setting `terminate_notification`: %+v", err)
+		}
+
+		// the Custom Data isn't returned from the API, so the value in the config is kept
+
+		if network := profile.NetworkProfile; network != nil {
+			healthProbeId := ""
+			if network.HealthProbe != nil && network.HealthProbe.ID != nil {
+				healthProbeId = *network.HealthProbe.ID
+			}
+			d.Set("health_probe_id", healthProbeId)
+
+			if err := d.Set("network_interface", flattenVirtualMachineScaleSetNetworkInterfaces(network.NetworkInterfaceConfigurations)); err != nil {
+				return fmt.Errorf("Error setting `network_interface`: %+v", err)
+			}
+		}
+
+		if osProfile := profile.OsProfile; osProfile != nil {
+			d.Set("admin_username", osProfile.AdminUsername)
+			d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
+
+			if config := osProfile.LinuxConfiguration; config != nil {
+				d.Set("disable_password_authentication", config.DisablePasswordAuthentication)
+				d.Set("provision_vm_agent", config.ProvisionVMAgent)
+
+				if err := d.Set("admin_ssh_key", flattenLinuxVirtualMachineSSHKeys(config.SSH)); err != nil {
+					return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenVirtualMachineSecrets(osProfile.Secrets, false)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		if storageProfile := profile.StorageProfile; storageProfile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			if err := d.Set("data_disk", flattenVirtualMachineScaleSetDataDisks(storageProfile.DataDisks)); err != nil {
+				return fmt.Errorf("Error setting `data_disk`: %+v", err)
+			}
+
+			sourceImageId := ""
+			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+				sourceImageId = *storageProfile.ImageReference.ID
+			}
+			d.Set("source_image_id", sourceImageId)
+
+			if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(storageProfile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmLinuxVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := compute.VirtualMachineScaleSetUpdate{
+		VirtualMachineScaleSetUpdateProperties: &compute.VirtualMachineScaleSetUpdateProperties{},
+	}
+
+	// changes to the Virtual Machine Profile need rolling out to the existing instances
+	profileChanged := false
+	profile := compute.VirtualMachineScaleSetUpdateVMProfile{}
+
+	if d.HasChange("boot_diagnostics") {
+		profileChanged = true
+		profile.DiagnosticsProfile = expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+	}
+
+	if d.HasChange("custom_data") || d.HasChange("secret") {
+		profileChanged = true
+		profile.OsProfile = &compute.VirtualMachineScaleSetUpdateOSProfile{
+			Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{}), false),
+		}
+
+		if v := d.Get("custom_data").(string); v != "" {
+			profile.OsProfile.CustomData = utils.String(v)
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		profileChanged = true
+		networkInterfaces, err := expandVirtualMachineScaleSetNetworkInterfacesUpdate(d.Get("network_interface").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding `network_interface`: %+v", err)
+		}
+
+		profile.NetworkProfile = &compute.VirtualMachineScaleSetUpdateNetworkProfile{
+			NetworkInterfaceConfigurations: networkInterfaces,
+		}
+	}
+
+	if d.HasChange("data_disk") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+		profileChanged = true
+		storageProfile := &compute.VirtualMachineScaleSetUpdateStorageProfile{}
+
+		if d.HasChange("data_disk") {
+			storageProfile.DataDisks = expandVirtualMachineScaleSetDataDisks(d.Get("data_disk").([]interface{}))
+		}
+
+		if d.HasChange("os_disk") {
+			storageProfile.OsDisk = expandVirtualMachineScaleSetOSDiskUpdate(d.Get("os_disk").([]interface{}))
+		}
+
+		if d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+			imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+			if err != nil {
+				return err
+			}
+
+			storageProfile.ImageReference = imageReference
+		}
+
+		profile.StorageProfile = storageProfile
+	}
+
+	if d.HasChange("terminate_notification") {
+		profileChanged = true
+		profile.ScheduledEventsProfile = expandVirtualMachineScaleSetScheduledEventsProfile(d.Get("terminate_notification").([]interface{}))
+	}
+
+	if profileChanged {
+		update.VirtualMachineScaleSetUpdateProperties.VirtualMachineProfile = &profile
+	}
+
+	if d.HasChange("automatic_os_upgrade_policy") || d.HasChange("rolling_upgrade_policy") || d.HasChange("upgrade_mode") {
+		update.VirtualMachineScaleSetUpdateProperties.UpgradePolicy = expandVirtualMachineScaleSetUpgradePolicy(d)
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+		update.Identity = identity
+	}
+
+	if d.HasChange("automatic_instance_repair") {
+		update.VirtualMachineScaleSetUpdateProperties.AutomaticRepairsPolicy = expandVirtualMachineScaleSetAutomaticRepairsPolicy(d.Get("automatic_instance_repair").([]interface{}))
+	}
+
+	if d.HasChange("overprovision") {
+		update.VirtualMachineScaleSetUpdateProperties.Overprovision = utils.Bool(d.Get("overprovision").(bool))
+	}
+
+	if d.HasChange("plan") {
+		update.Plan = expandVirtualMachinePlan(d.Get("plan").([]interface{}))
+	}
+
+	if d.HasChange("scale_in_policy") {
+		update.VirtualMachineScaleSetUpdateProperties.ScaleInPolicy = expandVirtualMachineScaleSetScaleInPolicy(d.Get("scale_in_policy").(string))
+	}
+
+	if d.HasChange("single_placement_group") {
+		update.VirtualMachineScaleSetUpdateProperties.SinglePlacementGroup = utils.Bool(d.Get("single_placement_group").(bool))
+	}
+
+	if d.HasChange("sku") || d.HasChange("capacity") {
+		// when `ignore_capacity_changes` is enabled the diff for `capacity` is suppressed,
+		// meaning this is the current capacity of the Scale Set rather than the value in the config
+		update.Sku = &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("capacity").(int))),
+			Tier:     utils.String("Standard"),
+		}
+
+		// changing the size of the instances requires they're upgraded
+		if d.HasChange("sku") {
+			profileChanged = true
+		}
+	}
+
+	if hasTagsChange(d) {
+		t := d.Get("tags").(map[string]interface{})
+		update.Tags = expandTagsWithDefaults(meta, t)
+	}
+
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	if err := updateVirtualMachineScaleSet(ctx, meta, id.ResourceGroup, id.Name, update, upgradeMode, profileChanged); err != nil {
+		return err
+	}
+
+	return resourceArmLinuxVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Linux Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Linux Virtual Machine Scale Set %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return nil
+}
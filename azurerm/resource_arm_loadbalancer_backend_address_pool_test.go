@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMLoadBalancerBackEndAddressPool_basic(t *testing.T) {
@@ -162,7 +163,7 @@ func testCheckAzureRMLoadBalancerBackEndAddressPoolDisappears(addressPoolName st
 		pools := append(currentPools[:i], currentPools[i+1:]...)
 		lb.LoadBalancerPropertiesFormat.BackendAddressPools = &pools
 
-		id, err := parseAzureResourceID(*lb.ID)
+		id, err := resourceid.ParseLoadBalancerID(*lb.ID)
 		if err != nil {
 			return err
 		}
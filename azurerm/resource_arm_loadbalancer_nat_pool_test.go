@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMLoadBalancerNatPool_basic(t *testing.T) {
@@ -204,7 +205,7 @@ func testCheckAzureRMLoadBalancerNatPoolDisappears(natPoolName string, lb *netwo
 		pools := append(currentPools[:i], currentPools[i+1:]...)
 		lb.LoadBalancerPropertiesFormat.InboundNatPools = &pools
 
-		id, err := parseAzureResourceID(*lb.ID)
+		id, err := resourceid.ParseLoadBalancerID(*lb.ID)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMLoadBalancerNatRule_basic(t *testing.T) {
@@ -264,7 +265,7 @@ func testCheckAzureRMLoadBalancerNatRuleDisappears(natRuleName string, lb *netwo
 		rules := append(currentRules[:i], currentRules[i+1:]...)
 		lb.LoadBalancerPropertiesFormat.InboundNatRules = &rules
 
-		id, err := parseAzureResourceID(*lb.ID)
+		id, err := resourceid.ParseLoadBalancerID(*lb.ID)
 		if err != nil {
 			return err
 		}
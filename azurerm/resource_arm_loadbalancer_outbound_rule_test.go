@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
@@ -237,7 +238,7 @@ func testCheckAzureRMLoadBalancerOutboundRuleDisappears(ruleName string, lb *net
 		rules := append(currentRules[:i], currentRules[i+1:]...)
 		lb.LoadBalancerPropertiesFormat.OutboundRules = &rules
 
-		id, err := parseAzureResourceID(*lb.ID)
+		id, err := resourceid.ParseLoadBalancerID(*lb.ID)
 		if err != nil {
 			return err
 		}
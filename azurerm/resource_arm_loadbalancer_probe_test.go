@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMLoadBalancerProbe_basic(t *testing.T) {
@@ -235,7 +236,7 @@ func testCheckAzureRMLoadBalancerProbeDisappears(addressPoolName string, lb *net
 		probes := append(currentProbes[:i], currentProbes[i+1:]...)
 		lb.LoadBalancerPropertiesFormat.Probes = &probes
 
-		id, err := parseAzureResourceID(*lb.ID)
+		id, err := resourceid.ParseLoadBalancerID(*lb.ID)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -254,11 +255,11 @@ func testCheckAzureRMLocalNetworkGatewayExists(resourceName string) resource.Tes
 		}
 
 		// then, extract the name and the resource group:
-		id, err := parseAzureResourceID(res.Primary.ID)
+		id, err := resourceid.ParseLocalNetworkGatewayID(res.Primary.ID)
 		if err != nil {
 			return err
 		}
-		localNetName := id.Path["localNetworkGateways"]
+		localNetName := id.Name
 		resGrp := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
@@ -287,11 +288,11 @@ func testCheckAzureRMLocalNetworkGatewayDisappears(resourceName string) resource
 		}
 
 		// then, extract the name and the resource group:
-		id, err := parseAzureResourceID(res.Primary.ID)
+		id, err := resourceid.ParseLocalNetworkGatewayID(res.Primary.ID)
 		if err != nil {
 			return err
 		}
-		localNetName := id.Path["localNetworkGateways"]
+		localNetName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
@@ -320,11 +321,11 @@ func testCheckAzureRMLocalNetworkGatewayDestroy(s *terraform.State) error {
 			continue
 		}
 
-		id, err := parseAzureResourceID(res.Primary.ID)
+		id, err := resourceid.ParseLocalNetworkGatewayID(res.Primary.ID)
 		if err != nil {
 			return err
 		}
-		localNetName := id.Path["localNetworkGateways"]
+		localNetName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).localNetConnClient
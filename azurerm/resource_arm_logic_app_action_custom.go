@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"encoding/json"
 
@@ -10,16 +11,23 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppActionCustom() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppActionCustomCreateUpdate,
-		Read:   resourceArmLogicAppActionCustomRead,
-		Update: resourceArmLogicAppActionCustomCreateUpdate,
-		Delete: resourceArmLogicAppActionCustomDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppActionCustomCreateUpdate,
+		Read:     resourceArmLogicAppActionCustomRead,
+		Update:   resourceArmLogicAppActionCustomCreateUpdate,
+		Delete:   resourceArmLogicAppActionCustomDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppActionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,16 +72,19 @@ func resourceArmLogicAppActionCustomCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseLogicAppActionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppAction(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppAction(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -102,14 +113,14 @@ func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmLogicAppActionCustomDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppActionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppActionRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -4,22 +4,30 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppActionHTTP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppActionHTTPCreateUpdate,
-		Read:   resourceArmLogicAppActionHTTPRead,
-		Update: resourceArmLogicAppActionHTTPCreateUpdate,
-		Delete: resourceArmLogicAppActionHTTPDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppActionHTTPCreateUpdate,
+		Read:     resourceArmLogicAppActionHTTPRead,
+		Update:   resourceArmLogicAppActionHTTPCreateUpdate,
+		Delete:   resourceArmLogicAppActionHTTPDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppActionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -99,16 +107,19 @@ func resourceArmLogicAppActionHTTPCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmLogicAppActionHTTPRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseLogicAppActionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppAction(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppAction(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -162,14 +173,14 @@ func resourceArmLogicAppActionHTTPRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLogicAppActionHTTPDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppActionID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppActionRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
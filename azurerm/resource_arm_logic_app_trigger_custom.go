@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"encoding/json"
 
@@ -10,16 +11,23 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppTriggerCustom() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Read:   resourceArmLogicAppTriggerCustomRead,
-		Update: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Delete: resourceArmLogicAppTriggerCustomDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Read:     resourceArmLogicAppTriggerCustomRead,
+		Update:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerCustomDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppTriggerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,16 +72,19 @@ func resourceArmLogicAppTriggerCustomCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -102,14 +113,14 @@ func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmLogicAppTriggerCustomDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
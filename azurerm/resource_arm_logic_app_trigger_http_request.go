@@ -6,22 +6,23 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Read:   resourceArmLogicAppTriggerHttpRequestRead,
-		Update: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Delete: resourceArmLogicAppTriggerHttpRequestDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Read:     resourceArmLogicAppTriggerHttpRequestRead,
+		Update:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerHttpRequestDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppTriggerID),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 
@@ -36,6 +37,13 @@ func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -113,16 +121,19 @@ func resourceArmLogicAppTriggerHttpRequestCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -169,14 +180,14 @@ func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta inte
 }
 
 func resourceArmLogicAppTriggerHttpRequestDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
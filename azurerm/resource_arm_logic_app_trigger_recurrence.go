@@ -3,20 +3,28 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
-		Read:   resourceArmLogicAppTriggerRecurrenceRead,
-		Update: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
-		Delete: resourceArmLogicAppTriggerRecurrenceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Read:     resourceArmLogicAppTriggerRecurrenceRead,
+		Update:   resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerRecurrenceDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppTriggerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,16 +82,19 @@ func resourceArmLogicAppTriggerRecurrenceCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -121,14 +132,14 @@ func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta inter
 }
 
 func resourceArmLogicAppTriggerRecurrenceDelete(d *schema.ResourceData, meta interface{}) error {
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppTriggerID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName := id.WorkflowName
+	name := id.Name
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,12 +18,17 @@ var logicAppResourceName = "azurerm_logic_app"
 
 func resourceArmLogicAppWorkflow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppWorkflowCreate,
-		Read:   resourceArmLogicAppWorkflowRead,
-		Update: resourceArmLogicAppWorkflowUpdate,
-		Delete: resourceArmLogicAppWorkflowDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogicAppWorkflowCreate,
+		Read:     resourceArmLogicAppWorkflowRead,
+		Update:   resourceArmLogicAppWorkflowUpdate,
+		Delete:   resourceArmLogicAppWorkflowDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateLogicAppWorkflowID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +74,8 @@ func resourceArmLogicAppWorkflow() *schema.Resource {
 
 func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Logic App Workflow creation.")
 
@@ -104,7 +113,7 @@ func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{})
 			},
 			Parameters: parameters,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties); err != nil {
@@ -126,15 +135,16 @@ func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppWorkflowID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["workflows"]
+	name := id.Name
 
 	// lock to prevent against Actions, Parameters or Triggers conflicting
 	azureRMLockByName(name, logicAppResourceName)
@@ -164,7 +174,7 @@ func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{})
 			Definition: read.WorkflowProperties.Definition,
 			Parameters: parameters,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, properties); err != nil {
@@ -176,14 +186,15 @@ func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppWorkflowID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["workflows"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -225,14 +236,15 @@ func resourceArmLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).logic.WorkflowsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseLogicAppWorkflowID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["workflows"]
+	name := id.Name
 
 	// lock to prevent against Actions, Parameters or Triggers conflicting
 	azureRMLockByName(name, logicAppResourceName)
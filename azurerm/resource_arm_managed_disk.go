@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,8 +25,13 @@ func resourceArmManagedDisk() *schema.Resource {
 		Update: resourceArmManagedDiskCreateUpdate,
 		Delete: resourceArmManagedDiskDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateManagedDiskID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -99,6 +107,8 @@ func resourceArmManagedDisk() *schema.Resource {
 				ValidateFunc: validateDiskSizeGB,
 			},
 
+			"disk_encryption_set_id": diskEncryptionSetIDSchema(),
+
 			"encryption_settings": encryptionSettingsSchema(),
 
 			"tags": tagsSchema(),
@@ -117,7 +127,8 @@ func validateDiskSizeGB(v interface{}, _ string) (warnings []string, errors []er
 
 func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
 
@@ -141,7 +152,7 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 	storageAccountType := d.Get("storage_account_type").(string)
 	osType := d.Get("os_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 	zones := azure.ExpandZones(d.Get("zones").([]interface{}))
 
 	var skuName compute.DiskStorageAccountTypes
@@ -159,7 +170,8 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 		Name:     &name,
 		Location: &location,
 		DiskProperties: &compute.DiskProperties{
-			OsType: compute.OperatingSystemTypes(osType),
+			Encryption: expandDiskEncryption(d.Get("disk_encryption_set_id").(string)),
+			OsType:     compute.OperatingSystemTypes(osType),
 		},
 		Sku: &compute.DiskSku{
 			Name: skuName,
@@ -203,7 +215,7 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("encryption_settings"); ok {
 		encryptionSettings := v.([]interface{})
 		settings := encryptionSettings[0].(map[string]interface{})
-		createDisk.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
+		createDisk.EncryptionSettingsCollection = expandManagedDiskEncryptionSettings(settings)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, createDisk)
@@ -230,14 +242,15 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseManagedDiskID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -267,13 +280,14 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 		if osType := props.OsType; osType != "" {
 			d.Set("os_type", string(osType))
 		}
+		d.Set("disk_encryption_set_id", flattenDiskEncryption(props.Encryption))
 	}
 
 	if resp.CreationData != nil {
 		flattenAzureRmManagedDiskCreationData(d, resp.CreationData)
 	}
 
-	if settings := resp.EncryptionSettings; settings != nil {
+	if settings := resp.EncryptionSettingsCollection; settings != nil {
 		flattened := flattenManagedDiskEncryptionSettings(settings)
 		if err := d.Set("encryption_settings", flattened); err != nil {
 			return fmt.Errorf("Error setting encryption settings: %+v", err)
@@ -287,14 +301,15 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseManagedDiskID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
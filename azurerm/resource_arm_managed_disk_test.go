@@ -5,7 +5,7 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -224,6 +224,32 @@ func TestAccAzureRMManagedDisk_encryption(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagedDisk_diskEncryptionSet(t *testing.T) {
+	resourceName := "azurerm_managed_disk.test"
+	rs := acctest.RandString(4)
+	var d compute.Disk
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDisk_diskEncryptionSet(rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_encryption_set_id", "azurerm_disk_encryption_set.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagedDisk_NonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_managed_disk.test"
 	ri := tf.AccRandTimeInt()
@@ -700,3 +726,22 @@ resource "azurerm_managed_disk" "test" {
 }
 `, rInt, location, rString, rString, rString, rInt)
 }
+
+func testAccAzureRMManagedDisk_diskEncryptionSet(rString string, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                   = "acctestd-%s"
+  location               = "${azurerm_resource_group.test.location}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_type   = "Standard_LRS"
+  create_option          = "Empty"
+  disk_size_gb           = "1"
+  disk_encryption_set_id = "${azurerm_disk_encryption_set.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.disk-encryption"]
+}
+`, template, rString)
+}
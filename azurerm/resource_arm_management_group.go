@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,12 +21,17 @@ var managementGroupCacheControl = "no-cache"
 
 func resourceArmManagementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmManagementGroupCreateUpdate,
-		Update: resourceArmManagementGroupCreateUpdate,
-		Read:   resourceArmManagementGroupRead,
-		Delete: resourceArmManagementGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmManagementGroupCreateUpdate,
+		Update:   resourceArmManagementGroupCreateUpdate,
+		Read:     resourceArmManagementGroupRead,
+		Delete:   resourceArmManagementGroupDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Management/managementGroups")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,7 +67,8 @@ func resourceArmManagementGroup() *schema.Resource {
 func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroups.GroupsClient
 	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	armTenantID := meta.(*ArmClient).tenantId
 
 	groupId := d.Get("group_id").(string)
@@ -157,7 +167,8 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroups.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
@@ -205,7 +216,8 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroups.GroupsClient
 	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
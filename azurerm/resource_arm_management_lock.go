@@ -5,21 +5,29 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmManagementLock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmManagementLockCreateUpdate,
-		Read:   resourceArmManagementLockRead,
-		Delete: resourceArmManagementLockDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmManagementLockCreateUpdate,
+		Read:     resourceArmManagementLockRead,
+		Delete:   resourceArmManagementLockDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Authorization/locks")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,7 +66,8 @@ func resourceArmManagementLock() *schema.Resource {
 
 func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
 
 	name := d.Get("name").(string)
@@ -106,7 +115,8 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -135,7 +145,8 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
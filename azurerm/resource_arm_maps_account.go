@@ -3,24 +3,32 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/maps/mgmt/2018-05-01/maps"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	mapsint "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMapsAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMapsAccountCreateUpdate,
-		Read:   resourceArmMapsAccountRead,
-		Update: resourceArmMapsAccountCreateUpdate,
-		Delete: resourceArmMapsAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMapsAccountCreateUpdate,
+		Read:     resourceArmMapsAccountRead,
+		Update:   resourceArmMapsAccountCreateUpdate,
+		Delete:   resourceArmMapsAccountDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMapsAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +75,8 @@ func resourceArmMapsAccount() *schema.Resource {
 
 func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Maps Account creation.")
 
@@ -94,7 +103,7 @@ func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}
 		Sku: &maps.Sku{
 			Name: &sku,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
@@ -117,14 +126,15 @@ func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMapsAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -159,14 +169,15 @@ func resourceArmMapsAccountRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmMapsAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMapsAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name := id.Name
 
 	if _, err := client.Delete(ctx, resGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
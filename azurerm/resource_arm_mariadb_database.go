@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,16 +12,22 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMariaDbDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMariaDbDatabaseCreateUpdate,
-		Read:   resourceArmMariaDbDatabaseRead,
-		Delete: resourceArmMariaDbDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMariaDbDatabaseCreateUpdate,
+		Read:     resourceArmMariaDbDatabaseRead,
+		Delete:   resourceArmMariaDbDatabaseDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMariaDBDatabaseID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,7 +75,8 @@ func resourceArmMariaDbDatabase() *schema.Resource {
 
 func resourceArmMariaDbDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MariaDB database creation")
 
@@ -123,15 +131,16 @@ func resourceArmMariaDbDatabaseCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmMariaDbDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBDatabaseID(d.Id())
 	if err != nil {
 		return fmt.Errorf("cannot parse MariaDB database %q ID:\n%+v", d.Id(), err)
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName := id.ServerName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -158,16 +167,17 @@ func resourceArmMariaDbDatabaseRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmMariaDbDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBDatabaseID(d.Id())
 	if err != nil {
 		return fmt.Errorf("cannot parse MariaDB database %q ID:\n%+v", d.Id(), err)
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName := id.ServerName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMariaDBFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMariaDBFirewallRuleCreateUpdate,
-		Read:   resourceArmMariaDBFirewallRuleRead,
-		Update: resourceArmMariaDBFirewallRuleCreateUpdate,
-		Delete: resourceArmMariaDBFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMariaDBFirewallRuleCreateUpdate,
+		Read:     resourceArmMariaDBFirewallRuleRead,
+		Update:   resourceArmMariaDBFirewallRuleCreateUpdate,
+		Delete:   resourceArmMariaDBFirewallRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMariaDBFirewallRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,7 +64,8 @@ func resourceArmMariaDBFirewallRule() *schema.Resource {
 
 func resourceArmMariaDBFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MariaDB Firewall Rule creation.")
 
@@ -110,15 +119,16 @@ func resourceArmMariaDBFirewallRuleCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmMariaDBFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["firewallRules"]
+	serverName := id.ServerName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -140,15 +150,16 @@ func resourceArmMariaDBFirewallRuleRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmMariaDBFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["firewallRules"]
+	serverName := id.ServerName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -15,17 +16,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMariaDbServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMariaDbServerCreateUpdate,
-		Read:   resourceArmMariaDbServerRead,
-		Update: resourceArmMariaDbServerCreateUpdate,
-		Delete: resourceArmMariaDbServerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMariaDbServerCreateUpdate,
+		Read:     resourceArmMariaDbServerRead,
+		Update:   resourceArmMariaDbServerCreateUpdate,
+		Delete:   resourceArmMariaDbServerDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMariaDBServerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -175,7 +183,8 @@ func resourceArmMariaDbServer() *schema.Resource {
 
 func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MariaDB Server creation.")
 
@@ -268,7 +277,7 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 			CreateMode:                 mariadb.CreateModeDefault,
 		},
 		Sku:  sku,
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -296,14 +305,15 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBServerID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["servers"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -346,14 +356,15 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmMariaDbServerDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mariadb.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMariaDBServerID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["servers"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -15,17 +15,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMonitorDiagnosticSettingCreateUpdate,
-		Read:   resourceArmMonitorDiagnosticSettingRead,
-		Update: resourceArmMonitorDiagnosticSettingCreateUpdate,
-		Delete: resourceArmMonitorDiagnosticSettingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMonitorDiagnosticSettingCreateUpdate,
+		Read:     resourceArmMonitorDiagnosticSettingRead,
+		Update:   resourceArmMonitorDiagnosticSettingCreateUpdate,
+		Delete:   resourceArmMonitorDiagnosticSettingDelete,
+		Importer: resourceid.ValidatingImporter(validateMonitorDiagnosticSettingID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,9 +161,34 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 	}
 }
 
+func validateMonitorDiagnosticSettingID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := parseMonitorDiagnosticId(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Monitor Diagnostic Setting ID: %+v", k, err))
+		return
+	}
+
+	if _, err := azure.ParseAzureResourceID(id.resourceID); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse the Target Resource ID within %q: %+v", k, err))
+	}
+
+	if id.name == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain the name of the Monitor Diagnostic Setting", k))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
 	name := d.Get("name").(string)
@@ -259,7 +291,8 @@ func resourceArmMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseMonitorDiagnosticId(d.Id())
 	if err != nil {
@@ -300,7 +333,8 @@ func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interf
 
 func resourceArmMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseMonitorDiagnosticId(d.Id())
 	if err != nil {
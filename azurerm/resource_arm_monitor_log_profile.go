@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,17 +13,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMonitorLogProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogProfileCreateUpdate,
-		Read:   resourceArmLogProfileRead,
-		Update: resourceArmLogProfileCreateUpdate,
-		Delete: resourceArmLogProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmLogProfileCreateUpdate,
+		Read:     resourceArmLogProfileRead,
+		Update:   resourceArmLogProfileCreateUpdate,
+		Delete:   resourceArmLogProfileDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Insights/logProfiles")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,7 +95,8 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 
 func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	if requireResourcesToBeImported && d.IsNewResource() {
@@ -133,7 +142,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Wait for Log Profile to become available
-	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(name, meta)); err != nil {
+	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(ctx, name, meta)); err != nil {
 		return fmt.Errorf("Error waiting for Log Profile %q to become available: %+v", name, err)
 	}
 
@@ -149,7 +158,8 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -186,7 +196,8 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -265,10 +276,9 @@ func flattenAzureRmLogProfileRetentionPolicy(input *insights.RetentionPolicy) []
 	return []interface{}{result}
 }
 
-func retryLogProfilesClientGet(name string, meta interface{}) func() *resource.RetryError {
+func retryLogProfilesClientGet(ctx context.Context, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		client := meta.(*ArmClient).monitorLogProfilesClient
-		ctx := meta.(*ArmClient).StopContext
 
 		if _, err := client.Get(ctx, name); err != nil {
 			return resource.RetryableError(err)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -13,6 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,8 +26,13 @@ func resourceArmMonitorMetricAlert() *schema.Resource {
 		Update: resourceArmMonitorMetricAlertCreateUpdate,
 		Delete: resourceArmMonitorMetricAlertDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMonitorMetricAlertID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -210,7 +218,8 @@ func resourceArmMonitorMetricAlert() *schema.Resource {
 
 func resourceArmMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorMetricAlertsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -239,7 +248,7 @@ func resourceArmMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta inte
 	actionRaw := d.Get("action").(*schema.Set).List()
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	parameters := insights.MetricAlertResource{
 		Location: utils.String(azure.NormalizeLocation("Global")),
@@ -275,14 +284,15 @@ func resourceArmMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorMetricAlertsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorMetricAlertID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["metricAlerts"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -320,14 +330,15 @@ func resourceArmMonitorMetricAlertRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorMetricAlertsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorMetricAlertID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["metricAlerts"]
+	name := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, name); err != nil {
 		if !response.WasNotFound(resp.Response) {
@@ -358,7 +369,7 @@ func expandMonitorMetricAlertCriteria(input []interface{}) *insights.MetricAlert
 			MetricNamespace: utils.String(v["metric_namespace"].(string)),
 			MetricName:      utils.String(v["metric_name"].(string)),
 			TimeAggregation: v["aggregation"].(string),
-			Operator:        v["operator"].(string),
+			Operator:        insights.Operator(v["operator"].(string)),
 			Threshold:       utils.Float(v["threshold"].(float64)),
 			Dimensions:      &dimensions,
 		})
@@ -383,7 +394,7 @@ func expandMonitorMetricAlertAction(input []interface{}) *[]insights.MetricAlert
 
 			actions = append(actions, insights.MetricAlertAction{
 				ActionGroupID:     utils.String(agID),
-				WebhookProperties: props,
+				WebHookProperties: props,
 			})
 		}
 	}
@@ -411,9 +422,7 @@ func flattenMonitorMetricAlertCriteria(input insights.BasicMetricAlertCriteria)
 		if aggr, ok := metric.TimeAggregation.(string); ok {
 			v["aggregation"] = aggr
 		}
-		if op, ok := metric.Operator.(string); ok {
-			v["operator"] = op
-		}
+		v["operator"] = string(metric.Operator)
 		if metric.Threshold != nil {
 			v["threshold"] = *metric.Threshold
 		}
@@ -452,7 +461,7 @@ func flattenMonitorMetricAlertAction(input *[]insights.MetricAlertAction) (resul
 		}
 
 		props := make(map[string]string)
-		for pk, pv := range action.WebhookProperties {
+		for pk, pv := range action.WebHookProperties {
 			if pv != nil {
 				props[pk] = *pv
 			}
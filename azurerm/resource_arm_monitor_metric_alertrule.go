@@ -4,23 +4,31 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMonitorMetricAlertRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMonitorMetricAlertRuleCreateUpdate,
-		Read:   resourceArmMonitorMetricAlertRuleRead,
-		Update: resourceArmMonitorMetricAlertRuleCreateUpdate,
-		Delete: resourceArmMonitorMetricAlertRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmMonitorMetricAlertRuleCreateUpdate,
+		Read:     resourceArmMonitorMetricAlertRuleRead,
+		Update:   resourceArmMonitorMetricAlertRuleCreateUpdate,
+		Delete:   resourceArmMonitorMetricAlertRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMonitorMetricAlertRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -144,7 +152,6 @@ func resourceArmMonitorMetricAlertRule() *schema.Resource {
 			"tags": {
 				Type:         schema.TypeMap,
 				Optional:     true,
-				Computed:     true,
 				ValidateFunc: validateMetricAlertRuleTags,
 			},
 		},
@@ -153,7 +160,8 @@ func resourceArmMonitorMetricAlertRule() *schema.Resource {
 
 func resourceArmMonitorMetricAlertRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorAlertRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Alert Rule creation.")
 
@@ -184,7 +192,7 @@ func resourceArmMonitorMetricAlertRuleCreateUpdate(d *schema.ResourceData, meta
 	alertRuleResource := insights.AlertRuleResource{
 		Name:      &name,
 		Location:  &location,
-		Tags:      expandTags(tags),
+		Tags:      expandTagsWithDefaults(meta, tags),
 		AlertRule: alertRule,
 	}
 
@@ -207,13 +215,14 @@ func resourceArmMonitorMetricAlertRuleCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmMonitorMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorAlertRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorMetricAlertRuleID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["alertrules"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -308,13 +317,14 @@ func resourceArmMonitorMetricAlertRuleRead(d *schema.ResourceData, meta interfac
 
 func resourceArmMonitorMetricAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorAlertRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMonitorMetricAlertRuleID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["alertrules"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
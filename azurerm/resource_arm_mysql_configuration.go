@@ -3,10 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,8 +19,12 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 		Read:   resourceArmMySQLConfigurationRead,
 		Delete: resourceArmMySQLConfigurationDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateMySQLConfigurationID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +54,8 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 
 func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysql.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Configuration creation.")
 
@@ -86,15 +94,16 @@ func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysql.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMySQLConfigurationID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName := id.ServerName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -117,15 +126,16 @@ func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmMySQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysql.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseMySQLConfigurationID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName := id.ServerName
+	name := id.Name
 
 	// "delete" = resetting this to the default value
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,6 +13,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,8 +27,13 @@ func resourceArmNetworkInterface() *schema.Resource {
 		Update: resourceArmNetworkInterfaceCreateUpdate,
 		Delete: resourceArmNetworkInterfaceDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateNetworkInterfaceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -245,7 +253,8 @@ func resourceArmNetworkInterface() *schema.Resource {
 
 func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Network Interface creation.")
 
@@ -335,7 +344,7 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		Name:                      &name,
 		Location:                  &location,
 		InterfacePropertiesFormat: &properties,
-		Tags:                      expandTags(tags),
+		Tags:                      expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, iface)
@@ -362,14 +371,15 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkInterfaces"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -452,14 +462,15 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["networkInterfaces"]
+	name := id.Name
 
 	azureRMLockByName(name, networkInterfaceResourceName)
 	defer azureRMUnlockByName(name, networkInterfaceResourceName)
@@ -484,16 +495,16 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 
 		subnet_id := data["subnet_id"].(string)
 		if subnet_id != "" {
-			subnetId, err2 := parseAzureResourceID(subnet_id)
+			subnetId, err2 := resourceid.ParseSubnetID(subnet_id)
 			if err2 != nil {
 				return err2
 			}
-			subnetName := subnetId.Path["subnets"]
+			subnetName := subnetId.Name
 			if !sliceContainsValue(subnetNamesToLock, subnetName) {
 				subnetNamesToLock = append(subnetNamesToLock, subnetName)
 			}
 
-			virtualNetworkName := subnetId.Path["virtualNetworks"]
+			virtualNetworkName := subnetId.VirtualNetworkName
 			if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
 				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 			}
@@ -614,13 +625,13 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 				ID: &subnet_id,
 			}
 
-			subnetId, err := parseAzureResourceID(subnet_id)
+			subnetId, err := resourceid.ParseSubnetID(subnet_id)
 			if err != nil {
 				return []network.InterfaceIPConfiguration{}, nil, nil, err
 			}
 
-			subnetName := subnetId.Path["subnets"]
-			virtualNetworkName := subnetId.Path["virtualNetworks"]
+			subnetName := subnetId.Name
+			virtualNetworkName := subnetId.VirtualNetworkName
 
 			if !sliceContainsValue(subnetNamesToLock, subnetName) {
 				subnetNamesToLock = append(subnetNamesToLock, subnetName)
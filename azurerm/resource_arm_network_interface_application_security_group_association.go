@@ -4,22 +4,29 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNetworkInterfaceApplicationSecurityGroupAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate,
-		Read:   resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead,
-		Delete: resourceArmNetworkInterfaceApplicationSecurityGroupAssociationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate,
+		Read:     resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead,
+		Delete:   resourceArmNetworkInterfaceApplicationSecurityGroupAssociationDelete,
+		Importer: resourceid.ValidatingImporter(validateNetworkInterfaceAssociationID(resourceid.ValidateApplicationSecurityGroupID)),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,7 +56,8 @@ func resourceArmNetworkInterfaceApplicationSecurityGroupAssociation() *schema.Re
 
 func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Application Security Group Association creation.")
 
@@ -57,12 +65,12 @@ func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *sch
 	ipConfigurationName := d.Get("ip_configuration_name").(string)
 	applicationSecurityGroupId := d.Get("application_security_group_id").(string)
 
-	id, err := parseAzureResourceID(networkInterfaceId)
+	id, err := resourceid.ParseNetworkInterfaceID(networkInterfaceId)
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := id.Path["networkInterfaces"]
+	networkInterfaceName := id.Name
 	resourceGroup := id.ResourceGroup
 
 	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
@@ -142,20 +150,21 @@ func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *sch
 
 func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	splitId := strings.Split(d.Id(), "|")
 	if len(splitId) != 2 {
 		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{applicationSecurityGroupId} but got %q", d.Id())
 	}
 
-	nicID, err := parseAzureResourceID(splitId[0])
+	nicID, err := resourceid.ParseNetworkInterfaceIPConfigurationID(splitId[0])
 	if err != nil {
 		return err
 	}
 
-	ipConfigurationName := nicID.Path["ipConfigurations"]
-	networkInterfaceName := nicID.Path["networkInterfaces"]
+	ipConfigurationName := nicID.Name
+	networkInterfaceName := nicID.NetworkInterfaceName
 	resourceGroup := nicID.ResourceGroup
 	applicationSecurityGroupId := splitId[1]
 
@@ -219,20 +228,21 @@ func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationRead(d *schem
 
 func resourceArmNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	splitId := strings.Split(d.Id(), "|")
 	if len(splitId) != 2 {
 		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{applicationSecurityGroupId} but got %q", d.Id())
 	}
 
-	nicID, err := parseAzureResourceID(splitId[0])
+	nicID, err := resourceid.ParseNetworkInterfaceIPConfigurationID(splitId[0])
 	if err != nil {
 		return err
 	}
 
-	ipConfigurationName := nicID.Path["ipConfigurations"]
-	networkInterfaceName := nicID.Path["networkInterfaces"]
+	ipConfigurationName := nicID.Name
+	networkInterfaceName := nicID.NetworkInterfaceName
 	resourceGroup := nicID.ResourceGroup
 	applicationSecurityGroupId := splitId[1]
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMNetworkInterfaceApplicationSecurityGroupAssociation_basic(t *testing.T) {
@@ -90,12 +91,12 @@ func testCheckAzureRMNetworkInterfaceApplicationSecurityGroupAssociationExists(r
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		applicationSecurityGroupId := rs.Primary.Attributes["application_security_group_id"]
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
@@ -140,12 +141,12 @@ func testCheckAzureRMNetworkInterfaceApplicationSecurityGroupAssociationDisappea
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		applicationSecurityGroupId := rs.Primary.Attributes["application_security_group_id"]
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
@@ -90,12 +91,12 @@ func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationExists(resourc
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
@@ -140,12 +141,12 @@ func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationDisappears(res
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
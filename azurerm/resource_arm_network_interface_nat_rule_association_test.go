@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestAccAzureRMNetworkInterfaceNATRuleAssociation_basic(t *testing.T) {
@@ -90,12 +91,12 @@ func testCheckAzureRMNetworkInterfaceNATRuleAssociationExists(resourceName strin
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		natRuleId := rs.Primary.Attributes["nat_rule_id"]
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
@@ -140,12 +141,12 @@ func testCheckAzureRMNetworkInterfaceNATRuleAssociationDisappears(resourceName s
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		nicID, err := resourceid.ParseNetworkInterfaceID(rs.Primary.Attributes["network_interface_id"])
 		if err != nil {
 			return err
 		}
 
-		nicName := nicID.Path["networkInterfaces"]
+		nicName := nicID.Name
 		resourceGroup := nicID.ResourceGroup
 		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
 		natRuleId := rs.Primary.Attributes["nat_rule_id"]
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,8 +24,12 @@ func resourceArmNetworkPacketCapture() *schema.Resource {
 		Read:   resourceArmNetworkPacketCaptureRead,
 		Delete: resourceArmNetworkPacketCaptureDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePacketCaptureID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,7 +143,8 @@ func resourceArmNetworkPacketCapture() *schema.Resource {
 
 func resourceArmNetworkPacketCaptureCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -202,16 +210,17 @@ func resourceArmNetworkPacketCaptureCreate(d *schema.ResourceData, meta interfac
 
 func resourceArmNetworkPacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePacketCaptureID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["NetworkPacketCaptures"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -250,16 +259,17 @@ func resourceArmNetworkPacketCaptureRead(d *schema.ResourceData, meta interface{
 
 func resourceArmNetworkPacketCaptureDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePacketCaptureID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["NetworkPacketCaptures"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,12 +19,17 @@ const azureNetworkProfileResourceName = "azurerm_network_profile"
 
 func resourceArmNetworkProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkProfileCreateUpdate,
-		Read:   resourceArmNetworkProfileRead,
-		Update: resourceArmNetworkProfileCreateUpdate,
-		Delete: resourceArmNetworkProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmNetworkProfileCreateUpdate,
+		Read:     resourceArmNetworkProfileRead,
+		Update:   resourceArmNetworkProfileCreateUpdate,
+		Delete:   resourceArmNetworkProfileDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateNetworkProfileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,7 +92,8 @@ func resourceArmNetworkProfile() *schema.Resource {
 
 func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Network Profile creation")
 
@@ -128,7 +137,7 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 
 	parameters := network.Profile{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
 			ContainerNetworkInterfaceConfigurations: cniConfigs,
 		},
@@ -154,14 +163,15 @@ func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkProfiles"]
+	name := id.Name
 
 	profile, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -199,14 +209,15 @@ func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).netProfileClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkProfiles"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -293,13 +304,13 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *schema.ResourceData) (*[]s
 			ipData := ipConfig.(map[string]interface{})
 			subnetID := ipData["subnet_id"].(string)
 
-			subnetResourceID, err := parseAzureResourceID(subnetID)
+			subnetResourceID, err := resourceid.ParseSubnetID(subnetID)
 			if err != nil {
 				return nil, nil, err
 			}
 
-			subnetName := subnetResourceID.Path["subnets"]
-			vnetName := subnetResourceID.Path["virtualNetworks"]
+			subnetName := subnetResourceID.Name
+			vnetName := subnetResourceID.VirtualNetworkName
 
 			if !sliceContainsValue(subnetNames, subnetName) {
 				subnetNames = append(subnetNames, subnetName)
@@ -2,23 +2,31 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNetworkSecurityRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkSecurityRuleCreateUpdate,
-		Read:   resourceArmNetworkSecurityRuleRead,
-		Update: resourceArmNetworkSecurityRuleCreateUpdate,
-		Delete: resourceArmNetworkSecurityRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmNetworkSecurityRuleCreateUpdate,
+		Read:     resourceArmNetworkSecurityRuleRead,
+		Update:   resourceArmNetworkSecurityRuleCreateUpdate,
+		Delete:   resourceArmNetworkSecurityRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateNetworkSecurityRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -156,7 +164,8 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 
 func resourceArmNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	nsgName := d.Get("network_security_group_name").(string)
@@ -292,15 +301,16 @@ func resourceArmNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkSecurityRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	networkSGName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	networkSGName := id.NetworkSecurityGroupName
+	sgRuleName := id.Name
 
 	resp, err := client.Get(ctx, resGroup, networkSGName, sgRuleName)
 	if err != nil {
@@ -344,15 +354,16 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNetworkSecurityRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	nsgName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	nsgName := id.NetworkSecurityGroupName
+	sgRuleName := id.Name
 
 	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
 	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,13 +25,11 @@ const apnsSandboxEndpoint = "https://api.development.push.apple.com:443/3/device
 
 func resourceArmNotificationHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNotificationHubCreateUpdate,
-		Read:   resourceArmNotificationHubRead,
-		Update: resourceArmNotificationHubCreateUpdate,
-		Delete: resourceArmNotificationHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNotificationHubCreateUpdate,
+		Read:     resourceArmNotificationHubRead,
+		Update:   resourceArmNotificationHubCreateUpdate,
+		Delete:   resourceArmNotificationHubDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateNotificationHubID),
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 			// NOTE: the ForceNew is to workaround a bug in the Azure SDK where nil-values aren't sent to the API.
 			// Bug: https://github.com/Azure/azure-sdk-for-go/issues/2246
@@ -51,6 +51,13 @@ func resourceArmNotificationHub() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -131,7 +138,8 @@ func resourceArmNotificationHub() *schema.Resource {
 
 func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	namespaceName := d.Get("namespace_name").(string)
@@ -220,15 +228,16 @@ func notificationHubStateRefreshFunc(ctx context.Context, client notificationhub
 
 func resourceArmNotificationHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["notificationHubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -270,15 +279,16 @@ func resourceArmNotificationHubRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmNotificationHubDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["notificationHubs"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -3,25 +3,33 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNotificationHubAuthorizationRuleCreateUpdate,
-		Read:   resourceArmNotificationHubAuthorizationRuleRead,
-		Update: resourceArmNotificationHubAuthorizationRuleCreateUpdate,
-		Delete: resourceArmNotificationHubAuthorizationRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNotificationHubAuthorizationRuleCreateUpdate,
+		Read:     resourceArmNotificationHubAuthorizationRuleRead,
+		Update:   resourceArmNotificationHubAuthorizationRuleCreateUpdate,
+		Delete:   resourceArmNotificationHubAuthorizationRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateNotificationHubAuthorizationRuleID),
 		// TODO: customizeDiff for send+listen when manage selected
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -76,7 +84,8 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 
 func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	notificationHubName := d.Get("notification_hub_name").(string)
@@ -125,16 +134,17 @@ func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceD
 
 func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNotificationHubAuthorizationRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	notificationHubName := id.Path["notificationHubs"]
-	name := id.Path["AuthorizationRules"]
+	namespaceName := id.NamespaceName
+	notificationHubName := id.NotificationHubName
+	name := id.Name
 
 	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 	if err != nil {
@@ -172,16 +182,17 @@ func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, met
 
 func resourceArmNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubs.HubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseNotificationHubAuthorizationRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	notificationHubName := id.Path["notificationHubs"]
-	name := id.Path["AuthorizationRules"]
+	namespaceName := id.NamespaceName
+	notificationHubName := id.NotificationHubName
+	name := id.Name
 
 	resp, err := client.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 	if err != nil {
@@ -201,7 +212,7 @@ func expandNotificationHubAuthorizationRuleRights(manage bool, send bool, listen
 	}
 
 	if send {
-		rights = append(rights, notificationhubs.Send)
+		rights = append(rights, notificationhubs.SendEnumValue)
 	}
 
 	if listen {
@@ -221,7 +232,7 @@ func flattenNotificationHubAuthorizationRuleRights(input *[]notificationhubs.Acc
 		case notificationhubs.Manage:
 			manage = true
 			continue
-		case notificationhubs.Send:
+		case notificationhubs.SendEnumValue:
 			send = true
 			continue
 		case notificationhubs.Listen:
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -12,16 +13,22 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPacketCapture() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPacketCaptureCreate,
-		Read:   resourceArmPacketCaptureRead,
-		Delete: resourceArmPacketCaptureDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPacketCaptureCreate,
+		Read:     resourceArmPacketCaptureRead,
+		Delete:   resourceArmPacketCaptureDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePacketCaptureID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -135,7 +142,8 @@ func resourceArmPacketCapture() *schema.Resource {
 
 func resourceArmPacketCaptureCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -201,16 +209,17 @@ func resourceArmPacketCaptureCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmPacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePacketCaptureID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["packetCaptures"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -249,16 +258,17 @@ func resourceArmPacketCaptureRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmPacketCaptureDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePacketCaptureID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["packetCaptures"]
+	watcherName := id.NetworkWatcherName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -17,17 +17,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPolicyAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPolicyAssignmentCreateOrUpdate,
-		Update: resourceArmPolicyAssignmentCreateOrUpdate,
-		Read:   resourceArmPolicyAssignmentRead,
-		Delete: resourceArmPolicyAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPolicyAssignmentCreateOrUpdate,
+		Update:   resourceArmPolicyAssignmentCreateOrUpdate,
+		Read:     resourceArmPolicyAssignmentRead,
+		Delete:   resourceArmPolicyAssignmentDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Authorization/policyAssignments")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,7 +115,8 @@ func resourceArmPolicyAssignment() *schema.Resource {
 
 func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -195,7 +203,8 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
@@ -244,7 +253,8 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
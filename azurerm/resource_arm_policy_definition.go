@@ -19,17 +19,25 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPolicyDefinition() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPolicyDefinitionCreateUpdate,
-		Update: resourceArmPolicyDefinitionCreateUpdate,
-		Read:   resourceArmPolicyDefinitionRead,
-		Delete: resourceArmPolicyDefinitionDelete,
-		Importer: &schema.ResourceImporter{
-			State: resourceArmPolicyDefinitionImport,
+		Create:   resourceArmPolicyDefinitionCreateUpdate,
+		Update:   resourceArmPolicyDefinitionCreateUpdate,
+		Read:     resourceArmPolicyDefinitionRead,
+		Delete:   resourceArmPolicyDefinitionDelete,
+		Importer: resourceid.ValidatingImporterWithState(azure.ValidateScopedResourceID("Microsoft.Authorization/policyDefinitions"), resourceArmPolicyDefinitionImport),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,7 +111,8 @@ func resourceArmPolicyDefinition() *schema.Resource {
 
 func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.DefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	policyType := d.Get("policy_type").(string)
@@ -199,7 +208,8 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.DefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicyDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -247,7 +257,8 @@ func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPolicyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.DefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicyDefinitionNameFromId(d.Id())
 	if err != nil {
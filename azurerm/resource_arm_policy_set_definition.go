@@ -20,18 +20,26 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPolicySetDefinition() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPolicySetDefinitionCreateUpdate,
-		Update: resourceArmPolicySetDefinitionCreateUpdate,
-		Read:   resourceArmPolicySetDefinitionRead,
-		Delete: resourceArmPolicySetDefinitionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPolicySetDefinitionCreateUpdate,
+		Update:   resourceArmPolicySetDefinitionCreateUpdate,
+		Read:     resourceArmPolicySetDefinitionRead,
+		Delete:   resourceArmPolicySetDefinitionDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Authorization/policySetDefinitions")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,7 +119,8 @@ func policyDefinitionsDiffSuppressFunc(k, old, new string, d *schema.ResourceDat
 
 func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	policyType := d.Get("policy_type").(string)
@@ -207,7 +216,8 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicySetDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -272,7 +282,8 @@ func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmPolicySetDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicySetDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -11,16 +12,22 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPostgreSQLDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPostgreSQLDatabaseCreate,
-		Read:   resourceArmPostgreSQLDatabaseRead,
-		Delete: resourceArmPostgreSQLDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPostgreSQLDatabaseCreate,
+		Read:     resourceArmPostgreSQLDatabaseRead,
+		Delete:   resourceArmPostgreSQLDatabaseDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePostgreSQLDatabaseID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +64,8 @@ func resourceArmPostgreSQLDatabase() *schema.Resource {
 
 func resourceArmPostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgres.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM PostgreSQL Database creation.")
 
@@ -112,15 +120,16 @@ func resourceArmPostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmPostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgres.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePostgreSQLDatabaseID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName := id.ServerName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, serverName, name)
 	if err != nil {
@@ -151,15 +160,16 @@ func resourceArmPostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmPostgreSQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgres.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePostgreSQLDatabaseID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName := id.ServerName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, serverName, name)
 	if err != nil {
@@ -3,22 +3,30 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPrivateDnsARecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPrivateDnsARecordCreateUpdate,
-		Read:   resourceArmPrivateDnsARecordRead,
-		Update: resourceArmPrivateDnsARecordCreateUpdate,
-		Delete: resourceArmPrivateDnsARecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPrivateDnsARecordCreateUpdate,
+		Read:     resourceArmPrivateDnsARecordRead,
+		Update:   resourceArmPrivateDnsARecordCreateUpdate,
+		Delete:   resourceArmPrivateDnsARecordDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePrivateDnsARecordID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,7 +63,8 @@ func resourceArmPrivateDnsARecord() *schema.Resource {
 
 func resourceArmPrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).privateDns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -108,16 +117,17 @@ func resourceArmPrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmPrivateDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).privateDns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePrivateDnsARecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["A"]
-	zoneName := id.Path["privateDnsZones"]
+	name := id.Name
+	zoneName := id.PrivateDnsZoneName
 
 	resp, err := dnsClient.Get(ctx, resGroup, zoneName, privatedns.A, name)
 	if err != nil {
@@ -143,16 +153,17 @@ func resourceArmPrivateDnsARecordRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmPrivateDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).privateDns.RecordSetsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePrivateDnsARecordID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["A"]
-	zoneName := id.Path["privateDnsZones"]
+	name := id.Name
+	zoneName := id.PrivateDnsZoneName
 
 	resp, err := dnsClient.Delete(ctx, resGroup, zoneName, privatedns.A, name, "")
 	if resp.StatusCode != http.StatusOK {
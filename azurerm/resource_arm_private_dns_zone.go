@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"time"
@@ -11,17 +12,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPrivateDnsZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPrivateDnsZoneCreateUpdate,
-		Read:   resourceArmPrivateDnsZoneRead,
-		Update: resourceArmPrivateDnsZoneCreateUpdate,
-		Delete: resourceArmPrivateDnsZoneDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPrivateDnsZoneCreateUpdate,
+		Read:     resourceArmPrivateDnsZoneRead,
+		Update:   resourceArmPrivateDnsZoneCreateUpdate,
+		Delete:   resourceArmPrivateDnsZoneDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePrivateDnsZoneID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +68,8 @@ func resourceArmPrivateDnsZone() *schema.Resource {
 
 func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -83,7 +92,7 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 
 	parameters := privatedns.PrivateZone{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 
 	etag := ""
@@ -95,7 +104,7 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	time.Sleep(time.Second * 10) // resource is slow to create / update, retry covers the create, sleeping to make update more reliable
-	if err := resource.Retry(120*time.Second, retryPrivateDnsZonesClientGet(resGroup, name, meta)); err != nil {
+	if err := resource.Retry(120*time.Second, retryPrivateDnsZonesClientGet(ctx, resGroup, name, meta)); err != nil {
 		return fmt.Errorf("error waiting for Private DNS Zone %q to become available: %+v", name, err)
 	}
 
@@ -113,10 +122,9 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 	return resourceArmPrivateDnsZoneRead(d, meta)
 }
 
-func retryPrivateDnsZonesClientGet(resGroup string, name string, meta interface{}) func() *resource.RetryError {
+func retryPrivateDnsZonesClientGet(ctx context.Context, resGroup string, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		client := meta.(*ArmClient).privateDns.PrivateZonesClient
-		ctx := meta.(*ArmClient).StopContext
 
 		if _, err := client.Get(ctx, resGroup, name); err != nil {
 			return resource.RetryableError(err)
@@ -128,15 +136,16 @@ func retryPrivateDnsZonesClientGet(resGroup string, name string, meta interface{
 
 func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePrivateDnsZoneID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["privateDnsZones"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -161,15 +170,16 @@ func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmPrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePrivateDnsZoneID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["privateDnsZones"]
+	name := id.Name
 
 	etag := ""
 	future, err := client.Delete(ctx, resGroup, name, etag)
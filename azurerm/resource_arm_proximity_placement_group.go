@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmProximityPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmProximityPlacementGroupCreateUpdate,
+		Read:     resourceArmProximityPlacementGroupRead,
+		Update:   resourceArmProximityPlacementGroupCreateUpdate,
+		Delete:   resourceArmProximityPlacementGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateProximityPlacementGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmProximityPlacementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Proximity Placement Group %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_proximity_placement_group", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := compute.ProximityPlacementGroup{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		ProximityPlacementGroupProperties: &compute.ProximityPlacementGroupProperties{
+			// `Ultra` is documented as "for future use" - so `Standard` is the only usable type at this time
+			ProximityPlacementGroupType: compute.Standard,
+		},
+		Tags: expandTagsWithDefaults(meta, t),
+	}
+
+	log.Printf("[DEBUG] Creating/Updating Proximity Placement Group %q (Resource Group %q)..", name, resourceGroup)
+	resp, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Proximity Placement Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmProximityPlacementGroupRead(d, meta)
+}
+
+func resourceArmProximityPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseProximityPlacementGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Proximity Placement Group %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Proximity Placement Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmProximityPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).proximityPlacementGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseProximityPlacementGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Proximity Placement Group %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Proximity Placement Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// proximityPlacementGroupIDSchema is the `proximity_placement_group_id` field used by the
+// Availability Set, Virtual Machine and Virtual Machine Scale Set resources
+func proximityPlacementGroupIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: resourceid.ValidateProximityPlacementGroupID,
+		// the Compute API returns the Resource Group name within this ID in UPPERCASE
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
+
+func expandProximityPlacementGroupID(input string) *compute.SubResource {
+	if input == "" {
+		return nil
+	}
+
+	return &compute.SubResource{
+		ID: utils.String(input),
+	}
+}
+
+func flattenProximityPlacementGroupID(input *compute.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
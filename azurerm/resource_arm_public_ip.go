@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,6 +13,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,18 +25,13 @@ func resourceArmPublicIp() *schema.Resource {
 		Update: resourceArmPublicIpCreateUpdate,
 		Delete: resourceArmPublicIpDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				id, err := parseAzureResourceID(d.Id())
-				if err != nil {
-					return nil, err
-				}
-				name := id.Path["publicIPAddresses"]
-				if name == "" {
-					return nil, fmt.Errorf("Error parsing supplied resource id. Please check it and rerun:\n %s", d.Id())
-				}
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePublicIPID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,7 +140,8 @@ func resourceArmPublicIp() *schema.Resource {
 
 func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
@@ -200,7 +199,7 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
 		},
-		Tags:  expandTags(tags),
+		Tags:  expandTagsWithDefaults(meta, tags),
 		Zones: zones,
 	}
 
@@ -253,14 +252,15 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePublicIPID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -305,14 +305,15 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePublicIPID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPublicIpPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPublicIpPrefixCreateUpdate,
-		Read:   resourceArmPublicIpPrefixRead,
-		Update: resourceArmPublicIpPrefixCreateUpdate,
-		Delete: resourceArmPublicIpPrefixDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmPublicIpPrefixCreateUpdate,
+		Read:     resourceArmPublicIpPrefixRead,
+		Update:   resourceArmPublicIpPrefixCreateUpdate,
+		Delete:   resourceArmPublicIpPrefixDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidatePublicIPPrefixID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +74,8 @@ func resourceArmPublicIpPrefix() *schema.Resource {
 
 func resourceArmPublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPPrefixClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP Prefix creation.")
 
@@ -87,7 +96,7 @@ func resourceArmPublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interfac
 		PublicIPPrefixPropertiesFormat: &network.PublicIPPrefixPropertiesFormat{
 			PrefixLength: utils.Int32(int32(prefix_length)),
 		},
-		Tags:  expandTags(tags),
+		Tags:  expandTagsWithDefaults(meta, tags),
 		Zones: zones,
 	}
 
@@ -115,14 +124,15 @@ func resourceArmPublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmPublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPPrefixClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePublicIPPrefixID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPPrefixes"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -157,14 +167,15 @@ func resourceArmPublicIpPrefixRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmPublicIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPPrefixClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParsePublicIPPrefixID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPPrefixes"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
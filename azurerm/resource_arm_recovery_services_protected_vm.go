@@ -10,12 +10,14 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
-	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,8 +28,13 @@ func resourceArmRecoveryServicesProtectedVm() *schema.Resource {
 		Update: resourceArmRecoveryServicesProtectedVmCreateUpdate,
 		Delete: resourceArmRecoveryServicesProtectedVmDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateRecoveryServicesProtectedItemID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,7 +71,8 @@ func resourceArmRecoveryServicesProtectedVm() *schema.Resource {
 
 func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
@@ -74,17 +82,13 @@ func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData,
 	policyId := d.Get("backup_policy_id").(string)
 
 	//get VM name from id
-	parsedVmId, err := azure.ParseAzureResourceID(vmId)
+	parsedVmId, err := resourceid.ParseVirtualMachineID(vmId)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Unable to parse source_vm_id '%s': %+v", vmId, err)
 	}
-	vmName, hasName := parsedVmId.Path["virtualMachines"]
-	if !hasName {
-		return fmt.Errorf("[ERROR] parsed source_vm_id '%s' doesn't contain 'virtualMachines'", vmId)
-	}
 
-	protectedItemName := fmt.Sprintf("VM;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
-	containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
+	protectedItemName := fmt.Sprintf("VM;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, parsedVmId.Name)
+	containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, parsedVmId.Name)
 
 	log.Printf("[DEBUG] Creating/updating Recovery Service Protected VM %s (resource group %q)", protectedItemName, resourceGroup)
 
@@ -102,13 +106,13 @@ func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData,
 	}
 
 	item := backup.ProtectedItemResource{
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		Properties: &backup.AzureIaaSComputeVMProtectedItem{
 			PolicyID:          &policyId,
 			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
 			WorkloadType:      backup.DataSourceTypeVM,
 			SourceResourceID:  utils.String(vmId),
-			FriendlyName:      utils.String(vmName),
+			FriendlyName:      utils.String(parsedVmId.Name),
 			VirtualMachineID:  utils.String(vmId),
 		},
 	}
@@ -130,17 +134,18 @@ func resourceArmRecoveryServicesProtectedVmCreateUpdate(d *schema.ResourceData,
 
 func resourceArmRecoveryServicesProtectedVmRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRecoveryServicesProtectedItemID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	protectedItemName := id.Path["protectedItems"]
-	vaultName := id.Path["vaults"]
+	protectedItemName := id.Name
+	vaultName := id.VaultName
 	resourceGroup := id.ResourceGroup
-	containerName := id.Path["protectionContainers"]
+	containerName := id.ProtectionContainerName
 
 	log.Printf("[DEBUG] Reading Recovery Service Protected VM %q (resource group %q)", protectedItemName, resourceGroup)
 
@@ -174,17 +179,18 @@ func resourceArmRecoveryServicesProtectedVmRead(d *schema.ResourceData, meta int
 
 func resourceArmRecoveryServicesProtectedVmDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRecoveryServicesProtectedItemID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	protectedItemName := id.Path["protectedItems"]
+	protectedItemName := id.Name
 	resourceGroup := id.ResourceGroup
-	vaultName := id.Path["vaults"]
-	containerName := id.Path["protectionContainers"]
+	vaultName := id.VaultName
+	containerName := id.ProtectionContainerName
 
 	log.Printf("[DEBUG] Deleting Recovery Service Protected Item %q (resource group %q)", protectedItemName, resourceGroup)
 
@@ -202,7 +208,7 @@ func resourceArmRecoveryServicesProtectedVmDelete(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceArmRecoveryServicesProtectedVmWaitForState(client backup.ProtectedItemsGroupClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName, protectedItemName string, policyId string, newResource bool) (backup.ProtectedItemResource, error) {
+func resourceArmRecoveryServicesProtectedVmWaitForState(client backup.ProtectedItemsClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName, protectedItemName string, policyId string, newResource bool) (backup.ProtectedItemResource, error) {
 	state := &resource.StateChangeConf{
 		Timeout:    30 * time.Minute,
 		MinTimeout: 30 * time.Second,
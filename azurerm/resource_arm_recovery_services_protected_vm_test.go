@@ -7,8 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -162,14 +162,11 @@ func testCheckAzureRMRecoveryServicesProtectedVmDestroy(s *terraform.State) erro
 		vaultName := rs.Primary.Attributes["recovery_vault_name"]
 		vmId := rs.Primary.Attributes["source_vm_id"]
 
-		parsedVmId, err := azure.ParseAzureResourceID(vmId)
+		parsedVmId, err := resourceid.ParseVirtualMachineID(vmId)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Unable to parse source_vm_id '%s': %+v", vmId, err)
 		}
-		vmName, hasName := parsedVmId.Path["virtualMachines"]
-		if !hasName {
-			return fmt.Errorf("[ERROR] parsed source_vm_id '%s' doesn't contain 'virtualMachines'", vmId)
-		}
+		vmName := parsedVmId.Name
 
 		protectedItemName := fmt.Sprintf("VM;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
 		containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
@@ -209,14 +206,11 @@ func testCheckAzureRMRecoveryServicesProtectedVmExists(resourceName string) reso
 		vmId := rs.Primary.Attributes["source_vm_id"]
 
 		//get VM name from id
-		parsedVmId, err := azure.ParseAzureResourceID(vmId)
+		parsedVmId, err := resourceid.ParseVirtualMachineID(vmId)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Unable to parse source_vm_id '%s': %+v", vmId, err)
 		}
-		vmName, hasName := parsedVmId.Path["virtualMachines"]
-		if !hasName {
-			return fmt.Errorf("[ERROR] parsed source_vm_id '%s' doesn't contain 'virtualMachines'", vmId)
-		}
+		vmName := parsedVmId.Name
 
 		protectedItemName := fmt.Sprintf("VM;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
 		containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
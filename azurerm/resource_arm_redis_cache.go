@@ -19,17 +19,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRedisCache() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRedisCacheCreate,
-		Read:   resourceArmRedisCacheRead,
-		Update: resourceArmRedisCacheUpdate,
-		Delete: resourceArmRedisCacheDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmRedisCacheCreate,
+		Read:     resourceArmRedisCacheRead,
+		Update:   resourceArmRedisCacheUpdate,
+		Delete:   resourceArmRedisCacheDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateRedisCacheID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -251,7 +258,8 @@ func resourceArmRedisCache() *schema.Resource {
 
 func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.Client
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache creation.")
 
 	name := d.Get("name").(string)
@@ -265,7 +273,7 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	if requireResourcesToBeImported {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -315,12 +323,12 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v, ok := d.GetOk("subnet_id"); ok {
-		parsed, parseErr := parseAzureResourceID(v.(string))
+		parsed, parseErr := resourceid.ParseSubnetID(v.(string))
 		if parseErr != nil {
 			return fmt.Errorf("Error parsing Azure Resource ID %q", v.(string))
 		}
-		subnetName := parsed.Path["subnets"]
-		virtualNetworkName := parsed.Path["virtualNetworks"]
+		subnetName := parsed.Name
+		virtualNetworkName := parsed.VirtualNetworkName
 		azureRMLockByName(subnetName, subnetResourceName)
 		defer azureRMUnlockByName(subnetName, subnetResourceName)
 		azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
@@ -376,7 +384,8 @@ func resourceArmRedisCacheCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.Client
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache update.")
 
 	name := d.Get("name").(string)
@@ -389,7 +398,7 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 
 	parameters := redis.UpdateParameters{
 		UpdateProperties: &redis.UpdateProperties{
@@ -468,14 +477,15 @@ func resourceArmRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.Client
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRedisCacheID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["Redis"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 
@@ -550,14 +560,15 @@ func resourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.Client
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRedisCacheID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["Redis"]
+	name := id.Name
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -568,12 +579,12 @@ func resourceArmRedisCacheDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	props := *read.Properties
 	if subnetID := props.SubnetID; subnetID != nil {
-		parsed, parseErr := parseAzureResourceID(*subnetID)
+		parsed, parseErr := resourceid.ParseSubnetID(*subnetID)
 		if parseErr != nil {
 			return fmt.Errorf("Error parsing Azure Resource ID %q", *subnetID)
 		}
-		subnetName := parsed.Path["subnets"]
-		virtualNetworkName := parsed.Path["virtualNetworks"]
+		subnetName := parsed.Name
+		virtualNetworkName := parsed.VirtualNetworkName
 		azureRMLockByName(subnetName, subnetResourceName)
 		defer azureRMUnlockByName(subnetName, subnetResourceName)
 		azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -12,17 +13,24 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRedisFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRedisFirewallRuleCreateUpdate,
-		Read:   resourceArmRedisFirewallRuleRead,
-		Update: resourceArmRedisFirewallRuleCreateUpdate,
-		Delete: resourceArmRedisFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmRedisFirewallRuleCreateUpdate,
+		Read:     resourceArmRedisFirewallRuleRead,
+		Update:   resourceArmRedisFirewallRuleCreateUpdate,
+		Delete:   resourceArmRedisFirewallRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateRedisFirewallRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,7 +64,8 @@ func resourceArmRedisFirewallRule() *schema.Resource {
 
 func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Redis Firewall Rule creation.")
 
 	name := d.Get("name").(string)
@@ -107,15 +116,16 @@ func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRedisFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	cacheName := id.Path["Redis"]
-	name := id.Path["firewallRules"]
+	cacheName := id.RedisName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, cacheName, name)
 
@@ -142,15 +152,16 @@ func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmRedisFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redis.FirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRedisFirewallRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	cacheName := id.Path["Redis"]
-	name := id.Path["firewallRules"]
+	cacheName := id.RedisName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, cacheName, name)
 
@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -10,18 +12,25 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmResourceGroupCreateUpdate,
-		Read:   resourceArmResourceGroupRead,
-		Update: resourceArmResourceGroupCreateUpdate,
-		Exists: resourceArmResourceGroupExists,
-		Delete: resourceArmResourceGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmResourceGroupCreateUpdate,
+		Read:     resourceArmResourceGroupRead,
+		Update:   resourceArmResourceGroupCreateUpdate,
+		Exists:   resourceArmResourceGroupExists,
+		Delete:   resourceArmResourceGroupDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateResourceGroupID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,7 +45,8 @@ func resourceArmResourceGroup() *schema.Resource {
 
 func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -57,7 +67,7 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
@@ -76,14 +86,15 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseResourceGroupID(d.Id())
 	if err != nil {
 		return fmt.Errorf("Error parsing Azure Resource ID %q: %+v", d.Id(), err)
 	}
 
-	name := id.ResourceGroup
+	name := id.Name
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
@@ -107,14 +118,15 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseResourceGroupID(d.Id())
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Azure Resource ID %q: %+v", d.Id(), err)
 	}
 
-	name := id.ResourceGroup
+	name := id.Name
 
 	resp, err := client.Get(ctx, name)
 	if err != nil {
@@ -130,14 +142,44 @@ func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseResourceGroupID(d.Id())
 	if err != nil {
 		return fmt.Errorf("Error parsing Azure Resource ID %q: %+v", d.Id(), err)
 	}
 
-	name := id.ResourceGroup
+	name := id.Name
+
+	if meta.(*ArmClient).Features.ResourceGroup.PreventDeletionIfContainsResources {
+		resourcesClient := meta.(*ArmClient).resourcesClient
+		results, err := resourcesClient.ListByResourceGroupComplete(ctx, name, "", "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing resources in Resource Group %q: %+v", name, err)
+		}
+
+		nestedResourceIds := make([]string, 0)
+		for results.NotDone() {
+			val := results.Value()
+			if val.ID != nil {
+				nestedResourceIds = append(nestedResourceIds, *val.ID)
+			}
+
+			if err := results.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error retrieving next page of resources in Resource Group %q: %+v", name, err)
+			}
+		}
+
+		if len(nestedResourceIds) > 0 {
+			return fmt.Errorf(`Deletion of Resource Group %q was prevented since it still contains %d Resource(s):
+
+%s
+
+The Provider's features block has 'prevent_deletion_if_contains_resources' enabled - either remove
+these Resources (or manage them in Terraform) or disable this feature to delete the Resource Group.`, name, len(nestedResourceIds), "* "+strings.Join(nestedResourceIds, "\n* "))
+		}
+	}
 
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
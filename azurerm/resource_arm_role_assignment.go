@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,16 +15,23 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRoleAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRoleAssignmentCreate,
-		Read:   resourceArmRoleAssignmentRead,
-		Delete: resourceArmRoleAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmRoleAssignmentCreate,
+		Read:     resourceArmRoleAssignmentRead,
+		Delete:   resourceArmRoleAssignmentDelete,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Authorization/roleAssignments")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +79,8 @@ func resourceArmRoleAssignment() *schema.Resource {
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
 	roleAssignmentsClient := meta.(*ArmClient).authorization.RoleAssignmentsClient
 	roleDefinitionsClient := meta.(*ArmClient).authorization.RoleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -125,7 +134,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
-	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(scope, name, properties, meta)); err != nil {
+	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(ctx, scope, name, properties, meta)); err != nil {
 		return err
 	}
 
@@ -144,7 +153,8 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).authorization.RoleAssignmentsClient
 	roleDefinitionsClient := meta.(*ArmClient).authorization.RoleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -182,7 +192,8 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).authorization.RoleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseRoleAssignmentId(d.Id())
 	if err != nil {
@@ -211,10 +222,9 @@ func validateRoleDefinitionName(i interface{}, k string) ([]string, []error) {
 	return nil, nil
 }
 
-func retryRoleAssignmentsClient(scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
+func retryRoleAssignmentsClient(ctx context.Context, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		roleAssignmentsClient := meta.(*ArmClient).authorization.RoleAssignmentsClient
-		ctx := meta.(*ArmClient).StopContext
 
 		resp, err := roleAssignmentsClient.Create(ctx, scope, name, properties)
 		if err != nil {
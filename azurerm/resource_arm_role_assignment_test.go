@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/google/uuid"
@@ -217,7 +218,12 @@ func testAccAzureRMActiveDirectoryServicePrincipal_servicePrincipal(t *testing.T
 }
 
 func testAccAzureRMActiveDirectoryServicePrincipal_group(t *testing.T) {
-	ri := tf.AccRandTimeInt()
+	// Azure Active Directory Groups can't be managed by this Provider, so an existing Group is used
+	groupObjectId := os.Getenv("ARM_TEST_GROUP_OBJECT_ID")
+	if groupObjectId == "" {
+		t.Skip("Skipping since `ARM_TEST_GROUP_OBJECT_ID` isn't specified")
+	}
+
 	id := uuid.New().String()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -226,7 +232,7 @@ func testAccAzureRMActiveDirectoryServicePrincipal_group(t *testing.T) {
 		CheckDestroy: testCheckAzureRMRoleAssignmentDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAzureRMRoleAssingment_group(ri, id),
+				Config: testAccAzureRMRoleAssingment_group(groupObjectId, id),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRoleAssignmentExists("azurerm_role_assignment.test"),
 				),
@@ -402,36 +408,32 @@ func testAccAzureRMRoleAssingment_servicePrincipal(rInt int, roleAssignmentID st
 	return fmt.Sprintf(`
 data "azurerm_subscription" "current" {}
 
-resource "azuread_application" "test" {
+resource "azurerm_azuread_application" "test" {
   name = "acctestspa-%d"
 }
 
-resource "azuread_service_principal" "test" {
-  application_id = "${azuread_application.test.application_id}"
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
 }
 
 resource "azurerm_role_assignment" "test" {
   name                 = "%s"
   scope                = "${data.azurerm_subscription.current.id}"
   role_definition_name = "Reader"
-  principal_id         = "${azuread_service_principal.test.id}"
+  principal_id         = "${azurerm_azuread_service_principal.test.id}"
 }
 `, rInt, roleAssignmentID)
 }
 
-func testAccAzureRMRoleAssingment_group(rInt int, roleAssignmentID string) string {
+func testAccAzureRMRoleAssingment_group(groupObjectId string, roleAssignmentID string) string {
 	return fmt.Sprintf(`
 data "azurerm_subscription" "current" {}
 
-resource "azuread_group" "test" {
-  name = "acctestspa-%d"
-}
-
 resource "azurerm_role_assignment" "test" {
   name                 = "%s"
   scope                = "${data.azurerm_subscription.current.id}"
   role_definition_name = "Reader"
-  principal_id         = "${azuread_group.test.id}"
+  principal_id         = "%s"
 }
-`, rInt, roleAssignmentID)
+`, roleAssignmentID, groupObjectId)
 }
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,8 +24,13 @@ func resourceArmRoute() *schema.Resource {
 		Update: resourceArmRouteCreateUpdate,
 		Delete: resourceArmRouteDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateRouteID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,7 +80,8 @@ func resourceArmRoute() *schema.Resource {
 
 func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).routesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	rtName := d.Get("route_table_name").(string)
@@ -132,15 +141,16 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).routesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
 	resp, err := client.Get(ctx, resGroup, rtName, routeName)
 	if err != nil {
@@ -166,15 +176,16 @@ func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).routesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseRouteID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	rtName := id.Path["routeTables"]
-	routeName := id.Path["routes"]
+	rtName := id.RouteTableName
+	routeName := id.Name
 
 	azureRMLockByName(rtName, routeTableResourceName)
 	defer azureRMUnlockByName(rtName, routeTableResourceName)
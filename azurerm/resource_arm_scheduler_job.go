@@ -23,6 +23,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -35,12 +37,17 @@ func resourceArmSchedulerJob() *schema.Resource {
 
 		DeprecationMessage: "Scheduler Job's have been deprecated in favour of Logic Apps - more information can be found at https://docs.microsoft.com/en-us/azure/scheduler/migrate-from-scheduler-to-logic-apps",
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSchedulerJobID),
 
 		CustomizeDiff: resourceArmSchedulerJobCustomizeDiff,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -464,7 +471,8 @@ func resourceArmSchedulerJobCustomizeDiff(diff *schema.ResourceDiff, _ interface
 
 func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -521,16 +529,17 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 
 func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSchedulerJobID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobs"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
-	jobCollection := id.Path["jobCollections"]
+	jobCollection := id.JobCollectionName
 
 	log.Printf("[DEBUG] Reading Scheduler Job %q (resource group %q)", name, resourceGroup)
 
@@ -615,16 +624,17 @@ func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSchedulerJobDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).scheduler.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSchedulerJobID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobs"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
-	jobCollection := id.Path["jobCollections"]
+	jobCollection := id.JobCollectionName
 
 	log.Printf("[DEBUG] Deleting Scheduler Job %q (resource group %q)", name, resourceGroup)
 
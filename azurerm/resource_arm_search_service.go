@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -10,17 +11,24 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSearchService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSearchServiceCreateUpdate,
-		Read:   resourceArmSearchServiceRead,
-		Update: resourceArmSearchServiceCreateUpdate,
-		Delete: resourceArmSearchServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSearchServiceCreateUpdate,
+		Read:     resourceArmSearchServiceRead,
+		Update:   resourceArmSearchServiceCreateUpdate,
+		Delete:   resourceArmSearchServiceDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSearchServiceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,7 +86,8 @@ func resourceArmSearchService() *schema.Resource {
 
 func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).search.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -105,7 +114,7 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 			Name: search.SkuName(skuName),
 		},
 		ServiceProperties: &search.ServiceProperties{},
-		Tags:              expandTags(tags),
+		Tags:              expandTagsWithDefaults(meta, tags),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -134,14 +143,15 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).search.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSearchServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["searchServices"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name, nil)
 	if err != nil {
@@ -188,14 +198,15 @@ func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmSearchServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).search.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSearchServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["searchServices"]
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, name, nil)
 
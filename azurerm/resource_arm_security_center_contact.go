@@ -3,12 +3,16 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,8 +29,13 @@ func resourceArmSecurityCenterContact() *schema.Resource {
 		Update: resourceArmSecurityCenterContactCreateUpdate,
 		Delete: resourceArmSecurityCenterContactDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(azure.ValidateScopedResourceID("Microsoft.Security/securityContacts")),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +66,8 @@ func resourceArmSecurityCenterContact() *schema.Resource {
 
 func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
@@ -119,7 +129,8 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
@@ -144,9 +155,10 @@ func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceArmSecurityCenterContactDelete(_ *schema.ResourceData, meta interface{}) error {
+func resourceArmSecurityCenterContactDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
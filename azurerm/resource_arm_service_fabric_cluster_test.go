@@ -1264,60 +1264,26 @@ resource "azurerm_resource_group" "test" {
 
 data "azurerm_client_config" "current" {}
 
-resource "azuread_application" "cluster_explorer" {
+resource "azurerm_azuread_application" "cluster_explorer" {
   name                       = "${azurerm_resource_group.test.name}-explorer-AAD"
   homepage                   = "https://example:19080/Explorer/index.html"
   identifier_uris            = ["https://example:19080/Explorer/index.html"]
   reply_urls                 = ["https://example:19080/Explorer/index.html"]
   available_to_other_tenants = false
-  oauth2_allow_implicit_flow = true
-
-  # https://blogs.msdn.microsoft.com/aaddevsup/2018/06/06/guid-table-for-windows-azure-active-directory-permissions/
-  # https://shawntabrizi.com/aad/common-microsoft-resources-azure-active-directory/
-  required_resource_access {
-    resource_app_id = "00000002-0000-0000-c000-000000000000"
-
-    resource_access {
-      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
-      type = "Scope"
-    }
-  }
 }
 
-resource "azuread_service_principal" "cluster_explorer" {
-  application_id = "${azuread_application.cluster_explorer.application_id}"
+resource "azurerm_azuread_service_principal" "cluster_explorer" {
+  application_id = "${azurerm_azuread_application.cluster_explorer.application_id}"
 }
 
-resource "azuread_application" "cluster_console" {
+resource "azurerm_azuread_application" "cluster_console" {
   name                       = "${azurerm_resource_group.test.name}-console-AAD"
-  type                       = "native"
   reply_urls                 = ["urn:ietf:wg:oauth:2.0:oob"]
   available_to_other_tenants = false
-  oauth2_allow_implicit_flow = true
-
-  # https://blogs.msdn.microsoft.com/aaddevsup/2018/06/06/guid-table-for-windows-azure-active-directory-permissions/
-  # https://shawntabrizi.com/aad/common-microsoft-resources-azure-active-directory/
-  required_resource_access {
-    resource_app_id = "00000002-0000-0000-c000-000000000000"
-
-    resource_access {
-      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
-      type = "Scope"
-    }
-  }
-
-  required_resource_access {
-    resource_app_id = "${azuread_application.cluster_explorer.application_id}"
-
-    resource_access {
-      id   = "${azuread_application.cluster_explorer.oauth2_permissions.0.id}"
-      type = "Scope"
-    }
-  }
 }
 
-resource "azuread_service_principal" "cluster_console" {
-  application_id = "${azuread_application.cluster_console.application_id}"
+resource "azurerm_azuread_service_principal" "cluster_console" {
+  application_id = "${azurerm_azuread_application.cluster_console.application_id}"
 }
 
 resource "azurerm_service_fabric_cluster" "test" {
@@ -1336,8 +1302,8 @@ resource "azurerm_service_fabric_cluster" "test" {
 
   azure_active_directory {
     tenant_id              = "${data.azurerm_client_config.current.tenant_id}"
-    cluster_application_id = "${azuread_application.cluster_explorer.application_id}"
-    client_application_id  = "${azuread_application.cluster_console.application_id}"
+    cluster_application_id = "${azurerm_azuread_application.cluster_explorer.application_id}"
+    client_application_id  = "${azurerm_azuread_application.cluster_console.application_id}"
   }
 
   fabric_settings {
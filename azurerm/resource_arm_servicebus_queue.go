@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceBusQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceBusQueueCreateUpdate,
-		Read:   resourceArmServiceBusQueueRead,
-		Update: resourceArmServiceBusQueueCreateUpdate,
-		Delete: resourceArmServiceBusQueueDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmServiceBusQueueCreateUpdate,
+		Read:     resourceArmServiceBusQueueRead,
+		Update:   resourceArmServiceBusQueueCreateUpdate,
+		Delete:   resourceArmServiceBusQueueDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateServiceBusQueueID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,7 +141,8 @@ func resourceArmServiceBusQueue() *schema.Resource {
 
 func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.QueuesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM ServiceBus Queue creation/update.")
 
 	name := d.Get("name").(string)
@@ -223,15 +232,16 @@ func resourceArmServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.QueuesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["queues"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -286,15 +296,16 @@ func resourceArmServiceBusQueueRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.QueuesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["queues"]
+	namespaceName := id.NamespaceName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -3,22 +3,30 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceBusSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceBusSubscriptionCreateUpdate,
-		Read:   resourceArmServiceBusSubscriptionRead,
-		Update: resourceArmServiceBusSubscriptionCreateUpdate,
-		Delete: resourceArmServiceBusSubscriptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmServiceBusSubscriptionCreateUpdate,
+		Read:     resourceArmServiceBusSubscriptionRead,
+		Update:   resourceArmServiceBusSubscriptionCreateUpdate,
+		Delete:   resourceArmServiceBusSubscriptionDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateServiceBusSubscriptionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,7 +112,8 @@ func resourceArmServiceBusSubscription() *schema.Resource {
 
 func resourceArmServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Subscription creation.")
 
 	name := d.Get("name").(string)
@@ -174,16 +183,17 @@ func resourceArmServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	name := id.Path["subscriptions"]
+	namespaceName := id.NamespaceName
+	topicName := id.TopicName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, topicName, name)
 	if err != nil {
@@ -218,16 +228,17 @@ func resourceArmServiceBusSubscriptionRead(d *schema.ResourceData, meta interfac
 
 func resourceArmServiceBusSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	name := id.Path["subscriptions"]
+	namespaceName := id.NamespaceName
+	topicName := id.TopicName
+	name := id.Name
 
 	_, err = client.Delete(ctx, resourceGroup, namespaceName, topicName, name)
 
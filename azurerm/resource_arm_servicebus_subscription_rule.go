@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceBusSubscriptionRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceBusSubscriptionRuleCreateUpdate,
-		Read:   resourceArmServiceBusSubscriptionRuleRead,
-		Update: resourceArmServiceBusSubscriptionRuleCreateUpdate,
-		Delete: resourceArmServiceBusSubscriptionRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmServiceBusSubscriptionRuleCreateUpdate,
+		Read:     resourceArmServiceBusSubscriptionRuleRead,
+		Update:   resourceArmServiceBusSubscriptionRuleCreateUpdate,
+		Delete:   resourceArmServiceBusSubscriptionRuleDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateServiceBusSubscriptionRuleID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -122,7 +130,8 @@ func resourceArmServiceBusSubscriptionRule() *schema.Resource {
 
 func resourceArmServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure Service Bus Subscription Rule creation.")
 
 	name := d.Get("name").(string)
@@ -192,18 +201,19 @@ func resourceArmServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusSubscriptionRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	subscriptionName := id.Path["subscriptions"]
-	name := id.Path["rules"]
+	namespaceName := id.NamespaceName
+	topicName := id.TopicName
+	subscriptionName := id.SubscriptionName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, topicName, subscriptionName, name)
 	if err != nil {
@@ -241,17 +251,18 @@ func resourceArmServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta inte
 
 func resourceArmServiceBusSubscriptionRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).servicebus.SubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseServiceBusSubscriptionRuleID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	subscriptionName := id.Path["subscriptions"]
-	name := id.Path["rules"]
+	namespaceName := id.NamespaceName
+	topicName := id.TopicName
+	subscriptionName := id.SubscriptionName
+	name := id.Name
 
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, topicName, subscriptionName, name)
 	if err != nil {
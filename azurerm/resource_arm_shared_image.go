@@ -3,25 +3,33 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageCreateUpdate,
-		Read:   resourceArmSharedImageRead,
-		Update: resourceArmSharedImageCreateUpdate,
-		Delete: resourceArmSharedImageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSharedImageCreateUpdate,
+		Read:     resourceArmSharedImageRead,
+		Update:   resourceArmSharedImageCreateUpdate,
+		Delete:   resourceArmSharedImageDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSharedImageID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,7 +109,8 @@ func resourceArmSharedImage() *schema.Resource {
 
 func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Shared Image creation.")
 
@@ -144,7 +153,7 @@ func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}
 			OsType:              compute.OperatingSystemTypes(osType),
 			OsState:             compute.Generalized,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, name, image)
@@ -172,16 +181,17 @@ func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	galleryName := id.Path["galleries"]
-	name := id.Path["images"]
+	galleryName := id.GalleryName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, galleryName, name)
 	if err != nil {
@@ -221,16 +231,17 @@ func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	galleryName := id.Path["galleries"]
-	name := id.Path["images"]
+	galleryName := id.GalleryName
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, galleryName, name)
 	if err != nil {
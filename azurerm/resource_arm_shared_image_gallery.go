@@ -3,24 +3,32 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImageGallery() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageGalleryCreateUpdate,
-		Read:   resourceArmSharedImageGalleryRead,
-		Update: resourceArmSharedImageGalleryCreateUpdate,
-		Delete: resourceArmSharedImageGalleryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSharedImageGalleryCreateUpdate,
+		Read:     resourceArmSharedImageGalleryRead,
+		Update:   resourceArmSharedImageGalleryCreateUpdate,
+		Delete:   resourceArmSharedImageGalleryDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSharedImageGalleryID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,11 +60,11 @@ func resourceArmSharedImageGallery() *schema.Resource {
 
 func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Image Gallery creation.")
 
-	// TODO: support for Timeouts/Requiring Import
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -81,7 +89,7 @@ func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta inte
 		GalleryProperties: &compute.GalleryProperties{
 			Description: utils.String(description),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gallery)
@@ -109,15 +117,16 @@ func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageGalleryID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["galleries"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -150,15 +159,16 @@ func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmSharedImageGalleryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageGalleryID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["galleries"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
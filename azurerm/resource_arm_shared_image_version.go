@@ -3,24 +3,32 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImageVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageVersionCreateUpdate,
-		Read:   resourceArmSharedImageVersionRead,
-		Update: resourceArmSharedImageVersionCreateUpdate,
-		Delete: resourceArmSharedImageVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSharedImageVersionCreateUpdate,
+		Read:     resourceArmSharedImageVersionRead,
+		Update:   resourceArmSharedImageVersionCreateUpdate,
+		Delete:   resourceArmSharedImageVersionDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSharedImageVersionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,7 +96,8 @@ func resourceArmSharedImageVersion() *schema.Resource {
 
 func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	imageVersion := d.Get("name").(string)
 	imageName := d.Get("image_name").(string)
@@ -120,14 +129,14 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 			PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
 				ExcludeFromLatest: utils.Bool(excludeFromLatest),
 				TargetRegions:     targetRegions,
-				Source: &compute.GalleryArtifactSource{
-					ManagedImage: &compute.ManagedArtifact{
-						ID: utils.String(managedImageId),
-					},
+			},
+			StorageProfile: &compute.GalleryImageVersionStorageProfile{
+				Source: &compute.GalleryArtifactVersionSource{
+					ID: utils.String(managedImageId),
 				},
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, imageName, imageVersion, version)
@@ -153,16 +162,17 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageVersionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	imageVersion := id.Path["versions"]
-	imageName := id.Path["images"]
-	galleryName := id.Path["galleries"]
+	imageVersion := id.Name
+	imageName := id.ImageName
+	galleryName := id.GalleryName
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, compute.ReplicationStatusTypesReplicationStatus)
@@ -192,11 +202,11 @@ func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{})
 			if err := d.Set("target_region", flattenedRegions); err != nil {
 				return fmt.Errorf("Error setting `target_region`: %+v", err)
 			}
+		}
 
+		if profile := props.StorageProfile; profile != nil {
 			if source := profile.Source; source != nil {
-				if image := source.ManagedImage; image != nil {
-					d.Set("managed_image_id", image.ID)
-				}
+				d.Set("managed_image_id", source.ID)
 			}
 		}
 	}
@@ -208,16 +218,17 @@ func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSharedImageVersionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	imageVersion := id.Path["versions"]
-	imageName := id.Path["images"]
-	galleryName := id.Path["galleries"]
+	imageVersion := id.Name
+	imageName := id.ImageName
+	galleryName := id.GalleryName
 	resourceGroup := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroup, galleryName, imageName, imageVersion)
@@ -4,23 +4,31 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSnapshotCreateUpdate,
-		Read:   resourceArmSnapshotRead,
-		Update: resourceArmSnapshotCreateUpdate,
-		Delete: resourceArmSnapshotDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSnapshotCreateUpdate,
+		Read:     resourceArmSnapshotRead,
+		Update:   resourceArmSnapshotCreateUpdate,
+		Delete:   resourceArmSnapshotDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSnapshotID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +77,8 @@ func resourceArmSnapshot() *schema.Resource {
 				Computed: true,
 			},
 
+			"disk_encryption_set_id": diskEncryptionSetIDSchema(),
+
 			"encryption_settings": encryptionSettingsSchema(),
 
 			"tags": tagsSchema(),
@@ -78,7 +88,8 @@ func resourceArmSnapshot() *schema.Resource {
 
 func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -105,8 +116,9 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			CreationData: &compute.CreationData{
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
+			Encryption: expandDiskEncryption(d.Get("disk_encryption_set_id").(string)),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -129,7 +141,7 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	if v, ok := d.GetOk("encryption_settings"); ok {
 		encryptionSettings := v.([]interface{})
 		settings := encryptionSettings[0].(map[string]interface{})
-		properties.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
+		properties.EncryptionSettingsCollection = expandManagedDiskEncryptionSettings(settings)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
@@ -153,15 +165,16 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSnapshotID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["snapshots"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -194,8 +207,10 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("disk_size_gb", int(*props.DiskSizeGB))
 		}
 
-		if props.EncryptionSettings != nil {
-			d.Set("encryption_settings", flattenManagedDiskEncryptionSettings(props.EncryptionSettings))
+		d.Set("disk_encryption_set_id", flattenDiskEncryption(props.Encryption))
+
+		if props.EncryptionSettingsCollection != nil {
+			d.Set("encryption_settings", flattenManagedDiskEncryptionSettings(props.EncryptionSettingsCollection))
 		}
 	}
 
@@ -206,15 +221,16 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSnapshotID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["snapshots"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
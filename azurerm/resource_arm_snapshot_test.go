@@ -137,6 +137,32 @@ func TestAccAzureRMSnapshot_encryption(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSnapshot_diskEncryptionSet(t *testing.T) {
+	resourceName := "azurerm_snapshot.test"
+	rs := acctest.RandString(4)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSnapshot_diskEncryptionSet(rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_encryption_set_id", "azurerm_disk_encryption_set.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_uri"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSnapshot_update(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
 	ri := tf.AccRandTimeInt()
@@ -605,3 +631,30 @@ resource "azurerm_snapshot" "test" {
 }
 `, rInt, location, rInt, rInt, rString, rInt, rInt, rInt)
 }
+
+func testAccAzureRMSnapshot_diskEncryptionSet(rString string, location string) string {
+	template := testAccAzureRMDiskEncryptionSet_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%s"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "test" {
+  name                   = "acctestss_%s"
+  location               = "${azurerm_resource_group.test.location}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  create_option          = "Copy"
+  source_uri             = "${azurerm_managed_disk.test.id}"
+  disk_encryption_set_id = "${azurerm_disk_encryption_set.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.disk-encryption"]
+}
+`, template, rString, rString)
+}
@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	uuid "github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSqlAdministrator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
-		Read:   resourceArmSqlActiveDirectoryAdministratorRead,
-		Update: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
-		Delete: resourceArmSqlActiveDirectoryAdministratorDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Read:     resourceArmSqlActiveDirectoryAdministratorRead,
+		Update:   resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Delete:   resourceArmSqlActiveDirectoryAdministratorDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateSqlActiveDirectoryAdministratorID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +61,8 @@ func resourceArmSqlAdministrator() *schema.Resource {
 
 func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	serverName := d.Get("server_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -104,15 +113,16 @@ func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceDa
 
 func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSqlActiveDirectoryAdministratorID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
+	serverName := id.ServerName
 
 	resp, err := client.Get(ctx, resourceGroup, serverName)
 	if err != nil {
@@ -136,15 +146,16 @@ func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta
 
 func resourceArmSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSqlActiveDirectoryAdministratorID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
+	serverName := id.ServerName
 
 	_, err = client.Delete(ctx, resourceGroup, serverName)
 	if err != nil {
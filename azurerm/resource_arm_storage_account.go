@@ -1,13 +1,15 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -16,6 +18,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/blob/accounts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 )
@@ -32,8 +38,13 @@ func resourceArmStorageAccount() *schema.Resource {
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStorageAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,13 +115,14 @@ func resourceArmStorageAccount() *schema.Resource {
 				}, true),
 			},
 
+			// this is Computed since it's changed to `Microsoft.Keyvault` by the `azurerm_storage_account_customer_managed_key` resource
 			"account_encryption_source": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  string(storage.MicrosoftStorage),
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(storage.MicrosoftKeyvault),
-					string(storage.MicrosoftStorage),
+					string(storage.KeySourceMicrosoftKeyvault),
+					string(storage.KeySourceMicrosoftStorage),
 				}, true),
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
@@ -408,11 +420,119 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
-			"tags": {
-				Type:         schema.TypeMap,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateAzureRMStorageAccountTags,
+			"tags": tagsSchemaWithRules(tags.StorageAccountRules),
+
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"exposed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_methods": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"DELETE",
+												"GET",
+												"HEAD",
+												"MERGE",
+												"POST",
+												"OPTIONS",
+												"PUT"}, false),
+										},
+									},
+									"max_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 2000000000),
+									},
+								},
+							},
+						},
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"change_feed_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
 			},
 
 			"queue_properties": {
@@ -574,31 +694,9 @@ func resourceArmStorageAccount() *schema.Resource {
 	}
 }
 
-func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []string, errors []error) {
-	tagsMap := v.(map[string]interface{})
-
-	if len(tagsMap) > 15 {
-		errors = append(errors, fmt.Errorf("a maximum of 15 tags can be applied to each ARM resource"))
-	}
-
-	for k, v := range tagsMap {
-		if len(k) > 128 {
-			errors = append(errors, fmt.Errorf("the maximum length for a tag key is 128 characters: %q is %d characters", k, len(k)))
-		}
-
-		value, err := tagValueToString(v)
-		if err != nil {
-			errors = append(errors, err)
-		} else if len(value) > 256 {
-			errors = append(errors, fmt.Errorf("the maximum length for a tag value is 256 characters: the value for %q is %d characters", k, len(value)))
-		}
-	}
-
-	return warnings, errors
-}
-
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 
@@ -630,13 +728,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	replicationType := d.Get("account_replication_type").(string)
 	storageType := fmt.Sprintf("%s_%s", accountTier, replicationType)
 	storageAccountEncryptionSource := d.Get("account_encryption_source").(string)
+	if storageAccountEncryptionSource == "" {
+		storageAccountEncryptionSource = string(storage.KeySourceMicrosoftStorage)
+	}
 
 	parameters := storage.AccountCreateParameters{
 		Location: &location,
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			Encryption: &storage.Encryption{
@@ -730,6 +831,31 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if val, ok := d.GetOk("blob_properties"); ok {
+		// FileStorage accounts don't support a Blob Service
+		if accountKind == string(storage.FileStorage) {
+			return fmt.Errorf("`blob_properties` aren't supported for File Storage accounts.")
+		}
+
+		blobServicesClient := meta.(*ArmClient).storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(val.([]interface{}))
+		if _, err = blobServicesClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+	}
+
+	if val, ok := d.GetOk("static_website"); ok {
+		// static website only supported on StorageV2
+		if accountKind != string(storage.StorageV2) {
+			return fmt.Errorf("`static_website` is only supported for Storage V2 accounts.")
+		}
+
+		if err := setStorageAccountStaticWebsite(ctx, meta, resourceGroupName, storageAccountName, val.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	if val, ok := d.GetOk("queue_properties"); ok {
 		queueClient := meta.(*ArmClient).storage.QueuesClient
 
@@ -750,15 +876,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageAccounts"]
+	storageAccountName := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	accountTier := d.Get("account_tier").(string)
@@ -806,11 +933,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("access_tier")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		tags := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: expandTags(tags),
+			Tags: expandTagsWithDefaults(meta, tags),
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
@@ -849,6 +976,18 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 			d.SetPartial("enable_file_encryption")
 		}
 
+		// when a Customer Managed Key is used the existing Key Vault configuration needs to be retained
+		if strings.EqualFold(encryptionSource, string(storage.KeySourceMicrosoftKeyvault)) {
+			existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
+			if err != nil {
+				return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+			}
+
+			if props := existing.AccountProperties; props != nil && props.Encryption != nil {
+				opts.Encryption.KeyVaultProperties = props.Encryption.KeyVaultProperties
+			}
+		}
+
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
 			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %+v", storageAccountName, err)
 		}
@@ -921,6 +1060,35 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("enable_advanced_threat_protection")
 	}
 
+	if d.HasChange("blob_properties") {
+		// FileStorage accounts don't support a Blob Service
+		if accountKind == string(storage.FileStorage) {
+			return fmt.Errorf("`blob_properties` aren't supported for File Storage accounts.")
+		}
+
+		blobServicesClient := meta.(*ArmClient).storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
+		if _, err = blobServicesClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
+	if d.HasChange("static_website") {
+		// static website only supported on StorageV2
+		if accountKind != string(storage.StorageV2) {
+			return fmt.Errorf("`static_website` is only supported for Storage V2 accounts.")
+		}
+
+		if err := setStorageAccountStaticWebsite(ctx, meta, resourceGroupName, storageAccountName, d.Get("static_website").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("static_website")
+	}
+
 	if d.HasChange("queue_properties") {
 		queueClient := meta.(*ArmClient).storage.QueuesClient
 
@@ -941,16 +1109,17 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(ctx, resGroup, name, "")
@@ -962,7 +1131,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %+v", name, err)
 	}
 
-	keys, err := client.ListKeys(ctx, resGroup, name)
+	keys, err := client.ListKeys(ctx, resGroup, name, "")
 	if err != nil {
 		return err
 	}
@@ -1070,6 +1239,33 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 
 	flattenAndSetTags(d, resp.Tags)
 
+	// FileStorage accounts don't support a Blob Service
+	if resp.Kind != storage.FileStorage {
+		blobServicesClient := meta.(*ArmClient).storage.BlobServicesClient
+		blobProps, err := blobServicesClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(blobProps.Response) {
+				return fmt.Errorf("Error reading blob properties for AzureRM Storage Account %q: %+v", name, err)
+			}
+		}
+
+		if err := d.Set("blob_properties", flattenBlobProperties(blobProps)); err != nil {
+			return fmt.Errorf("Error setting `blob_properties `for AzureRM Storage Account %q: %+v", name, err)
+		}
+	}
+
+	// static website only supported on StorageV2
+	var staticWebsite []interface{}
+	if resp.Kind == storage.StorageV2 {
+		staticWebsite, err = getStorageAccountStaticWebsite(ctx, meta, resGroup, name)
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("static_website", staticWebsite); err != nil {
+		return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
+	}
+
 	queueClient := meta.(*ArmClient).storage.QueuesClient
 	queueProps, err := queueClient.GetServiceProperties(ctx, name)
 	if err != nil {
@@ -1086,14 +1282,15 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	read, err := client.GetProperties(ctx, resourceGroup, name, "")
@@ -1115,12 +1312,12 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 						continue
 					}
 
-					id, err2 := parseAzureResourceID(*v.VirtualNetworkResourceID)
+					id, err2 := resourceid.ParseSubnetID(*v.VirtualNetworkResourceID)
 					if err2 != nil {
 						return err2
 					}
 
-					networkName := id.Path["virtualNetworks"]
+					networkName := id.VirtualNetworkName
 					virtualNetworkNames = append(virtualNetworkNames, networkName)
 				}
 			}
@@ -1232,6 +1429,211 @@ func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypa
 	return storage.Bypass(strings.Join(bypassValues, ", "))
 }
 
+func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
+	properties := storage.BlobServiceProperties{
+		BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
+			Cors: &storage.CorsRules{
+				CorsRules: &[]storage.CorsRule{},
+			},
+			DeleteRetentionPolicy: &storage.DeleteRetentionPolicy{
+				Enabled: utils.Bool(false),
+			},
+			IsVersioningEnabled: utils.Bool(false),
+			ChangeFeed: &storage.ChangeFeed{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	v := input[0].(map[string]interface{})
+
+	properties.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(v["cors_rule"].([]interface{}))
+
+	deletePolicy := v["delete_retention_policy"].([]interface{})
+	if len(deletePolicy) > 0 {
+		days := 7
+		if policy, ok := deletePolicy[0].(map[string]interface{}); ok {
+			days = policy["days"].(int)
+		}
+
+		properties.BlobServicePropertiesProperties.DeleteRetentionPolicy = &storage.DeleteRetentionPolicy{
+			Enabled: utils.Bool(true),
+			Days:    utils.Int32(int32(days)),
+		}
+	}
+
+	properties.BlobServicePropertiesProperties.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
+	properties.BlobServicePropertiesProperties.ChangeFeed = &storage.ChangeFeed{
+		Enabled: utils.Bool(v["change_feed_enabled"].(bool)),
+	}
+
+	return properties
+}
+
+func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
+	corsRules := make([]storage.CorsRule, 0)
+
+	for _, attr := range input {
+		corsRuleAttr := attr.(map[string]interface{})
+
+		corsRules = append(corsRules, storage.CorsRule{
+			AllowedOrigins:  utils.ExpandStringSlice(corsRuleAttr["allowed_origins"].([]interface{})),
+			ExposedHeaders:  utils.ExpandStringSlice(corsRuleAttr["exposed_headers"].([]interface{})),
+			AllowedHeaders:  utils.ExpandStringSlice(corsRuleAttr["allowed_headers"].([]interface{})),
+			AllowedMethods:  utils.ExpandStringSlice(corsRuleAttr["allowed_methods"].([]interface{})),
+			MaxAgeInSeconds: utils.Int32(int32(corsRuleAttr["max_age_in_seconds"].(int))),
+		})
+	}
+
+	return &storage.CorsRules{
+		CorsRules: &corsRules,
+	}
+}
+
+func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
+	if input.BlobServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	corsRules := make([]interface{}, 0)
+	if cors := input.BlobServicePropertiesProperties.Cors; cors != nil {
+		corsRules = flattenBlobPropertiesCorsRule(cors.CorsRules)
+	}
+
+	deleteRetentionPolicy := make([]interface{}, 0)
+	if policy := input.BlobServicePropertiesProperties.DeleteRetentionPolicy; policy != nil {
+		if policy.Enabled != nil && *policy.Enabled {
+			days := 0
+			if policy.Days != nil {
+				days = int(*policy.Days)
+			}
+
+			deleteRetentionPolicy = append(deleteRetentionPolicy, map[string]interface{}{
+				"days": days,
+			})
+		}
+	}
+
+	versioningEnabled := false
+	if input.BlobServicePropertiesProperties.IsVersioningEnabled != nil {
+		versioningEnabled = *input.BlobServicePropertiesProperties.IsVersioningEnabled
+	}
+
+	changeFeedEnabled := false
+	if feed := input.BlobServicePropertiesProperties.ChangeFeed; feed != nil && feed.Enabled != nil {
+		changeFeedEnabled = *feed.Enabled
+	}
+
+	if len(corsRules) == 0 && len(deleteRetentionPolicy) == 0 && !versioningEnabled && !changeFeedEnabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"change_feed_enabled":     changeFeedEnabled,
+			"cors_rule":               corsRules,
+			"delete_retention_policy": deleteRetentionPolicy,
+			"versioning_enabled":      versioningEnabled,
+		},
+	}
+}
+
+func flattenBlobPropertiesCorsRule(input *[]storage.CorsRule) []interface{} {
+	corsRules := make([]interface{}, 0)
+	if input == nil {
+		return corsRules
+	}
+
+	for _, corsRule := range *input {
+		maxAgeInSeconds := 0
+		if corsRule.MaxAgeInSeconds != nil {
+			maxAgeInSeconds = int(*corsRule.MaxAgeInSeconds)
+		}
+
+		corsRules = append(corsRules, map[string]interface{}{
+			"allowed_origins":    utils.FlattenStringSlice(corsRule.AllowedOrigins),
+			"allowed_methods":    utils.FlattenStringSlice(corsRule.AllowedMethods),
+			"allowed_headers":    utils.FlattenStringSlice(corsRule.AllowedHeaders),
+			"exposed_headers":    utils.FlattenStringSlice(corsRule.ExposedHeaders),
+			"max_age_in_seconds": maxAgeInSeconds,
+		})
+	}
+
+	return corsRules
+}
+
+// the Static Website configuration is only exposed through the Blob Service properties in the Data Plane API -
+// when these are set the Blob Service retains the existing values for any properties which aren't specified,
+// as such only the Static Website configuration is sent
+func setStorageAccountStaticWebsite(ctx context.Context, meta interface{}, resourceGroup, accountName string, input []interface{}) error {
+	accountsClient, err := meta.(*ArmClient).storage.AccountsClient(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error building Accounts Data Plane Client for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	properties := accounts.StorageServiceProperties{
+		StaticWebsite: expandStorageAccountStaticWebsite(input),
+	}
+	if _, err := accountsClient.SetServiceProperties(ctx, accountName, properties); err != nil {
+		return fmt.Errorf("Error updating Azure Storage Account `static_website` %q: %+v", accountName, err)
+	}
+
+	return nil
+}
+
+func getStorageAccountStaticWebsite(ctx context.Context, meta interface{}, resourceGroup, accountName string) ([]interface{}, error) {
+	accountsClient, err := meta.(*ArmClient).storage.AccountsClient(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error building Accounts Data Plane Client for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	properties, err := accountsClient.GetServiceProperties(ctx, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading static website for AzureRM Storage Account %q: %+v", accountName, err)
+	}
+
+	return flattenStorageAccountStaticWebsite(properties.StaticWebsite), nil
+}
+
+func expandStorageAccountStaticWebsite(input []interface{}) *accounts.StaticWebsite {
+	if len(input) == 0 {
+		return &accounts.StaticWebsite{
+			Enabled: false,
+		}
+	}
+
+	staticWebsite := accounts.StaticWebsite{
+		Enabled: true,
+	}
+
+	if input[0] == nil {
+		return &staticWebsite
+	}
+
+	attr := input[0].(map[string]interface{})
+	staticWebsite.IndexDocument = attr["index_document"].(string)
+	staticWebsite.ErrorDocument404Path = attr["error_404_document"].(string)
+
+	return &staticWebsite
+}
+
+func flattenStorageAccountStaticWebsite(input *accounts.StaticWebsite) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     input.IndexDocument,
+			"error_404_document": input.ErrorDocument404Path,
+		},
+	}
+}
+
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
 	var err error
 	properties := queues.StorageServiceProperties{}
@@ -0,0 +1,273 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Read:     resourceArmStorageAccountCustomerManagedKeyRead,
+		Update:   resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Delete:   resourceArmStorageAccountCustomerManagedKeyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStorageAccountID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceid.ValidateKeyVaultID,
+			},
+
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildName,
+			},
+
+			// when this is omitted the latest version of the Key is used, which is automatically rotated
+			"key_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := storageAccountId.ResourceGroup
+	accountName := storageAccountId.Name
+
+	account, err := client.GetProperties(ctx, resourceGroup, accountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if account.ID == nil {
+		return fmt.Errorf("Cannot read ID of Storage Account %q (Resource Group %q)", accountName, resourceGroup)
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if props := account.AccountProperties; props != nil && props.Encryption != nil {
+			if props.Encryption.KeySource == storage.KeySourceMicrosoftKeyvault {
+				return tf.ImportAsExistsError("azurerm_storage_account_customer_managed_key", *account.ID)
+			}
+		}
+	}
+
+	// the System Assigned Identity of the Storage Account is used to access the Key Vault
+	if account.Identity == nil || account.Identity.PrincipalID == nil || *account.Identity.PrincipalID == "" {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) must have a System Assigned `identity` to use a Customer Managed Key", accountName, resourceGroup)
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	if err := azure.KeyVaultIsRecoverable(ctx, vaultsClient, keyVaultId); err != nil {
+		return fmt.Errorf("Error validating Key Vault %q for Customer Managed Key of Storage Account %q (Resource Group %q): %+v", keyVaultId, accountName, resourceGroup, err)
+	}
+
+	keyVaultBaseUrl, err := azure.GetKeyVaultBaseUrlFromID(ctx, vaultsClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error looking up Key Vault URI from Key Vault %q: %+v", keyVaultId, err)
+	}
+
+	props := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  expandStorageAccountCustomerManagedKeyServices(account.AccountProperties),
+				KeySource: storage.KeySourceMicrosoftKeyvault,
+				KeyVaultProperties: &storage.KeyVaultProperties{
+					KeyName:     utils.String(d.Get("key_name").(string)),
+					KeyVaultURI: utils.String(keyVaultBaseUrl),
+					// an empty Key Version enables automatic rotation to the latest version of the Key
+					KeyVersion: utils.String(d.Get("key_version").(string)),
+				},
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, accountName, props); err != nil {
+		return fmt.Errorf("Error updating Customer Managed Key for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	d.SetId(*account.ID)
+
+	return resourceArmStorageAccountCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			log.Printf("[DEBUG] Storage Account %q was not found in Resource Group %q - removing Customer Managed Key from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	var keyVaultProps *storage.KeyVaultProperties
+	if props := account.AccountProperties; props != nil && props.Encryption != nil {
+		if props.Encryption.KeySource == storage.KeySourceMicrosoftKeyvault {
+			keyVaultProps = props.Encryption.KeyVaultProperties
+		}
+	}
+
+	if keyVaultProps == nil {
+		log.Printf("[DEBUG] Storage Account %q (Resource Group %q) isn't using a Customer Managed Key - removing from state!", id.Name, id.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", id.String())
+
+	keyName := ""
+	if keyVaultProps.KeyName != nil {
+		keyName = *keyVaultProps.KeyName
+	}
+	d.Set("key_name", keyName)
+
+	keyVersion := ""
+	if keyVaultProps.KeyVersion != nil {
+		keyVersion = *keyVaultProps.KeyVersion
+	}
+	d.Set("key_version", keyVersion)
+
+	keyVaultId := ""
+	if keyVaultProps.KeyVaultURI != nil {
+		keyVaultUri := *keyVaultProps.KeyVaultURI
+		// the URI returned from the Storage API doesn't necessarily include a trailing slash
+		if !strings.HasSuffix(keyVaultUri, "/") {
+			keyVaultUri += "/"
+		}
+
+		vaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultsClient, keyVaultUri)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Key Vault ID from the Base URI %q: %+v", keyVaultUri, err)
+		}
+
+		// the Key Vault may have been deleted, in which case the configured value is retained
+		if vaultId == nil {
+			keyVaultId = d.Get("key_vault_id").(string)
+		} else {
+			keyVaultId = *vaultId
+		}
+	}
+	d.Set("key_vault_id", keyVaultId)
+
+	return nil
+}
+
+func resourceArmStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// the Customer Managed Key can't be removed, instead we switch back to Microsoft Managed Keys
+	props := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  expandStorageAccountCustomerManagedKeyServices(account.AccountProperties),
+				KeySource: storage.KeySourceMicrosoftStorage,
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, props); err != nil {
+		return fmt.Errorf("Error removing Customer Managed Key for Storage Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// expandStorageAccountCustomerManagedKeyServices retains the existing encryption settings for the Blob and File services,
+// since these are managed by the `azurerm_storage_account` resource
+func expandStorageAccountCustomerManagedKeyServices(input *storage.AccountProperties) *storage.EncryptionServices {
+	services := storage.EncryptionServices{
+		Blob: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+		File: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+	}
+
+	if input == nil || input.Encryption == nil || input.Encryption.Services == nil {
+		return &services
+	}
+
+	if blob := input.Encryption.Services.Blob; blob != nil && blob.Enabled != nil {
+		services.Blob.Enabled = blob.Enabled
+	}
+
+	if file := input.Encryption.Services.File; file != nil && file.Enabled != nil {
+		services.File.Enabled = file.Enabled
+	}
+
+	return &services
+}
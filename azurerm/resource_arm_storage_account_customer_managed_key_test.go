@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_version", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// pinning the version of the Key
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_keyVersion(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the Customer Managed Key should switch back to Microsoft Managed Keys
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_template(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_storage_account.test", "account_encryption_source", "Microsoft.Storage"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_account_customer_managed_key"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseStorageAccountID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
+		}
+
+		if props := resp.AccountProperties; props != nil && props.Encryption != nil {
+			if props.Encryption.KeySource == storage.KeySourceMicrosoftKeyvault {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Storage Account %q (Resource Group %q) isn't using a Customer Managed Key", id.Name, id.ResourceGroup)
+	}
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_basic(rString, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+  key_name           = "${azurerm_key_vault_key.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_keyVersion(rString, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+  key_name           = "${azurerm_key_vault_key.test.name}"
+  key_version        = "${azurerm_key_vault_key.test.version}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(rString, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "import" {
+  storage_account_id = "${azurerm_storage_account_customer_managed_key.test.storage_account_id}"
+  key_vault_id       = "${azurerm_storage_account_customer_managed_key.test.key_vault_id}"
+  key_name           = "${azurerm_storage_account_customer_managed_key.test.key_name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_template(rString, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name                 = "standard"
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+}
+
+resource "azurerm_key_vault_access_policy" "storage" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${azurerm_storage_account.test.identity.0.principal_id}"
+
+  key_permissions    = ["get", "create", "list", "restore", "recover", "unwrapkey", "wrapkey", "purge", "encrypt", "decrypt", "sign", "verify"]
+  secret_permissions = ["get"]
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions    = ["get", "create", "delete", "list", "restore", "recover", "unwrapkey", "wrapkey", "purge", "encrypt", "decrypt", "sign", "verify"]
+  secret_permissions = ["get"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "first"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.client", "azurerm_key_vault_access_policy.storage"]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, rString, location, rString, rString)
+}
@@ -8,17 +8,14 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 )
 
-// TODO: with the new SDK: changing the Tier of Blobs. Content type for Block blobs
-
-var supportsNewStorageFeatures = false
-
 func TestAccAzureRMStorageBlob_disappears(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -43,10 +40,6 @@ func TestAccAzureRMStorageBlob_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_appendEmpty(t *testing.T) {
-	if !supportsNewStorageFeatures {
-		t.Skip("Resource doesn't support Append Blobs Yet..")
-	}
-
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
@@ -74,10 +67,6 @@ func TestAccAzureRMStorageBlob_appendEmpty(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_appendEmptyMetaData(t *testing.T) {
-	if !supportsNewStorageFeatures {
-		t.Skip("Resource doesn't support Append Blobs Yet..")
-	}
-
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
@@ -104,6 +93,72 @@ func TestAccAzureRMStorageBlob_appendEmptyMetaData(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlob_appendFromInlineContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_fromInlineContent(ri, rs, location, "append"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, blobs.AppendBlob, "Wubba Lubba Dub Dub"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content", "type"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlob_appendFromLocalFile(t *testing.T) {
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := testAccAzureRMStorageBlob_populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_fromLocalBlob(ri, rs, location, "append", sourceBlob.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, blobs.AppendBlob, sourceBlob.Name()),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source", "type"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlob_blockEmpty(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -158,6 +213,47 @@ func TestAccAzureRMStorageBlob_blockEmptyMetaData(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlob_blockAccessTier(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_blockAccessTier(ri, rs, location, "Cool"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Cool"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "type"},
+			},
+			{
+				Config: testAccAzureRMStorageBlob_blockAccessTier(ri, rs, location, "Hot"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Hot"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "type"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlob_blockFromPublicBlob(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -263,7 +359,7 @@ func TestAccAzureRMStorageBlob_blockFromLocalFile(t *testing.T) {
 				Config: testAccAzureRMStorageBlob_blockFromLocalBlob(ri, rs, location, sourceBlob.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMStorageBlobExists(resourceName),
-					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, blobs.BlockBlob, sourceBlob.Name()),
 				),
 			},
 			{
@@ -276,6 +372,81 @@ func TestAccAzureRMStorageBlob_blockFromLocalFile(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlob_blockFromInlineContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_fromInlineContent(ri, rs, location, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, blobs.BlockBlob, "Wubba Lubba Dub Dub"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content", "type"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlob_blockFromLocalFileChanged(t *testing.T) {
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := ioutil.WriteFile(sourceBlob.Name(), []byte("Wubba Lubba Dub Dub"), 0644); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	config := testAccAzureRMStorageBlob_fromLocalBlob(ri, rs, location, "block", sourceBlob.Name())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, blobs.BlockBlob, sourceBlob.Name()),
+					resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				),
+			},
+			{
+				// changing the contents (but not the path) of the file should re-upload the Blob
+				PreConfig: func() {
+					if err := ioutil.WriteFile(sourceBlob.Name(), []byte("Get Schwifty"), 0644); err != nil {
+						t.Fatalf("Error updating temp file: %s", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, blobs.BlockBlob, sourceBlob.Name()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlob_contentType(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -420,7 +591,7 @@ func TestAccAzureRMStorageBlob_pageFromLocalFile(t *testing.T) {
 				Config: testAccAzureRMStorageBlob_pageFromLocalBlob(ri, rs, location, sourceBlob.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMStorageBlobExists(resourceName),
-					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypePage, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, blobs.PageBlob, sourceBlob.Name()),
 				),
 			},
 			{
@@ -433,6 +604,34 @@ func TestAccAzureRMStorageBlob_pageFromLocalFile(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlob_pageFromInlineContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_fromInlineContent(ri, rs, location, "page"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					testCheckAzureRMStorageBlobMatchesContent(resourceName, blobs.PageBlob, "Wubba Lubba Dub Dub"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content", "type"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlob_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -464,10 +663,6 @@ func TestAccAzureRMStorageBlob_requiresImport(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_update(t *testing.T) {
-	if !supportsNewStorageFeatures {
-		t.Skip("Current implementation doesn't support updating the Content Type..")
-	}
-
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
 	rs := strings.ToLower(acctest.RandString(11))
@@ -515,29 +710,33 @@ func testCheckAzureRMStorageBlobExists(resourceName string) resource.TestCheckFu
 		}
 
 		name := rs.Primary.Attributes["name"]
-		storageAccountName := rs.Primary.Attributes["storage_account_name"]
-		storageContainerName := rs.Primary.Attributes["storage_container_name"]
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		containerName := rs.Primary.Attributes["storage_container_name"]
+		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		armClient := testAccProvider.Meta().(*ArmClient)
-		ctx := armClient.StopContext
-		blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error finding Resource Group: %s", err)
 		}
-		if !accountExists {
-			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		if resourceGroup == nil {
+			return fmt.Errorf("Unable to locate Resource Group for Storage Account %q", accountName)
 		}
 
-		container := blobClient.GetContainerReference(storageContainerName)
-		blob := container.GetBlobReference(name)
-		exists, err := blob.Exists()
+		client, err := storageClient.BlobsClient(ctx, *resourceGroup, accountName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error building Blobs Client: %s", err)
 		}
 
-		if !exists {
-			return fmt.Errorf("Bad: Storage Blob %q (storage container: %q) does not exist", name, storageContainerName)
+		input := blobs.GetPropertiesInput{}
+		resp, err := client.GetProperties(ctx, accountName, containerName, name, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Blob %q (Container %q / Account %q / Resource Group %q) does not exist", name, containerName, accountName, *resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on BlobsClient: %+v", err)
 		}
 
 		return nil
@@ -553,29 +752,48 @@ func testCheckAzureRMStorageBlobDisappears(resourceName string) resource.TestChe
 		}
 
 		name := rs.Primary.Attributes["name"]
-		storageAccountName := rs.Primary.Attributes["storage_account_name"]
-		storageContainerName := rs.Primary.Attributes["storage_container_name"]
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		containerName := rs.Primary.Attributes["storage_container_name"]
+		accountName := rs.Primary.Attributes["storage_account_name"]
+
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
-		armClient := testAccProvider.Meta().(*ArmClient)
-		ctx := armClient.StopContext
-		blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error finding Resource Group: %s", err)
+		}
+		if resourceGroup == nil {
+			return fmt.Errorf("Unable to locate Resource Group for Storage Account %q", accountName)
+		}
+
+		client, err := storageClient.BlobsClient(ctx, *resourceGroup, accountName)
+		if err != nil {
+			return fmt.Errorf("Error building Blobs Client: %s", err)
 		}
-		if !accountExists {
-			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+
+		input := blobs.DeleteInput{
+			DeleteSnapshots: false,
+		}
+		if _, err := client.Delete(ctx, accountName, containerName, name, input); err != nil {
+			return fmt.Errorf("Error deleting Blob %q (Container %q / Account %q / Resource Group %q): %s", name, containerName, accountName, *resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageBlobMatchesFile(resourceName string, kind blobs.BlobType, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expectedContents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
 		}
 
-		container := blobClient.GetContainerReference(storageContainerName)
-		blob := container.GetBlobReference(name)
-		options := &storage.DeleteBlobOptions{}
-		_, err = blob.DeleteIfExists(options)
-		return err
+		return testCheckAzureRMStorageBlobMatchesContent(resourceName, kind, string(expectedContents))(s)
 	}
 }
 
-func testCheckAzureRMStorageBlobMatchesFile(resourceName string, kind storage.BlobType, filePath string) resource.TestCheckFunc {
+func testCheckAzureRMStorageBlobMatchesContent(resourceName string, kind blobs.BlobType, expectedContents string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -584,53 +802,47 @@ func testCheckAzureRMStorageBlobMatchesFile(resourceName string, kind storage.Bl
 		}
 
 		name := rs.Primary.Attributes["name"]
-		storageAccountName := rs.Primary.Attributes["storage_account_name"]
-		storageContainerName := rs.Primary.Attributes["storage_container_name"]
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		containerName := rs.Primary.Attributes["storage_container_name"]
+		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		armClient := testAccProvider.Meta().(*ArmClient)
-		ctx := armClient.StopContext
-		blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error finding Resource Group: %s", err)
 		}
-		if !accountExists {
-			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		if resourceGroup == nil {
+			return fmt.Errorf("Unable to locate Resource Group for Storage Account %q", accountName)
 		}
 
-		containerReference := blobClient.GetContainerReference(storageContainerName)
-		blobReference := containerReference.GetBlobReference(name)
-		propertyOptions := &storage.GetBlobPropertiesOptions{}
-		err = blobReference.GetProperties(propertyOptions)
+		client, err := storageClient.BlobsClient(ctx, *resourceGroup, accountName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Error building Blobs Client: %s", err)
 		}
 
-		properties := blobReference.Properties
-
-		if properties.BlobType != kind {
-			return fmt.Errorf("Bad: blob type %q does not match expected type %q", properties.BlobType, kind)
+		props, err := client.GetProperties(ctx, accountName, containerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			return fmt.Errorf("Error retrieving Properties for Blob %q (Container %q): %s", name, containerName, err)
 		}
 
-		getOptions := &storage.GetBlobOptions{}
-		blob, err := blobReference.Get(getOptions)
-		if err != nil {
-			return err
+		if props.BlobType != kind {
+			return fmt.Errorf("Bad: blob type %q does not match expected type %q", props.BlobType, kind)
 		}
 
-		contents, err := ioutil.ReadAll(blob)
+		blob, err := client.Get(ctx, accountName, containerName, name, blobs.GetInput{})
 		if err != nil {
-			return err
+			return fmt.Errorf("Error retrieving Blob %q (Container %q): %s", name, containerName, err)
 		}
-		defer blob.Close()
 
-		expectedContents, err := ioutil.ReadFile(filePath)
-		if err != nil {
-			return err
+		// page blobs are padded to a 512 byte boundary
+		contents := blob.Contents
+		if kind == blobs.PageBlob && len(contents) > len(expectedContents) {
+			contents = contents[0:len(expectedContents)]
 		}
 
-		if string(contents) != string(expectedContents) {
-			return fmt.Errorf("Bad: Storage Blob %q (storage container: %q) does not match contents", name, storageContainerName)
+		if string(contents) != expectedContents {
+			return fmt.Errorf("Bad: Storage Blob %q (storage container: %q) does not match contents", name, containerName)
 		}
 
 		return nil
@@ -644,33 +856,35 @@ func testCheckAzureRMStorageBlobDestroy(s *terraform.State) error {
 		}
 
 		name := rs.Primary.Attributes["name"]
-		storageAccountName := rs.Primary.Attributes["storage_account_name"]
-		storageContainerName := rs.Primary.Attributes["storage_container_name"]
-		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
-		if !hasResourceGroup {
-			return fmt.Errorf("Bad: no resource group found in state for storage blob: %s", name)
-		}
+		containerName := rs.Primary.Attributes["storage_container_name"]
+		accountName := rs.Primary.Attributes["storage_account_name"]
+
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
-		armClient := testAccProvider.Meta().(*ArmClient)
-		ctx := armClient.StopContext
-		blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 		if err != nil {
-			return nil
+			return fmt.Errorf("Error finding Resource Group: %s", err)
 		}
-		if !accountExists {
+		if resourceGroup == nil {
 			return nil
 		}
 
-		container := blobClient.GetContainerReference(storageContainerName)
-		blob := container.GetBlobReference(name)
-		exists, err := blob.Exists()
+		client, err := storageClient.BlobsClient(ctx, *resourceGroup, accountName)
 		if err != nil {
-			return nil
+			return fmt.Errorf("Error building Blobs Client: %s", err)
 		}
 
-		if exists {
-			return fmt.Errorf("Bad: Storage Blob %q (storage container: %q) still exists", name, storageContainerName)
+		props, err := client.GetProperties(ctx, accountName, containerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			if utils.ResponseWasNotFound(props.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Error retrieving Blob %q (Container %q): %s", name, containerName, err)
 		}
+
+		return fmt.Errorf("Bad: Storage Blob %q (Container %q) still exists", name, containerName)
 	}
 
 	return nil
@@ -744,6 +958,38 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func testAccAzureRMStorageBlob_blockAccessTier(rInt int, rString, location, accessTier string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  access_tier            = "%s"
+}
+`, rInt, location, rString, accessTier)
+}
+
 func testAccAzureRMStorageBlob_blockFromPublicBlob(rInt int, rString, location string) string {
 	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "blob")
 	return fmt.Sprintf(`
@@ -838,6 +1084,36 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName)
 }
 
+func testAccAzureRMStorageBlob_fromInlineContent(rInt int, rString, location, blobType string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "private")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "%s"
+  source_content         = "Wubba Lubba Dub Dub"
+}
+`, template, blobType)
+}
+
+func testAccAzureRMStorageBlob_fromLocalBlob(rInt int, rString, location, blobType, fileName string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "private")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "%s"
+  source                 = "%s"
+}
+`, template, blobType, fileName)
+}
+
 func testAccAzureRMStorageBlob_contentType(rInt int, rString, location string) string {
 	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "private")
 	return fmt.Sprintf(`
@@ -1,15 +1,20 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
 )
@@ -23,8 +28,13 @@ func resourceArmStorageContainer() *schema.Resource {
 		MigrateState:  resourceStorageContainerMigrateState,
 		SchemaVersion: 1,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(validateStorageContainerID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,7 +65,28 @@ func resourceArmStorageContainer() *schema.Resource {
 
 			"metadata": storage.MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			"immutability_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period_since_creation_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"locked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// TODO: support for ACL's and Legal Holds
 			"has_immutability_policy": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -72,12 +103,56 @@ func resourceArmStorageContainer() *schema.Resource {
 				Deprecated: "This field will be removed in version 2.0 of the Azure Provider",
 			},
 		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			if !d.HasChange("immutability_policy") {
+				return nil
+			}
+
+			o, n := d.GetChange("immutability_policy")
+			oldPeriod, oldLocked := expandStorageContainerImmutabilityPolicy(o.([]interface{}))
+			if !oldLocked {
+				return nil
+			}
+
+			// once locked an Immutability Policy can't be removed, unlocked or shortened - only extended
+			newPeriod, newLocked := expandStorageContainerImmutabilityPolicy(n.([]interface{}))
+			if newPeriod == nil || !newLocked {
+				return fmt.Errorf("A locked `immutability_policy` cannot be removed or unlocked")
+			}
+			if *newPeriod < *oldPeriod {
+				return fmt.Errorf("The `period_since_creation_in_days` of a locked `immutability_policy` can only be increased (from %d)", *oldPeriod)
+			}
+
+			return nil
+		},
+	}
+}
+
+func validateStorageContainerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := containers.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Container ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
 	}
+
+	return warnings, errors
 }
 
 func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	containerName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -123,12 +198,19 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating Container %q (Account %q / Resource Group %q): %s", containerName, accountName, *resourceGroup, err)
 	}
 
+	if v, ok := d.GetOk("immutability_policy"); ok {
+		if err := setStorageContainerImmutabilityPolicy(ctx, storageClient.BlobContainersClient, *resourceGroup, accountName, containerName, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageContainerRead(d, meta)
 }
 
 func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := containers.ParseResourceID(d.Id())
@@ -173,11 +255,20 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.ContainerName, id.AccountName, *resourceGroup)
 	}
 
+	if d.HasChange("immutability_policy") {
+		log.Printf("[DEBUG] Updating the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)..", id.ContainerName, id.AccountName, *resourceGroup)
+		if err := setStorageContainerImmutabilityPolicy(ctx, storageClient.BlobContainersClient, *resourceGroup, id.AccountName, id.ContainerName, d.Get("immutability_policy").([]interface{})); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Updated the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)", id.ContainerName, id.AccountName, *resourceGroup)
+	}
+
 	return resourceArmStorageContainerRead(d, meta)
 }
 
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := containers.ParseResourceID(d.Id())
@@ -228,11 +319,25 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
+	immutabilityPolicy := make([]interface{}, 0)
+	if props.HasImmutabilityPolicy {
+		policy, err := storageClient.BlobContainersClient.GetImmutabilityPolicy(ctx, *resourceGroup, id.AccountName, id.ContainerName, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Immutability Policy for Container %q (Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
+		}
+
+		immutabilityPolicy = flattenStorageContainerImmutabilityPolicy(policy)
+	}
+	if err := d.Set("immutability_policy", immutabilityPolicy); err != nil {
+		return fmt.Errorf("Error setting `immutability_policy`: %+v", err)
+	}
+
 	return nil
 }
 
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := containers.ParseResourceID(d.Id())
@@ -262,6 +367,116 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func setStorageContainerImmutabilityPolicy(ctx context.Context, client storageMgmt.BlobContainersClient, resourceGroup, accountName, containerName string, input []interface{}) error {
+	existing, err := client.GetImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, "")
+	if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("Error retrieving Immutability Policy for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+	}
+
+	existingState := storageMgmt.Unlocked
+	existingPeriod := int32(0)
+	if props := existing.ImmutabilityPolicyProperty; props != nil {
+		existingState = props.State
+		if props.ImmutabilityPeriodSinceCreationInDays != nil {
+			existingPeriod = *props.ImmutabilityPeriodSinceCreationInDays
+		}
+	}
+	etag := ""
+	if existing.Etag != nil {
+		etag = *existing.Etag
+	}
+
+	period, locked := expandStorageContainerImmutabilityPolicy(input)
+	if period == nil {
+		if existingState == storageMgmt.Locked {
+			return fmt.Errorf("The Immutability Policy for Container %q (Account %q / Resource Group %q) is locked and cannot be removed", containerName, accountName, resourceGroup)
+		}
+
+		if etag == "" {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Deleting the Immutability Policy for Container %q (Account %q / Resource Group %q)..", containerName, accountName, resourceGroup)
+		if _, err := client.DeleteImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag); err != nil {
+			return fmt.Errorf("Error deleting Immutability Policy for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	policy := storageMgmt.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storageMgmt.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: period,
+		},
+	}
+
+	if existingState == storageMgmt.Locked {
+		// a locked policy can only be extended
+		if !locked || *period < existingPeriod {
+			return fmt.Errorf("The Immutability Policy for Container %q (Account %q / Resource Group %q) is locked and can only be extended", containerName, accountName, resourceGroup)
+		}
+
+		if *period == existingPeriod {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Extending the Immutability Policy for Container %q (Account %q / Resource Group %q)..", containerName, accountName, resourceGroup)
+		if _, err := client.ExtendImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag, &policy); err != nil {
+			return fmt.Errorf("Error extending Immutability Policy for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Setting the Immutability Policy for Container %q (Account %q / Resource Group %q)..", containerName, accountName, resourceGroup)
+	updated, err := client.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, &policy, etag)
+	if err != nil {
+		return fmt.Errorf("Error setting Immutability Policy for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+	}
+
+	if locked {
+		if updated.Etag == nil {
+			return fmt.Errorf("Error locking Immutability Policy for Container %q (Account %q / Resource Group %q): `etag` was nil", containerName, accountName, resourceGroup)
+		}
+
+		log.Printf("[DEBUG] Locking the Immutability Policy for Container %q (Account %q / Resource Group %q)..", containerName, accountName, resourceGroup)
+		if _, err := client.LockImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, *updated.Etag); err != nil {
+			return fmt.Errorf("Error locking Immutability Policy for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageContainerImmutabilityPolicy(input []interface{}) (*int32, bool) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, false
+	}
+
+	v := input[0].(map[string]interface{})
+	period := int32(v["period_since_creation_in_days"].(int))
+	return &period, v["locked"].(bool)
+}
+
+func flattenStorageContainerImmutabilityPolicy(input storageMgmt.ImmutabilityPolicy) []interface{} {
+	props := input.ImmutabilityPolicyProperty
+	if props == nil {
+		return []interface{}{}
+	}
+
+	period := 0
+	if props.ImmutabilityPeriodSinceCreationInDays != nil {
+		period = int(*props.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"period_since_creation_in_days": period,
+			"locked":                        props.State == storageMgmt.Locked,
+		},
+	}
+}
+
 func flattenStorageContainerProperties(input containers.ContainerProperties) map[string]interface{} {
 	output := map[string]interface{}{
 		"last_modified":  input.Header.Get("Last-Modified"),
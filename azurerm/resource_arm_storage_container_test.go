@@ -154,6 +154,58 @@ func TestAccAzureRMStorageContainer_metaData(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageContainer_immutabilityPolicy(t *testing.T) {
+	resourceName := "azurerm_storage_container.test"
+
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	// NOTE: a locked Immutability Policy prevents the Container from being deleted
+	// as such we only test unlocked policies here
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageContainer_immutabilityPolicy(ri, rs, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_immutability_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.period_since_creation_in_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.locked", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageContainer_immutabilityPolicy(ri, rs, location, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.period_since_creation_in_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageContainer_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_immutability_policy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageContainer_disappears(t *testing.T) {
 	resourceName := "azurerm_storage_container.test"
 	ri := tf.AccRandTimeInt()
@@ -442,6 +494,23 @@ resource "azurerm_storage_container" "test" {
 `, template)
 }
 
+func testAccAzureRMStorageContainer_immutabilityPolicy(rInt int, rString string, location string, days int) string {
+	template := testAccAzureRMStorageContainer_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+
+  immutability_policy {
+    period_since_creation_in_days = %d
+  }
+}
+`, template, days)
+}
+
 func testAccAzureRMStorageContainer_root(rInt int, rString string, location string) string {
 	template := testAccAzureRMStorageContainer_template(rInt, rString, location)
 	return fmt.Sprintf(`
@@ -0,0 +1,290 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/filesystems"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageDataLakeGen2FileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmStorageDataLakeGen2FileSystemCreate,
+		Read:     resourceArmStorageDataLakeGen2FileSystemRead,
+		Update:   resourceArmStorageDataLakeGen2FileSystemUpdate,
+		Delete:   resourceArmStorageDataLakeGen2FileSystemDelete,
+		Importer: resourceid.ValidatingImporter(validateStorageDataLakeGen2FileSystemID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func validateStorageDataLakeGen2FileSystemID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := filesystems.ParseResourceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Data Lake Gen2 File System ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateStorageDataLakeGen2FileSystemName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, contain only lowercase letters, numbers and hyphens, and must start and end with a letter or number: %q", k, value))
+	}
+
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func resourceArmStorageDataLakeGen2FileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	fileSystemName := d.Get("name").(string)
+	accountName := storageAccountId.Name
+	resourceGroup := storageAccountId.ResourceGroup
+
+	if err := requireStorageAccountHierarchicalNamespace(ctx, meta, resourceGroup, accountName); err != nil {
+		return err
+	}
+
+	client, err := storageClient.DataLakeFileSystemsClient(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 File Systems Client for Storage Account %q (Resource Group %q): %s", accountName, resourceGroup, err)
+	}
+
+	id := client.GetResourceID(accountName, fileSystemName)
+	if requireResourcesToBeImported {
+		existing, err := client.GetProperties(ctx, accountName, fileSystemName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existence of existing File System %q (Account %q / Resource Group %q): %+v", fileSystemName, accountName, resourceGroup, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_storage_data_lake_gen2_filesystem", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating File System %q in Storage Account %q", fileSystemName, accountName)
+	input := filesystems.CreateInput{
+		Properties: expandStorageDataLakeGen2FileSystemProperties(d.Get("properties").(map[string]interface{})),
+	}
+	if _, err := client.Create(ctx, accountName, fileSystemName, input); err != nil {
+		return fmt.Errorf("Error creating File System %q (Account %q / Resource Group %q): %s", fileSystemName, accountName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+	return resourceArmStorageDataLakeGen2FileSystemRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2FileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := filesystems.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := storageClient.DataLakeFileSystemsClient(ctx, storageAccountId.ResourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 File Systems Client for Storage Account %q (Resource Group %q): %s", id.AccountName, storageAccountId.ResourceGroup, err)
+	}
+
+	if d.HasChange("properties") {
+		log.Printf("[DEBUG] Updating the Properties for File System %q (Storage Account %q / Resource Group %q)..", id.FileSystemName, id.AccountName, storageAccountId.ResourceGroup)
+		input := filesystems.SetPropertiesInput{
+			Properties: expandStorageDataLakeGen2FileSystemProperties(d.Get("properties").(map[string]interface{})),
+		}
+		if _, err := client.SetProperties(ctx, id.AccountName, id.FileSystemName, input); err != nil {
+			return fmt.Errorf("Error updating the Properties for File System %q (Storage Account %q / Resource Group %q): %s", id.FileSystemName, id.AccountName, storageAccountId.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated the Properties for File System %q (Storage Account %q / Resource Group %q)", id.FileSystemName, id.AccountName, storageAccountId.ResourceGroup)
+	}
+
+	return resourceArmStorageDataLakeGen2FileSystemRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2FileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := filesystems.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error locating Resource Group for File System %q (Account %s): %s", id.FileSystemName, id.AccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for File System %q (Account %s) - assuming removed & removing from state", id.FileSystemName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.DataLakeFileSystemsClient(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 File Systems Client for Storage Account %q (Resource Group %q): %s", id.AccountName, *resourceGroup, err)
+	}
+
+	props, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName)
+	if err != nil {
+		if utils.ResponseWasNotFound(props.Response) {
+			log.Printf("[DEBUG] File System %q was not found in Account %q / Resource Group %q - assuming removed & removing from state", id.FileSystemName, id.AccountName, *resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving File System %q (Account %q / Resource Group %q): %s", id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	d.Set("name", id.FileSystemName)
+	d.Set("storage_account_id", resourceid.NewStorageAccountID(meta.(*ArmClient).subscriptionId, *resourceGroup, id.AccountName).String())
+
+	if err := d.Set("properties", flattenStorageDataLakeGen2FileSystemProperties(props.Properties)); err != nil {
+		return fmt.Errorf("Error setting `properties`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageDataLakeGen2FileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := filesystems.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error locating Resource Group for File System %q (Account %s): %s", id.FileSystemName, id.AccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for File System %q (Account %s) - assuming removed & removing from state", id.FileSystemName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.DataLakeFileSystemsClient(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 File Systems Client for Storage Account %q (Resource Group %q): %s", id.AccountName, *resourceGroup, err)
+	}
+
+	resp, err := client.Delete(ctx, id.AccountName, id.FileSystemName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting File System %q (Storage Account %q / Resource Group %q): %s", id.FileSystemName, id.AccountName, *resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// requireStorageAccountHierarchicalNamespace ensures that the Storage Account exists and has the
+// Hierarchical Namespace enabled, since otherwise the DFS endpoint returns an unhelpful error
+func requireStorageAccountHierarchicalNamespace(ctx context.Context, meta interface{}, resourceGroup, accountName string) error {
+	client := meta.(*ArmClient).storageServiceClient
+
+	account, err := client.GetProperties(ctx, resourceGroup, accountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if props := account.AccountProperties; props == nil || props.IsHnsEnabled == nil || !*props.IsHnsEnabled {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) must have `is_hns_enabled` set to `true` to use Data Lake Storage Gen2", accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func expandStorageDataLakeGen2FileSystemProperties(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+func flattenStorageDataLakeGen2FileSystemProperties(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/filesystems"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_basic(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_data_lake_gen2_filesystem"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_properties(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_properties(ri, rs, location, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.key", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_properties(ri, rs, location, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.key", "value2"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := filesystems.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error finding Resource Group: %s", err)
+		}
+		if resourceGroup == nil {
+			return fmt.Errorf("Unable to locate Resource Group for Storage Account %q", id.AccountName)
+		}
+
+		client, err := storageClient.DataLakeFileSystemsClient(ctx, *resourceGroup, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Data Lake Gen2 File Systems Client: %s", err)
+		}
+
+		resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: File System %q (Storage Account %q) does not exist", id.FileSystemName, id.AccountName)
+			}
+
+			return fmt.Errorf("Bad: Get on DataLakeFileSystemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageDataLakeGen2FileSystemDestroy(s *terraform.State) error {
+	storageClient := testAccProvider.Meta().(*ArmClient).storage
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_data_lake_gen2_filesystem" {
+			continue
+		}
+
+		id, err := filesystems.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error finding Resource Group: %s", err)
+		}
+
+		// expected since the storage account's been deleted
+		if resourceGroup == nil {
+			return nil
+		}
+
+		client, err := storageClient.DataLakeFileSystemsClient(ctx, *resourceGroup, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Data Lake Gen2 File Systems Client: %s", err)
+		}
+
+		resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("File System %q (Storage Account %q) still exists", id.FileSystemName, id.AccountName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "import" {
+  name               = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_data_lake_gen2_filesystem.test.storage_account_id}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_properties(rInt int, rString, location, value string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  properties = {
+    key = "%s"
+  }
+}
+`, template, rInt, value)
+}
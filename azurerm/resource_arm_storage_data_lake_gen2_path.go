@@ -0,0 +1,413 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/paths"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageDataLakeGen2Path() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmStorageDataLakeGen2PathCreate,
+		Read:     resourceArmStorageDataLakeGen2PathRead,
+		Update:   resourceArmStorageDataLakeGen2PathUpdate,
+		Delete:   resourceArmStorageDataLakeGen2PathDelete,
+		Importer: resourceid.ValidatingImporter(validateStorageDataLakeGen2PathID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2PathName,
+			},
+
+			"filesystem_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			// TODO: support for Files, once uploading content is supported
+			"resource": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(paths.PathResourceDirectory),
+				}, false),
+			},
+
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStorageDataLakeGen2PathOwner,
+			},
+
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStorageDataLakeGen2PathOwner,
+			},
+
+			"ace": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "access",
+							ValidateFunc: validation.StringInSlice([]string{
+								"access",
+								"default",
+							}, false),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(paths.TagTypeUser),
+								string(paths.TagTypeGroup),
+								string(paths.TagTypeMask),
+								string(paths.TagTypeOther),
+							}, false),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"permissions": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[r-][w-][x-]$`),
+								"permissions must be in the format `rwx`, using a `-` for a permission which isn't granted",
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateStorageDataLakeGen2PathID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := paths.ParseResourceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Data Lake Gen2 Path ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateStorageDataLakeGen2PathName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return
+	}
+
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot start or end with a `/`: %q", k, value))
+	}
+
+	if strings.Contains(value, "//") {
+		errors = append(errors, fmt.Errorf("%q cannot contain an empty segment: %q", k, value))
+	}
+
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1024 characters: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func validateStorageDataLakeGen2PathOwner(v interface{}, k string) (warnings []string, errors []error) {
+	// the Owner/Group is either an Object ID, or the Super User
+	if v.(string) == "$superuser" {
+		return
+	}
+
+	return validate.UUID(v, k)
+}
+
+func resourceArmStorageDataLakeGen2PathCreate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	path := d.Get("path").(string)
+	fileSystemName := d.Get("filesystem_name").(string)
+	accountName := storageAccountId.Name
+	resourceGroup := storageAccountId.ResourceGroup
+
+	if err := requireStorageAccountHierarchicalNamespace(ctx, meta, resourceGroup, accountName); err != nil {
+		return err
+	}
+
+	client, err := storageClient.DataLakePathsClient(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 Paths Client for Storage Account %q (Resource Group %q): %s", accountName, resourceGroup, err)
+	}
+
+	id := client.GetResourceID(accountName, fileSystemName, path)
+	if requireResourcesToBeImported {
+		existing, err := client.GetProperties(ctx, accountName, fileSystemName, path, paths.GetPropertiesActionGetStatus)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existence of existing Path %q (File System %q / Account %q / Resource Group %q): %+v", path, fileSystemName, accountName, resourceGroup, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_storage_data_lake_gen2_path", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating Path %q in File System %q (Storage Account %q)", path, fileSystemName, accountName)
+	input := paths.CreateInput{
+		Resource: paths.PathResource(d.Get("resource").(string)),
+	}
+	if _, err := client.Create(ctx, accountName, fileSystemName, path, input); err != nil {
+		return fmt.Errorf("Error creating Path %q (File System %q / Account %q / Resource Group %q): %s", path, fileSystemName, accountName, resourceGroup, err)
+	}
+
+	accessControl := expandStorageDataLakeGen2PathAccessControl(d, false)
+	if accessControl.Owner != nil || accessControl.Group != nil || accessControl.ACL != nil {
+		if _, err := client.SetAccessControl(ctx, accountName, fileSystemName, path, accessControl); err != nil {
+			return fmt.Errorf("Error setting the Access Control for Path %q (File System %q / Account %q / Resource Group %q): %s", path, fileSystemName, accountName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(id)
+	return resourceArmStorageDataLakeGen2PathRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2PathUpdate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := storageClient.DataLakePathsClient(ctx, storageAccountId.ResourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 Paths Client for Storage Account %q (Resource Group %q): %s", id.AccountName, storageAccountId.ResourceGroup, err)
+	}
+
+	accessControl := expandStorageDataLakeGen2PathAccessControl(d, true)
+	if accessControl.Owner != nil || accessControl.Group != nil || accessControl.ACL != nil {
+		log.Printf("[DEBUG] Updating the Access Control for Path %q (File System %q / Storage Account %q)..", id.Path, id.FileSystemName, id.AccountName)
+		if _, err := client.SetAccessControl(ctx, id.AccountName, id.FileSystemName, id.Path, accessControl); err != nil {
+			return fmt.Errorf("Error updating the Access Control for Path %q (File System %q / Account %q / Resource Group %q): %s", id.Path, id.FileSystemName, id.AccountName, storageAccountId.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated the Access Control for Path %q (File System %q / Storage Account %q)", id.Path, id.FileSystemName, id.AccountName)
+	}
+
+	return resourceArmStorageDataLakeGen2PathRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2PathRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error locating Resource Group for Path %q (File System %q / Account %s): %s", id.Path, id.FileSystemName, id.AccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Path %q (File System %q / Account %s) - assuming removed & removing from state", id.Path, id.FileSystemName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.DataLakePathsClient(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 Paths Client for Storage Account %q (Resource Group %q): %s", id.AccountName, *resourceGroup, err)
+	}
+
+	status, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(status.Response) {
+			log.Printf("[DEBUG] Path %q was not found in File System %q (Account %q / Resource Group %q) - assuming removed & removing from state", id.Path, id.FileSystemName, id.AccountName, *resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Path %q (File System %q / Account %q / Resource Group %q): %s", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	accessControl, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetAccessControl)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Access Control for Path %q (File System %q / Account %q / Resource Group %q): %s", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	acl, err := paths.ParseACL(accessControl.ACL)
+	if err != nil {
+		return fmt.Errorf("Error parsing the ACL %q for Path %q (File System %q / Account %q): %s", accessControl.ACL, id.Path, id.FileSystemName, id.AccountName, err)
+	}
+
+	d.Set("path", id.Path)
+	d.Set("filesystem_name", id.FileSystemName)
+	d.Set("storage_account_id", resourceid.NewStorageAccountID(meta.(*ArmClient).subscriptionId, *resourceGroup, id.AccountName).String())
+	d.Set("resource", string(status.ResourceType))
+	d.Set("owner", accessControl.Owner)
+	d.Set("group", accessControl.Group)
+
+	if err := d.Set("ace", flattenStorageDataLakeGen2PathACL(*acl)); err != nil {
+		return fmt.Errorf("Error setting `ace`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageDataLakeGen2PathDelete(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error locating Resource Group for Path %q (File System %q / Account %s): %s", id.Path, id.FileSystemName, id.AccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Path %q (File System %q / Account %s) - assuming removed & removing from state", id.Path, id.FileSystemName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.DataLakePathsClient(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("Error building Data Lake Gen2 Paths Client for Storage Account %q (Resource Group %q): %s", id.AccountName, *resourceGroup, err)
+	}
+
+	resp, err := client.Delete(ctx, id.AccountName, id.FileSystemName, id.Path)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Path %q (File System %q / Account %q / Resource Group %q): %s", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// expandStorageDataLakeGen2PathAccessControl returns the Access Control to set - which when updating only
+// includes the fields which have changed, since each field which is omitted is left as-is by the API
+func expandStorageDataLakeGen2PathAccessControl(d *schema.ResourceData, onlyChanges bool) paths.SetAccessControlInput {
+	input := paths.SetAccessControlInput{}
+
+	if v, ok := d.GetOk("owner"); ok && (!onlyChanges || d.HasChange("owner")) {
+		input.Owner = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("group"); ok && (!onlyChanges || d.HasChange("group")) {
+		input.Group = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ace"); ok && (!onlyChanges || d.HasChange("ace")) {
+		acl := expandStorageDataLakeGen2PathACL(v.(*schema.Set).List())
+		input.ACL = utils.String(acl.String())
+	}
+
+	return input
+}
+
+func expandStorageDataLakeGen2PathACL(input []interface{}) paths.ACL {
+	acl := paths.ACL{
+		Entries: make([]paths.ACE, 0),
+	}
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		acl.Entries = append(acl.Entries, paths.ACE{
+			IsDefault:    raw["scope"].(string) == "default",
+			TagType:      paths.TagType(raw["type"].(string)),
+			TagQualifier: raw["id"].(string),
+			Permissions:  raw["permissions"].(string),
+		})
+	}
+
+	return acl
+}
+
+func flattenStorageDataLakeGen2PathACL(input paths.ACL) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, v := range input.Entries {
+		scope := "access"
+		if v.IsDefault {
+			scope = "default"
+		}
+
+		output = append(output, map[string]interface{}{
+			"scope":       scope,
+			"type":        string(v.TagType),
+			"id":          v.TagQualifier,
+			"permissions": v.Permissions,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,266 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/datalakestore/paths"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageDataLakeGen2Path_basic(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource", "directory"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "group"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2Path_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageDataLakeGen2Path_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_data_lake_gen2_path"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2Path_withACL(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_withACL(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "owner", "azurerm_user_assigned_identity.test", "principal_id"),
+					resource.TestCheckResourceAttr(resourceName, "ace.#", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageDataLakeGen2PathExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := paths.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		storageClient := testAccProvider.Meta().(*ArmClient).storage
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error finding Resource Group: %s", err)
+		}
+		if resourceGroup == nil {
+			return fmt.Errorf("Unable to locate Resource Group for Storage Account %q", id.AccountName)
+		}
+
+		client, err := storageClient.DataLakePathsClient(ctx, *resourceGroup, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Data Lake Gen2 Paths Client: %s", err)
+		}
+
+		resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Path %q (File System %q / Storage Account %q) does not exist", id.Path, id.FileSystemName, id.AccountName)
+			}
+
+			return fmt.Errorf("Bad: Get on DataLakePathsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageDataLakeGen2PathDestroy(s *terraform.State) error {
+	storageClient := testAccProvider.Meta().(*ArmClient).storage
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_data_lake_gen2_path" {
+			continue
+		}
+
+		id, err := paths.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error finding Resource Group: %s", err)
+		}
+
+		// expected since the storage account's been deleted
+		if resourceGroup == nil {
+			return nil
+		}
+
+		client, err := storageClient.DataLakePathsClient(ctx, *resourceGroup, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Data Lake Gen2 Paths Client: %s", err)
+		}
+
+		resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Path %q (File System %q / Storage Account %q) still exists", id.Path, id.FileSystemName, id.AccountName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_basic(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  path               = "testpath/nested"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  resource           = "directory"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_requiresImport(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2Path_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "import" {
+  path               = "${azurerm_storage_data_lake_gen2_path.test.path}"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_path.test.filesystem_name}"
+  storage_account_id = "${azurerm_storage_data_lake_gen2_path.test.storage_account_id}"
+  resource           = "${azurerm_storage_data_lake_gen2_path.test.resource}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_withACL(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  path               = "testpath/nested"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  resource           = "directory"
+  owner              = "${azurerm_user_assigned_identity.test.principal_id}"
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "user"
+    id          = "${azurerm_user_assigned_identity.test.principal_id}"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,443 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmStorageManagementPolicyCreateUpdate,
+		Read:     resourceArmStorageManagementPolicyRead,
+		Update:   resourceArmStorageManagementPolicyCreateUpdate,
+		Delete:   resourceArmStorageManagementPolicyDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStorageManagementPolicyID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-zA-Z0-9]*$`),
+								"A rule name can contain any combination of alpha numeric characters.",
+							),
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := storageAccountId.ResourceGroup
+	accountName := storageAccountId.Name
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %s", accountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", *existing.ID)
+		}
+	}
+
+	rules, err := expandStorageManagementPolicyRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `rule` for Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	parameters := storage.ManagementPolicy{
+		ManagementPolicyProperties: &storage.ManagementPolicyProperties{
+			Policy: &storage.ManagementPolicySchema{
+				Rules: rules,
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Management Policy for Storage Account %q (Resource Group %q)", accountName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q was not found in Resource Group %q - removing from state!", id.StorageAccountName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", id.StorageAccountName, id.ResourceGroup, err)
+	}
+
+	storageAccountId := resourceid.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+	d.Set("storage_account_id", storageAccountId.String())
+
+	var rules *[]storage.ManagementPolicyRule
+	if props := resp.ManagementPolicyProperties; props != nil && props.Policy != nil {
+		rules = props.Policy.Rules
+	}
+
+	if err := d.Set("rule", flattenStorageManagementPolicyRules(rules)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", id.StorageAccountName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) (*[]storage.ManagementPolicyRule, error) {
+	rules := make([]storage.ManagementPolicyRule, 0)
+
+	for _, v := range input {
+		rule := v.(map[string]interface{})
+		name := rule["name"].(string)
+
+		definition := storage.ManagementPolicyDefinition{
+			Filters: expandStorageManagementPolicyFilters(rule["filters"].([]interface{})),
+		}
+
+		actions := rule["actions"].([]interface{})
+		if len(actions) == 0 || actions[0] == nil {
+			return nil, fmt.Errorf("rule %q must specify at least one action", name)
+		}
+		definition.Actions = expandStorageManagementPolicyActions(actions[0].(map[string]interface{}))
+		if definition.Actions.BaseBlob == nil && definition.Actions.Snapshot == nil {
+			return nil, fmt.Errorf("rule %q must specify at least one action for either `base_blob` or `snapshot`", name)
+		}
+
+		rules = append(rules, storage.ManagementPolicyRule{
+			Name:       utils.String(name),
+			Enabled:    utils.Bool(rule["enabled"].(bool)),
+			Type:       utils.String("Lifecycle"),
+			Definition: &definition,
+		})
+	}
+
+	return &rules, nil
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) *storage.ManagementPolicyFilter {
+	filters := storage.ManagementPolicyFilter{
+		// the API requires that the Blob Types are specified, and at this time only Block Blobs are supported
+		BlobTypes: &[]string{"blockBlob"},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &filters
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if prefixes := v["prefix_match"].(*schema.Set).List(); len(prefixes) > 0 {
+		filters.PrefixMatch = utils.ExpandStringSlice(prefixes)
+	}
+
+	if blobTypes := v["blob_types"].(*schema.Set).List(); len(blobTypes) > 0 {
+		filters.BlobTypes = utils.ExpandStringSlice(blobTypes)
+	}
+
+	return &filters
+}
+
+func expandStorageManagementPolicyActions(input map[string]interface{}) *storage.ManagementPolicyAction {
+	actions := storage.ManagementPolicyAction{}
+
+	if baseBlobs := input["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		baseBlob := baseBlobs[0].(map[string]interface{})
+		policy := storage.ManagementPolicyBaseBlob{}
+
+		if v, ok := baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int); ok && v > 0 {
+			policy.TierToCool = &storage.DateAfterModification{
+				DaysAfterModificationGreaterThan: utils.Float(float64(v)),
+			}
+		}
+		if v, ok := baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int); ok && v > 0 {
+			policy.TierToArchive = &storage.DateAfterModification{
+				DaysAfterModificationGreaterThan: utils.Float(float64(v)),
+			}
+		}
+		if v, ok := baseBlob["delete_after_days_since_modification_greater_than"].(int); ok && v > 0 {
+			policy.Delete = &storage.DateAfterModification{
+				DaysAfterModificationGreaterThan: utils.Float(float64(v)),
+			}
+		}
+
+		if policy.TierToCool != nil || policy.TierToArchive != nil || policy.Delete != nil {
+			actions.BaseBlob = &policy
+		}
+	}
+
+	if snapshots := input["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		snapshot := snapshots[0].(map[string]interface{})
+
+		if v, ok := snapshot["delete_after_days_since_creation_greater_than"].(int); ok && v > 0 {
+			actions.Snapshot = &storage.ManagementPolicySnapShot{
+				Delete: &storage.DateAfterCreation{
+					DaysAfterCreationGreaterThan: utils.Float(float64(v)),
+				},
+			}
+		}
+	}
+
+	return &actions
+}
+
+func flattenStorageManagementPolicyRules(input *[]storage.ManagementPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		enabled := false
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+		if definition := rule.Definition; definition != nil {
+			filters = flattenStorageManagementPolicyFilters(definition.Filters)
+			actions = flattenStorageManagementPolicyActions(definition.Actions)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    name,
+			"enabled": enabled,
+			"filters": filters,
+			"actions": actions,
+		})
+	}
+
+	return results
+}
+
+func flattenStorageManagementPolicyFilters(input *storage.ManagementPolicyFilter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix_match": schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.PrefixMatch)),
+			"blob_types":   schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.BlobTypes)),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input *storage.ManagementPolicyAction) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseBlobs := make([]interface{}, 0)
+	if baseBlob := input.BaseBlob; baseBlob != nil {
+		tierToCool := 0
+		if baseBlob.TierToCool != nil && baseBlob.TierToCool.DaysAfterModificationGreaterThan != nil {
+			tierToCool = int(*baseBlob.TierToCool.DaysAfterModificationGreaterThan)
+		}
+
+		tierToArchive := 0
+		if baseBlob.TierToArchive != nil && baseBlob.TierToArchive.DaysAfterModificationGreaterThan != nil {
+			tierToArchive = int(*baseBlob.TierToArchive.DaysAfterModificationGreaterThan)
+		}
+
+		deleteAfter := 0
+		if baseBlob.Delete != nil && baseBlob.Delete.DaysAfterModificationGreaterThan != nil {
+			deleteAfter = int(*baseBlob.Delete.DaysAfterModificationGreaterThan)
+		}
+
+		baseBlobs = append(baseBlobs, map[string]interface{}{
+			"tier_to_cool_after_days_since_modification_greater_than":    tierToCool,
+			"tier_to_archive_after_days_since_modification_greater_than": tierToArchive,
+			"delete_after_days_since_modification_greater_than":          deleteAfter,
+		})
+	}
+
+	snapshots := make([]interface{}, 0)
+	if snapshot := input.Snapshot; snapshot != nil {
+		deleteAfter := 0
+		if snapshot.Delete != nil && snapshot.Delete.DaysAfterCreationGreaterThan != nil {
+			deleteAfter = int(*snapshot.Delete.DaysAfterCreationGreaterThan)
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"delete_after_days_since_creation_greater_than": deleteAfter,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_blob": baseBlobs,
+			"snapshot":  snapshots,
+		},
+	}
+}
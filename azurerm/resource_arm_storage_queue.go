@@ -4,27 +4,35 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageQueueCreate,
-		Read:   resourceArmStorageQueueRead,
-		Update: resourceArmStorageQueueUpdate,
-		Delete: resourceArmStorageQueueDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmStorageQueueCreate,
+		Read:          resourceArmStorageQueueRead,
+		Update:        resourceArmStorageQueueUpdate,
+		Delete:        resourceArmStorageQueueDelete,
+		Importer:      resourceid.ValidatingImporter(validateStorageQueueID),
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageQueueMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -47,6 +55,26 @@ func resourceArmStorageQueue() *schema.Resource {
 	}
 }
 
+func validateStorageQueueID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := queues.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Queue ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func validateArmStorageQueueName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 
@@ -78,7 +106,8 @@ func validateArmStorageQueueName(v interface{}, k string) (warnings []string, er
 
 func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	queueClient := meta.(*ArmClient).storage.QueuesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	queueName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -111,7 +140,8 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
@@ -130,7 +160,8 @@ func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
@@ -171,7 +202,8 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
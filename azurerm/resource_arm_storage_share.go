@@ -4,26 +4,27 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/shares"
 )
 
 func resourceArmStorageShare() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageShareCreate,
-		Read:   resourceArmStorageShareRead,
-		Update: resourceArmStorageShareUpdate,
-		Delete: resourceArmStorageShareDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmStorageShareCreate,
+		Read:          resourceArmStorageShareRead,
+		Update:        resourceArmStorageShareUpdate,
+		Delete:        resourceArmStorageShareDelete,
+		Importer:      resourceid.ValidatingImporter(validateStorageShareID),
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -39,6 +40,13 @@ func resourceArmStorageShare() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -108,8 +116,30 @@ func resourceArmStorageShare() *schema.Resource {
 		},
 	}
 }
+
+func validateStorageShareID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := shares.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Share ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	accountName := d.Get("storage_account_name").(string)
@@ -168,7 +198,8 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := shares.ParseResourceID(d.Id())
@@ -227,7 +258,8 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := shares.ParseResourceID(d.Id())
@@ -290,7 +322,8 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := shares.ParseResourceID(d.Id())
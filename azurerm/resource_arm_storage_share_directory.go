@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/directories"
 )
 
 func resourceArmStorageShareDirectory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageShareDirectoryCreate,
-		Read:   resourceArmStorageShareDirectoryRead,
-		Update: resourceArmStorageShareDirectoryUpdate,
-		Delete: resourceArmStorageShareDirectoryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmStorageShareDirectoryCreate,
+		Read:     resourceArmStorageShareDirectoryRead,
+		Update:   resourceArmStorageShareDirectoryUpdate,
+		Delete:   resourceArmStorageShareDirectoryDelete,
+		Importer: resourceid.ValidatingImporter(validateStorageShareDirectoryID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,8 +55,29 @@ func resourceArmStorageShareDirectory() *schema.Resource {
 	}
 }
 
+func validateStorageShareDirectoryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := directories.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Share Directory ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	accountName := d.Get("storage_account_name").(string)
@@ -96,7 +125,8 @@ func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := directories.ParseResourceID(d.Id())
@@ -130,7 +160,8 @@ func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := directories.ParseResourceID(d.Id())
@@ -170,7 +201,8 @@ func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := directories.ParseResourceID(d.Id())
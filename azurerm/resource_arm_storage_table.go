@@ -4,25 +4,26 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/tables"
 )
 
 func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageTableCreate,
-		Read:   resourceArmStorageTableRead,
-		Delete: resourceArmStorageTableDelete,
-		Update: resourceArmStorageTableUpdate,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmStorageTableCreate,
+		Read:          resourceArmStorageTableRead,
+		Delete:        resourceArmStorageTableDelete,
+		Update:        resourceArmStorageTableUpdate,
+		Importer:      resourceid.ValidatingImporter(validateStorageTableID),
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -38,6 +39,13 @@ func resourceArmStorageTable() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -96,8 +104,29 @@ func resourceArmStorageTable() *schema.Resource {
 	}
 }
 
+func validateStorageTableID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := tables.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Table ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	tableName := d.Get("name").(string)
@@ -147,7 +176,8 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -199,7 +229,8 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -231,7 +262,8 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -3,22 +3,30 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/entities"
 )
 
 func resourceArmStorageTableEntity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageTableEntityCreateUpdate,
-		Read:   resourceArmStorageTableEntityRead,
-		Update: resourceArmStorageTableEntityCreateUpdate,
-		Delete: resourceArmStorageTableEntityDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmStorageTableEntityCreateUpdate,
+		Read:     resourceArmStorageTableEntityRead,
+		Update:   resourceArmStorageTableEntityCreateUpdate,
+		Delete:   resourceArmStorageTableEntityDelete,
+		Importer: resourceid.ValidatingImporter(validateStorageTableEntityID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,8 +65,29 @@ func resourceArmStorageTableEntity() *schema.Resource {
 	}
 }
 
+func validateStorageTableEntityID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := entities.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Table Entity ID: %+v", k, err))
+		return
+	}
+
+	if id.AccountName == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to contain a Storage Account Name but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func resourceArmStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	accountName := d.Get("storage_account_name").(string)
@@ -122,7 +151,8 @@ func resourceArmStorageTableEntityCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := entities.ParseResourceID(d.Id())
@@ -168,7 +198,8 @@ func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 
 	id, err := entities.ParseResourceID(d.Id())
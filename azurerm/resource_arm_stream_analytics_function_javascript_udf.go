@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -13,17 +14,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmStreamAnalyticsFunctionUDF() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStreamAnalyticsFunctionUDFCreateUpdate,
-		Read:   resourceArmStreamAnalyticsFunctionUDFRead,
-		Update: resourceArmStreamAnalyticsFunctionUDFCreateUpdate,
-		Delete: resourceArmStreamAnalyticsFunctionUDFDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmStreamAnalyticsFunctionUDFCreateUpdate,
+		Read:     resourceArmStreamAnalyticsFunctionUDFRead,
+		Update:   resourceArmStreamAnalyticsFunctionUDFCreateUpdate,
+		Delete:   resourceArmStreamAnalyticsFunctionUDFDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStreamAnalyticsFunctionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,7 +109,8 @@ func resourceArmStreamAnalyticsFunctionUDF() *schema.Resource {
 
 func resourceArmStreamAnalyticsFunctionUDFCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.FunctionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Function Javascript UDF creation.")
 	name := d.Get("name").(string)
@@ -169,15 +178,16 @@ func resourceArmStreamAnalyticsFunctionUDFCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmStreamAnalyticsFunctionUDFRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.FunctionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsFunctionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	jobName := id.Path["streamingjobs"]
-	name := id.Path["functions"]
+	jobName := id.StreamingjobName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, jobName, name)
 	if err != nil {
@@ -223,15 +233,16 @@ func resourceArmStreamAnalyticsFunctionUDFRead(d *schema.ResourceData, meta inte
 
 func resourceArmStreamAnalyticsFunctionUDFDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.FunctionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsFunctionID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	jobName := id.Path["streamingjobs"]
-	name := id.Path["functions"]
+	jobName := id.StreamingjobName
+	name := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, jobName, name); err != nil {
 		if !response.WasNotFound(resp.Response) {
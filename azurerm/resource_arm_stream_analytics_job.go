@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -13,17 +14,24 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmStreamAnalyticsJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStreamAnalyticsJobCreateUpdate,
-		Read:   resourceArmStreamAnalyticsJobRead,
-		Update: resourceArmStreamAnalyticsJobCreateUpdate,
-		Delete: resourceArmStreamAnalyticsJobDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmStreamAnalyticsJobCreateUpdate,
+		Read:     resourceArmStreamAnalyticsJobRead,
+		Update:   resourceArmStreamAnalyticsJobCreateUpdate,
+		Delete:   resourceArmStreamAnalyticsJobDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStreamAnalyticsJobID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,7 +122,8 @@ func resourceArmStreamAnalyticsJob() *schema.Resource {
 func resourceArmStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.JobsClient
 	transformationsClient := meta.(*ArmClient).streamanalytics.TransformationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Job creation.")
 
@@ -168,7 +177,7 @@ func resourceArmStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta inte
 			EventsOutOfOrderPolicy:             streamanalytics.EventsOutOfOrderPolicy(eventsOutOfOrderPolicy),
 			OutputErrorPolicy:                  streamanalytics.OutputErrorPolicy(outputErrorPolicy),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(meta, tags),
 	}
 
 	if d.IsNewResource() {
@@ -208,14 +217,15 @@ func resourceArmStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta inte
 func resourceArmStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.JobsClient
 	transformationsClient := meta.(*ArmClient).streamanalytics.TransformationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsJobID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["streamingjobs"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -275,14 +285,15 @@ func resourceArmStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmStreamAnalyticsJobDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.JobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsJobID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["streamingjobs"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmStreamAnalyticsOutputBlob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStreamAnalyticsOutputBlobCreateUpdate,
-		Read:   resourceArmStreamAnalyticsOutputBlobRead,
-		Update: resourceArmStreamAnalyticsOutputBlobCreateUpdate,
-		Delete: resourceArmStreamAnalyticsOutputBlobDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmStreamAnalyticsOutputBlobCreateUpdate,
+		Read:     resourceArmStreamAnalyticsOutputBlobRead,
+		Update:   resourceArmStreamAnalyticsOutputBlobCreateUpdate,
+		Delete:   resourceArmStreamAnalyticsOutputBlobDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateStreamAnalyticsOutputID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,7 +91,8 @@ func resourceArmStreamAnalyticsOutputBlob() *schema.Resource {
 
 func resourceArmStreamAnalyticsOutputBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.OutputsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output Blob creation.")
 	name := d.Get("name").(string)
@@ -163,15 +172,16 @@ func resourceArmStreamAnalyticsOutputBlobCreateUpdate(d *schema.ResourceData, me
 
 func resourceArmStreamAnalyticsOutputBlobRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.OutputsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsOutputID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	jobName := id.Path["streamingjobs"]
-	name := id.Path["outputs"]
+	jobName := id.StreamingjobName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, jobName, name)
 	if err != nil {
@@ -214,15 +224,16 @@ func resourceArmStreamAnalyticsOutputBlobRead(d *schema.ResourceData, meta inter
 
 func resourceArmStreamAnalyticsOutputBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).streamanalytics.OutputsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStreamAnalyticsOutputID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	jobName := id.Path["streamingjobs"]
-	name := id.Path["outputs"]
+	jobName := id.StreamingjobName
+	name := id.Name
 
 	if resp, err := client.Delete(ctx, resourceGroup, jobName, name); err != nil {
 		if !response.WasNotFound(resp.Response) {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -99,14 +100,14 @@ func testCheckAzureRMSubnetNetworkSecurityGroupAssociationExists(resourceName st
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroupName := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -141,14 +142,14 @@ func testCheckAzureRMSubnetNetworkSecurityGroupAssociationDisappears(resourceNam
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -182,14 +183,14 @@ func testCheckAzureRMSubnetHasNoNetworkSecurityGroup(resourceName string) resour
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroupName := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -98,14 +99,14 @@ func testCheckAzureRMSubnetRouteTableAssociationExists(resourceName string) reso
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroupName := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -140,14 +141,14 @@ func testCheckAzureRMSubnetRouteTableAssociationDisappears(resourceName string)
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroup := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -181,14 +182,14 @@ func testCheckAzureRMSubnetHasNoRouteTable(resourceName string) resource.TestChe
 		}
 
 		subnetId := rs.Primary.Attributes["subnet_id"]
-		parsedId, err := parseAzureResourceID(subnetId)
+		parsedId, err := resourceid.ParseSubnetID(subnetId)
 		if err != nil {
 			return err
 		}
 
 		resourceGroupName := parsedId.ResourceGroup
-		virtualNetworkName := parsedId.Path["virtualNetworks"]
-		subnetName := parsedId.Path["subnets"]
+		virtualNetworkName := parsedId.VirtualNetworkName
+		subnetName := parsedId.Name
 
 		client := testAccProvider.Meta().(*ArmClient).subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
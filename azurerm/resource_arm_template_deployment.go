@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +27,13 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -75,7 +84,8 @@ func resourceArmTemplateDeployment() *schema.Resource {
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -161,17 +171,15 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	resp, err := deployClient.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -227,17 +235,15 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	_, err = deployClient.Delete(ctx, resourceGroup, name)
 	if err != nil {
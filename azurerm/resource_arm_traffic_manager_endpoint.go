@@ -3,7 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,17 +11,24 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmTrafficManagerEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerEndpointCreateUpdate,
-		Read:   resourceArmTrafficManagerEndpointRead,
-		Update: resourceArmTrafficManagerEndpointCreateUpdate,
-		Delete: resourceArmTrafficManagerEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmTrafficManagerEndpointCreateUpdate,
+		Read:     resourceArmTrafficManagerEndpointRead,
+		Update:   resourceArmTrafficManagerEndpointCreateUpdate,
+		Delete:   resourceArmTrafficManagerEndpointDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateTrafficManagerEndpointID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -162,7 +169,8 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 
 func resourceArmTrafficManagerEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for TrafficManager Endpoint creation.")
 
@@ -211,24 +219,17 @@ func resourceArmTrafficManagerEndpointCreateUpdate(d *schema.ResourceData, meta
 func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.EndpointsClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTrafficManagerEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
+	endpointType := id.Type
+	profileName := id.ProfileName
+	name := id.Name
 
-	// lookup endpointType in Azure ID path
-	var endpointType string
-	typeRegex := regexp.MustCompile("azureEndpoints|externalEndpoints|nestedEndpoints")
-	for k := range id.Path {
-		if typeRegex.MatchString(k) {
-			endpointType = k
-		}
-	}
-	profileName := id.Path["trafficManagerProfiles"]
-	name := id.Path[endpointType]
-
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -305,17 +306,16 @@ func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]trafficmana
 func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.EndpointsClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTrafficManagerEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	endpointType := d.Get("type").(string)
-	profileName := id.Path["trafficManagerProfiles"]
-
-	// endpoint name is keyed by endpoint type in ARM ID
-	name := id.Path[endpointType]
-	ctx := meta.(*ArmClient).StopContext
+	endpointType := id.Type
+	profileName := id.ProfileName
+	name := id.Name
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
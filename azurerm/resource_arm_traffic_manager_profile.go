@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -12,17 +13,24 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmTrafficManagerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerProfileCreateUpdate,
-		Read:   resourceArmTrafficManagerProfileRead,
-		Update: resourceArmTrafficManagerProfileCreateUpdate,
-		Delete: resourceArmTrafficManagerProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmTrafficManagerProfileCreateUpdate,
+		Read:     resourceArmTrafficManagerProfileRead,
+		Update:   resourceArmTrafficManagerProfileCreateUpdate,
+		Delete:   resourceArmTrafficManagerProfileDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateTrafficManagerProfileID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -138,7 +146,8 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 
 func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for TrafficManager Profile creation.")
 
@@ -171,7 +180,7 @@ func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta i
 		Name:              &name,
 		Location:          &location,
 		ProfileProperties: props,
-		Tags:              expandTags(tags),
+		Tags:              expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, profile); err != nil {
@@ -193,14 +202,15 @@ func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTrafficManagerProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -236,14 +246,15 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.ProfilesClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseTrafficManagerProfileID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name := id.Name
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
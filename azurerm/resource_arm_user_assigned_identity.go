@@ -3,23 +3,31 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmUserAssignedIdentity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmUserAssignedIdentityCreateUpdate,
-		Read:   resourceArmUserAssignedIdentityRead,
-		Update: resourceArmUserAssignedIdentityCreateUpdate,
-		Delete: resourceArmUserAssignedIdentityDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmUserAssignedIdentityCreateUpdate,
+		Read:     resourceArmUserAssignedIdentityRead,
+		Update:   resourceArmUserAssignedIdentityCreateUpdate,
+		Delete:   resourceArmUserAssignedIdentityDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateUserAssignedIdentityID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +59,8 @@ func resourceArmUserAssignedIdentity() *schema.Resource {
 
 func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).msi.UserAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM user identity creation.")
 
@@ -76,7 +85,7 @@ func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta in
 	identity := msi.Identity{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTagsWithDefaults(meta, tags),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, identity); err != nil {
@@ -99,15 +108,16 @@ func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).msi.UserAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseUserAssignedIdentityID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["userAssignedIdentities"]
+	name := id.Name
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -138,15 +148,16 @@ func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{
 
 func resourceArmUserAssignedIdentityDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).msi.UserAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseUserAssignedIdentityID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["userAssignedIdentities"]
+	name := id.Name
 
 	_, err = client.Delete(ctx, resGroup, name)
 	if err != nil {
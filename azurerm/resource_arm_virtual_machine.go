@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 	"golang.org/x/net/context"
@@ -28,8 +31,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 		Update: resourceArmVirtualMachineCreateUpdate,
 		Delete: resourceArmVirtualMachineDelete,
 		// TODO: use a custom importer so that `delete_os_disk_on_termination` and `delete_data_disks_on_termination` are set
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateVirtualMachineID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -560,7 +568,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -582,7 +591,7 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTagsWithDefaults(meta, tags)
 	zones := azure.ExpandZones(d.Get("zones").([]interface{}))
 
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
@@ -715,14 +724,15 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
 	resp, err := vmClient.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -764,7 +774,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			}
 
 			if osDisk := profile.OsDisk; osDisk != nil {
-				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(osDisk.ManagedDisk, meta)
+				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, osDisk.ManagedDisk, meta)
 				if err != nil {
 					return fmt.Errorf("Error flattening `storage_os_disk`: %#v", err)
 				}
@@ -776,7 +786,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			if dataDisks := profile.DataDisks; dataDisks != nil {
 				disksInfo := make([]*compute.Disk, len(*dataDisks))
 				for i, dataDisk := range *dataDisks {
-					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(dataDisk.ManagedDisk, meta)
+					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, dataDisk.ManagedDisk, meta)
 					if err != nil {
 						return fmt.Errorf("[DEBUG] Error getting managed data disk detailed information: %#v", err)
 					}
@@ -837,14 +847,15 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualMachineID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name := id.Name
 
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
@@ -863,8 +874,8 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error waiting for deletion of Virtual Machine %q (Resource Group %q): %s", name, resGroup, err)
 	}
 
-	// delete OS Disk if opted in
-	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool)
+	// delete OS Disk if opted in, either on the resource or via the Provider's `features` block
+	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || meta.(*ArmClient).Features.VirtualMachine.DeleteOSDiskOnDeletion
 	deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool)
 
 	if deleteOsDisk || deleteDataDisks {
@@ -880,7 +891,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if deleteOsDisk {
-			log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
+			log.Printf("[INFO] Deletion of the OS Disk is enabled, deleting disk from %s", name)
 			osDisk := storageProfile.OsDisk
 			if osDisk == nil {
 				return fmt.Errorf("Error deleting OS Disk for Virtual Machine %q - `osDisk` was nil", name)
@@ -894,7 +905,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 					return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 				}
 			} else if osDisk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(osDisk.ManagedDisk, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, osDisk.ManagedDisk, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 				}
 			}
@@ -919,7 +930,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 						return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 					}
 				} else if disk.ManagedDisk != nil {
-					if err = resourceArmVirtualMachineDeleteManagedDisk(disk.ManagedDisk, meta); err != nil {
+					if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, disk.ManagedDisk, meta); err != nil {
 						return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 					}
 				}
@@ -967,7 +978,7 @@ func resourceArmVirtualMachineDeleteVhd(ctx context.Context, storageClient *intS
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(disk *compute.ManagedDiskParameters, meta interface{}) error {
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) error {
 	if disk == nil {
 		return fmt.Errorf("`disk` was nil`")
 	}
@@ -977,14 +988,13 @@ func resourceArmVirtualMachineDeleteManagedDisk(disk *compute.ManagedDiskParamet
 	managedDiskID := *disk.ID
 
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
-	id, err := parseAzureResourceID(managedDiskID)
+	id, err := resourceid.ParseManagedDiskID(managedDiskID)
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -1824,22 +1834,21 @@ func resourceArmVirtualMachineStorageImageReferenceHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
+func resourceArmVirtualMachineGetManagedDiskInfo(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
 	if disk == nil || disk.ID == nil {
 		return nil, nil
 	}
 
 	diskId := *disk.ID
-	id, err := parseAzureResourceID(diskId)
+	id, err := resourceid.ParseManagedDiskID(diskId)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Disk ID %q: %+v", diskId, err)
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name := id.Name
 	diskResp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -1867,13 +1876,13 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 					}
 				}
 
-				id, err := parseAzureResourceID(*nicReference.ID)
+				id, err := resourceid.ParseNetworkInterfaceID(*nicReference.ID)
 				if err != nil {
 					return "", err
 				}
 
 				resourceGroup := id.ResourceGroup
-				name := id.Path["networkInterfaces"]
+				name := id.Name
 
 				nic, err := nicClient.Get(ctx, resourceGroup, name, "")
 				if err != nil {
@@ -1895,13 +1904,13 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 			for _, config := range *configs {
 
 				if config.PublicIPAddress != nil {
-					id, err := parseAzureResourceID(*config.PublicIPAddress.ID)
+					id, err := resourceid.ParsePublicIPID(*config.PublicIPAddress.ID)
 					if err != nil {
 						return "", err
 					}
 
 					resourceGroup := id.ResourceGroup
-					name := id.Path["publicIPAddresses"]
+					name := id.Name
 
 					pip, err := pipClient.Get(ctx, resourceGroup, name, "")
 					if err != nil {
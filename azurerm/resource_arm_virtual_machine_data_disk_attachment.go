@@ -1,25 +1,34 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
-		Read:   resourceArmVirtualMachineDataDiskAttachmentRead,
-		Update: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
-		Delete: resourceArmVirtualMachineDataDiskAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Read:     resourceArmVirtualMachineDataDiskAttachmentRead,
+		Update:   resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Delete:   resourceArmVirtualMachineDataDiskAttachmentDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateVirtualMachineDataDiskAttachmentID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,16 +88,17 @@ func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
 
 func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	virtualMachineId := d.Get("virtual_machine_id").(string)
-	parsedVirtualMachineId, err := parseAzureResourceID(virtualMachineId)
+	parsedVirtualMachineId, err := resourceid.ParseVirtualMachineID(virtualMachineId)
 	if err != nil {
 		return fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", virtualMachineId, err)
 	}
 
 	resourceGroup := parsedVirtualMachineId.ResourceGroup
-	virtualMachineName := parsedVirtualMachineId.Path["virtualMachines"]
+	virtualMachineName := parsedVirtualMachineId.Name
 
 	azureRMLockByName(virtualMachineName, virtualMachineResourceName)
 	defer azureRMUnlockByName(virtualMachineName, virtualMachineResourceName)
@@ -103,7 +113,7 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 	}
 
 	managedDiskId := d.Get("managed_disk_id").(string)
-	managedDisk, err := retrieveDataDiskAttachmentManagedDisk(meta, managedDiskId)
+	managedDisk, err := retrieveDataDiskAttachmentManagedDisk(ctx, meta, managedDiskId)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Managed Disk %q: %+v", managedDiskId, err)
 	}
@@ -180,16 +190,17 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 
 func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualMachineDataDiskAttachmentID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	virtualMachineName := id.Path["virtualMachines"]
-	name := id.Path["dataDisks"]
+	virtualMachineName := id.VirtualMachineName
+	name := id.Name
 
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
@@ -237,16 +248,17 @@ func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, met
 
 func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualMachineDataDiskAttachmentID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	virtualMachineName := id.Path["virtualMachines"]
-	name := id.Path["dataDisks"]
+	virtualMachineName := id.VirtualMachineName
+	name := id.Name
 
 	azureRMLockByName(virtualMachineName, virtualMachineResourceName)
 	defer azureRMUnlockByName(virtualMachineName, virtualMachineResourceName)
@@ -285,16 +297,15 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 	return nil
 }
 
-func retrieveDataDiskAttachmentManagedDisk(meta interface{}, id string) (*compute.Disk, error) {
+func retrieveDataDiskAttachmentManagedDisk(ctx context.Context, meta interface{}, id string) (*compute.Disk, error) {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
-	parsedId, err := parseAzureResourceID(id)
+	parsedId, err := resourceid.ParseManagedDiskID(id)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Managed Disk ID %q: %+v", id, err)
 	}
 	resourceGroup := parsedId.ResourceGroup
-	name := parsedId.Path["disks"]
+	name := parsedId.Name
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
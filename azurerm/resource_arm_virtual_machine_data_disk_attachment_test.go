@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -211,12 +212,12 @@ func testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName string)
 
 		virtualMachineId := rs.Primary.Attributes["virtual_machine_id"]
 
-		id, err := parseAzureResourceID(virtualMachineId)
+		id, err := resourceid.ParseVirtualMachineID(virtualMachineId)
 		if err != nil {
 			return err
 		}
 
-		virtualMachineName := id.Path["virtualMachines"]
+		virtualMachineName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).vmClient
@@ -231,12 +232,12 @@ func testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName string)
 			return fmt.Errorf("Bad: VirtualMachine %q (resource group: %q) does not exist", virtualMachineName, resourceGroup)
 		}
 
-		diskId, err := parseAzureResourceID(rs.Primary.ID)
+		diskId, err := resourceid.ParseVirtualMachineDataDiskAttachmentID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
-		diskName := diskId.Path["dataDisks"]
+		diskName := diskId.Name
 
 		// deliberately not using strings.EqualFold as this is case sensitive
 		for _, disk := range *resp.StorageProfile.DataDisks {
@@ -257,12 +258,12 @@ func testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy(s *terraform.State)
 
 		virtualMachineId := rs.Primary.Attributes["virtual_machine_id"]
 
-		id, err := parseAzureResourceID(virtualMachineId)
+		id, err := resourceid.ParseVirtualMachineID(virtualMachineId)
 		if err != nil {
 			return err
 		}
 
-		virtualMachineName := id.Path["virtualMachines"]
+		virtualMachineName := id.Name
 		resourceGroup := id.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).vmClient
@@ -277,12 +278,12 @@ func testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy(s *terraform.State)
 			return fmt.Errorf("Bad: Get on vmClient: %+v", err)
 		}
 
-		diskId, err := parseAzureResourceID(rs.Primary.ID)
+		diskId, err := resourceid.ParseVirtualMachineDataDiskAttachmentID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
-		diskName := diskId.Path["dataDisks"]
+		diskName := diskId.Name
 
 		for _, disk := range *resp.StorageProfile.DataDisks {
 			// deliberately not using strings.Equals as this is case sensitive
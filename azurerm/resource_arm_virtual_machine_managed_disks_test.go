@@ -10,11 +10,12 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 // NOTE: Test `TestAccAzureRMVirtualMachine_enableAnWithVM` requires a machine of size `D8_v3` which is large/expensive - you may wish to ignore this test"
@@ -571,12 +572,12 @@ func TestAccAzureRMVirtualMachine_importBasic_withZone(t *testing.T) {
 
 func testCheckAndStopAzureRMVirtualMachine(vm *compute.VirtualMachine) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		vmID, err := parseAzureResourceID(*vm.ID)
+		vmID, err := resourceid.ParseVirtualMachineID(*vm.ID)
 		if err != nil {
 			return fmt.Errorf("Unable to parse virtual machine ID %s, %+v", *vm.ID, err)
 		}
 
-		name := vmID.Path["virtualMachines"]
+		name := vmID.Name
 		resourceGroup := vmID.ResourceGroup
 
 		client := testAccProvider.Meta().(*ArmClient).vmClient
@@ -2171,18 +2172,18 @@ func testLookupAzureRMVirtualMachineManagedDiskID(vm *compute.VirtualMachine, di
 }
 
 func findAzureRMVirtualMachineManagedDiskID(md *compute.ManagedDiskParameters) (string, error) {
-	if _, err := parseAzureResourceID(*md.ID); err != nil {
+	if _, err := resourceid.ParseManagedDiskID(*md.ID); err != nil {
 		return "", err
 	}
 	return *md.ID, nil
 }
 
 func testGetAzureRMVirtualMachineManagedDisk(managedDiskID *string) (*compute.Disk, error) {
-	armID, err := parseAzureResourceID(*managedDiskID)
+	armID, err := resourceid.ParseManagedDiskID(*managedDiskID)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to parse Managed Disk ID %s, %+v", *managedDiskID, err)
 	}
-	name := armID.Path["disks"]
+	name := armID.Name
 	resourceGroup := armID.ResourceGroup
 	client := testAccProvider.Meta().(*ArmClient).diskClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -14,21 +15,28 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmVirtualNetworkGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkGatewayCreateUpdate,
-		Read:   resourceArmVirtualNetworkGatewayRead,
-		Update: resourceArmVirtualNetworkGatewayCreateUpdate,
-		Delete: resourceArmVirtualNetworkGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualNetworkGatewayCreateUpdate,
+		Read:     resourceArmVirtualNetworkGatewayRead,
+		Update:   resourceArmVirtualNetworkGatewayCreateUpdate,
+		Delete:   resourceArmVirtualNetworkGatewayDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateVirtualNetworkGatewayID),
 
 		CustomizeDiff: resourceArmVirtualNetworkGatewayCustomizeDiff,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -266,7 +274,8 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 
 func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway creation.")
 
@@ -297,7 +306,7 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &name,
 		Location:                              &location,
-		Tags:                                  expandTags(tags),
+		Tags:                                  expandTagsWithDefaults(meta, tags),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
@@ -325,7 +334,8 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayFromId(d.Id())
 	if err != nil {
@@ -384,7 +394,8 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 
 func resourceArmVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayFromId(d.Id())
 	if err != nil {
@@ -710,11 +721,11 @@ func hashVirtualNetworkGatewayRevokedCert(v interface{}) int {
 }
 
 func resourceGroupAndVirtualNetworkGatewayFromId(virtualNetworkGatewayId string) (string, string, error) {
-	id, err := parseAzureResourceID(virtualNetworkGatewayId)
+	id, err := resourceid.ParseVirtualNetworkGatewayID(virtualNetworkGatewayId)
 	if err != nil {
 		return "", "", err
 	}
-	name := id.Path["virtualNetworkGateways"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	return resGroup, name, nil
@@ -727,19 +738,13 @@ func validateArmVirtualNetworkGatewaySubnetId(i interface{}, k string) (warnings
 		return
 	}
 
-	id, err := parseAzureResourceID(value)
+	id, err := resourceid.ParseSubnetID(value)
 	if err != nil {
-		errors = append(errors, fmt.Errorf("expected %s to be an Azure resource id", k))
-		return
-	}
-
-	subnet, ok := id.Path["subnets"]
-	if !ok {
-		errors = append(errors, fmt.Errorf("expected %s to reference a subnet resource", k))
+		errors = append(errors, fmt.Errorf("expected %s to reference a subnet resource: %+v", k, err))
 		return
 	}
 
-	if strings.ToLower(subnet) != "gatewaysubnet" {
+	if strings.ToLower(id.Name) != "gatewaysubnet" {
 		errors = append(errors, fmt.Errorf("expected %s to reference a gateway subnet with name GatewaySubnet", k))
 	}
 
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,12 +24,17 @@ var peerMutex = &sync.Mutex{}
 
 func resourceArmVirtualNetworkPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkPeeringCreateUpdate,
-		Read:   resourceArmVirtualNetworkPeeringRead,
-		Update: resourceArmVirtualNetworkPeeringCreateUpdate,
-		Delete: resourceArmVirtualNetworkPeeringDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmVirtualNetworkPeeringCreateUpdate,
+		Read:     resourceArmVirtualNetworkPeeringRead,
+		Update:   resourceArmVirtualNetworkPeeringCreateUpdate,
+		Delete:   resourceArmVirtualNetworkPeeringDelete,
+		Importer: resourceid.ValidatingImporter(resourceid.ValidateVirtualNetworkPeeringID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,7 +87,8 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 
 func resourceArmVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network peering creation.")
 
@@ -108,7 +117,7 @@ func resourceArmVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta i
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	if err := resource.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(resGroup, vnetName, name, peer, meta)); err != nil {
+	if err := resource.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(ctx, resGroup, vnetName, name, peer, meta)); err != nil {
 		return err
 	}
 
@@ -127,15 +136,16 @@ func resourceArmVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualNetworkPeeringID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name)
 	if err != nil {
@@ -163,15 +173,16 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 
 func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseVirtualNetworkPeeringID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
@@ -206,10 +217,9 @@ func getVirtualNetworkPeeringProperties(d *schema.ResourceData) *network.Virtual
 	}
 }
 
-func retryVnetPeeringsClientCreateUpdate(resGroup string, vnetName string, name string, peer network.VirtualNetworkPeering, meta interface{}) func() *resource.RetryError {
+func retryVnetPeeringsClientCreateUpdate(ctx context.Context, resGroup string, vnetName string, name string, peer network.VirtualNetworkPeering, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		vnetPeeringsClient := meta.(*ArmClient).vnetPeeringsClient
-		ctx := meta.(*ArmClient).StopContext
 
 		future, err := vnetPeeringsClient.CreateOrUpdate(ctx, resGroup, vnetName, name, peer)
 		if err != nil {
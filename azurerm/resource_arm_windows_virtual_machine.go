@@ -0,0 +1,789 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceArmWindowsVirtualMachineCreate,
+		Read:          resourceArmWindowsVirtualMachineRead,
+		Update:        resourceArmWindowsVirtualMachineUpdate,
+		Delete:        resourceArmWindowsVirtualMachineDelete,
+		Importer:      resourceid.ValidatingImporter(resourceid.ValidateVirtualMachineID),
+		CustomizeDiff: virtualMachineCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WindowsAdminUsername,
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.WindowsAdminPassword,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// Optional
+			"additional_unattend_content": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"setting": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.AutoLogon),
+								string(compute.FirstLogonCommands),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"availability_set_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				// the Compute/VM API is broken and returns the Availability Set name in UPPERCASE :shrug:
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"zone"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// whilst the `name` field can be any length, the `computer_name` has a shorter limit,
+				// which is validated against when `computer_name` isn't set in Create
+				ValidateFunc: validate.WindowsComputerName,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"dedicated_host_id": dedicatedHostIDSchema(),
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": virtualMachineEvictionPolicySchema(),
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+			},
+
+			"max_bid_price": virtualMachineMaxBidPriceSchema(),
+
+			"plan": virtualMachinePlanSchema(),
+
+			"priority": virtualMachinePrioritySchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"proximity_placement_group_id": proximityPlacementGroupIDSchema(),
+
+			"secret": virtualMachineSecretSchema(true),
+
+			"source_image_id": virtualMachineSourceImageIDSchema(),
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"tags": tagsSchema(),
+
+			"timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureVirtualMachineTimeZone(),
+			},
+
+			"winrm_listener": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.HTTP),
+								string(compute.HTTPS),
+							}, false),
+						},
+
+						"certificate_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+					},
+				},
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"1",
+					"2",
+					"3",
+				}, false),
+				ConflictsWith: []string{"availability_set_id"},
+			},
+
+			// Computed
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for existing Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine", *existing.ID)
+		}
+	}
+
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		if _, errs := validate.WindowsComputerName(name, "computer_name"); len(errs) > 0 {
+			return fmt.Errorf("Unable to assume default computer name %s. Please adjust the `name`, or specify an explicit `computer_name`", errs[0])
+		}
+		computerName = name
+	}
+
+	winRmListeners, err := expandWindowsVirtualMachineWinRMListeners(d.Get("winrm_listener").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	allowExtensionOperations := d.Get("allow_extension_operations").(bool)
+	provisionVMAgent := d.Get("provision_vm_agent").(bool)
+	if allowExtensionOperations && !provisionVMAgent {
+		return fmt.Errorf("`allow_extension_operations` cannot be set to `true` when `provision_vm_agent` is set to `false`")
+	}
+
+	imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	networkInterfaceIds := expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{}))
+	t := d.Get("tags").(map[string]interface{})
+
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Plan:     expandVirtualMachinePlan(d.Get("plan").([]interface{})),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			BillingProfile: expandVirtualMachineBillingProfile(priority, d.Get("max_bid_price").(float64)),
+			EvictionPolicy: compute.VirtualMachineEvictionPolicyTypes(d.Get("eviction_policy").(string)),
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			Host: expandDedicatedHostID(d.Get("dedicated_host_id").(string)),
+			OsProfile: &compute.OSProfile{
+				AdminUsername:            utils.String(d.Get("admin_username").(string)),
+				AdminPassword:            utils.String(d.Get("admin_password").(string)),
+				ComputerName:             utils.String(computerName),
+				AllowExtensionOperations: utils.Bool(allowExtensionOperations),
+				WindowsConfiguration: &compute.WindowsConfiguration{
+					AdditionalUnattendContent: expandWindowsVirtualMachineAdditionalUnattendContent(d.Get("additional_unattend_content").([]interface{})),
+					EnableAutomaticUpdates:    utils.Bool(d.Get("enable_automatic_updates").(bool)),
+					ProvisionVMAgent:          utils.Bool(provisionVMAgent),
+					WinRM:                     winRmListeners,
+				},
+				Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{}), true),
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &networkInterfaceIds,
+			},
+			Priority: priority,
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: imageReference,
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Windows),
+
+				// Data Disks are instead handled via the Association resource - as such we can send an empty value here
+				// but for Updates this'll need to be nil, else any associations will be overwritten
+				DataDisks: &[]compute.DataDisk{},
+			},
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		},
+		Tags: expandTagsWithDefaults(meta, t),
+	}
+
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		params.ProximityPlacementGroup = expandProximityPlacementGroupID(v.(string))
+	}
+
+	if v, ok := d.GetOk("availability_set_id"); ok {
+		params.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("custom_data"); ok {
+		params.OsProfile.CustomData = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		params.VirtualMachineProperties.LicenseType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		params.OsProfile.WindowsConfiguration.TimeZone = utils.String(v.(string))
+	}
+
+	if identityRaw := d.Get("identity").([]interface{}); len(identityRaw) > 0 {
+		identity, err := expandVirtualMachineIdentity(identityRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+		params.Identity = identity
+	}
+
+	if v, ok := d.GetOk("zone"); ok {
+		params.Zones = &[]string{
+			v.(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Windows Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Windows Virtual Machine %q (Resource Group %q) was created", name, resourceGroup)
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	zone := ""
+	if resp.Zones != nil && len(*resp.Zones) > 0 {
+		zone = (*resp.Zones)[0]
+	}
+	d.Set("zone", zone)
+
+	props := resp.VirtualMachineProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	availabilitySetId := ""
+	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+		availabilitySetId = *props.AvailabilitySet.ID
+	}
+	d.Set("availability_set_id", availabilitySetId)
+	d.Set("proximity_placement_group_id", flattenProximityPlacementGroupID(props.ProximityPlacementGroup))
+
+	if err := d.Set("boot_diagnostics", flattenVirtualMachineBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+		return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+	}
+
+	if profile := props.HardwareProfile; profile != nil {
+		d.Set("size", string(profile.VMSize))
+	}
+
+	if profile := props.NetworkProfile; profile != nil {
+		if err := d.Set("network_interface_ids", flattenVirtualMachineNetworkInterfaceIDs(profile.NetworkInterfaces)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		addresses, err := retrieveVirtualMachineIPAddresses(ctx, meta, profile.NetworkInterfaces)
+		if err != nil {
+			return fmt.Errorf("Error retrieving IP Addresses for Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := setVirtualMachineIPAddresses(d, addresses, "winrm"); err != nil {
+			return err
+		}
+	}
+
+	if profile := props.OsProfile; profile != nil {
+		d.Set("admin_username", profile.AdminUsername)
+		d.Set("allow_extension_operations", profile.AllowExtensionOperations)
+		d.Set("computer_name", profile.ComputerName)
+
+		if config := profile.WindowsConfiguration; config != nil {
+			d.Set("enable_automatic_updates", config.EnableAutomaticUpdates)
+			d.Set("provision_vm_agent", config.ProvisionVMAgent)
+			d.Set("timezone", config.TimeZone)
+
+			if err := d.Set("winrm_listener", flattenWindowsVirtualMachineWinRMListeners(config.WinRM)); err != nil {
+				return fmt.Errorf("Error setting `winrm_listener`: %+v", err)
+			}
+
+			// the `content` of the Additional Unattend Content isn't returned by the API, so we have to look this up from the state
+			existing := d.Get("additional_unattend_content").([]interface{})
+			if err := d.Set("additional_unattend_content", flattenWindowsVirtualMachineAdditionalUnattendContent(config.AdditionalUnattendContent, existing)); err != nil {
+				return fmt.Errorf("Error setting `additional_unattend_content`: %+v", err)
+			}
+		}
+
+		if err := d.Set("secret", flattenVirtualMachineSecrets(profile.Secrets, true)); err != nil {
+			return fmt.Errorf("Error setting `secret`: %+v", err)
+		}
+	}
+
+	if profile := props.StorageProfile; profile != nil {
+		osDisk, err := flattenVirtualMachineOSDisk(ctx, meta, profile.OsDisk)
+		if err != nil {
+			return fmt.Errorf("Error flattening `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("os_disk", osDisk); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		sourceImageId := ""
+		if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+			sourceImageId = *profile.ImageReference.ID
+		}
+		d.Set("source_image_id", sourceImageId)
+
+		if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+			return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+		}
+	}
+
+	licenseType := ""
+	if props.LicenseType != nil {
+		licenseType = *props.LicenseType
+	}
+	d.Set("license_type", licenseType)
+
+	d.Set("virtual_machine_id", props.VMID)
+
+	d.Set("dedicated_host_id", flattenDedicatedHostID(props.Host))
+	d.Set("eviction_policy", string(props.EvictionPolicy))
+	d.Set("max_bid_price", flattenVirtualMachineBillingProfile(props.BillingProfile))
+	d.Set("priority", flattenVirtualMachinePriority(props.Priority))
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	options := virtualMachineUpdateOptions{}
+	update := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{},
+	}
+
+	if d.HasChange("boot_diagnostics") {
+		update.VirtualMachineProperties.DiagnosticsProfile = expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandVirtualMachineIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding `identity`: %+v", err)
+		}
+		update.Identity = identity
+	}
+
+	if d.HasChange("license_type") {
+		licenseType := d.Get("license_type").(string)
+		if licenseType == "" {
+			// the API requires this is set to `None` to remove it
+			licenseType = "None"
+		}
+		update.VirtualMachineProperties.LicenseType = utils.String(licenseType)
+	}
+
+	if d.HasChange("max_bid_price") {
+		priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+		update.VirtualMachineProperties.BillingProfile = expandVirtualMachineBillingProfile(priority, d.Get("max_bid_price").(float64))
+
+		// the Max Bid Price can only be changed whilst the Virtual Machine is deallocated
+		options.shouldShutDown = true
+		options.shouldDeallocate = true
+	}
+
+	if d.HasChange("network_interface_ids") {
+		networkInterfaceIds := expandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{}))
+		update.VirtualMachineProperties.NetworkProfile = &compute.NetworkProfile{
+			NetworkInterfaces: &networkInterfaceIds,
+		}
+
+		// Network Interfaces can only be changed whilst the Virtual Machine is deallocated
+		options.shouldShutDown = true
+		options.shouldDeallocate = true
+	}
+
+	if d.HasChange("os_disk") {
+		osDiskRaw := d.Get("os_disk").([]interface{})
+		osDisk := osDiskRaw[0].(map[string]interface{})
+
+		update.VirtualMachineProperties.StorageProfile = &compute.StorageProfile{
+			OsDisk: &compute.OSDisk{
+				Caching:                 compute.CachingTypes(osDisk["caching"].(string)),
+				WriteAcceleratorEnabled: utils.Bool(osDisk["write_accelerator_enabled"].(bool)),
+			},
+		}
+
+		if d.HasChange("os_disk.0.disk_size_gb") {
+			// the OS Disk can only be resized whilst the Virtual Machine is deallocated
+			options.shouldShutDown = true
+			options.shouldDeallocate = true
+			options.osDiskNewSize = osDisk["disk_size_gb"].(int)
+		}
+	}
+
+	if d.HasChange("secret") {
+		update.VirtualMachineProperties.OsProfile = &compute.OSProfile{
+			Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{}), true),
+		}
+	}
+
+	if d.HasChange("size") {
+		size := d.Get("size").(string)
+		update.VirtualMachineProperties.HardwareProfile = &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(size),
+		}
+
+		// the Virtual Machine can be resized in-place when the new size is available on the current
+		// hardware cluster - otherwise it has to be deallocated so that it can be moved
+		available, err := virtualMachineSizeIsAvailable(ctx, client, id.ResourceGroup, id.Name, size)
+		if err != nil {
+			return err
+		}
+
+		if !available {
+			log.Printf("[DEBUG] Size %q isn't available on the current cluster for Windows Virtual Machine %q (Resource Group %q) - deallocating to resize", size, id.Name, id.ResourceGroup)
+			options.shouldShutDown = true
+			options.shouldDeallocate = true
+		}
+	}
+
+	if hasTagsChange(d) {
+		t := d.Get("tags").(map[string]interface{})
+		update.Tags = expandTagsWithDefaults(meta, t)
+	}
+
+	if err := updateVirtualMachine(ctx, meta, id.ResourceGroup, id.Name, update, options); err != nil {
+		return err
+	}
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return deleteVirtualMachine(ctx, meta, id.ResourceGroup, id.Name)
+}
+
+func expandWindowsVirtualMachineAdditionalUnattendContent(input []interface{}) *[]compute.AdditionalUnattendContent {
+	output := make([]compute.AdditionalUnattendContent, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		output = append(output, compute.AdditionalUnattendContent{
+			SettingName: compute.SettingNames(raw["setting"].(string)),
+			Content:     utils.String(raw["content"].(string)),
+
+			// no other possible values
+			PassName:      compute.OobeSystem,
+			ComponentName: compute.MicrosoftWindowsShellSetup,
+		})
+	}
+
+	return &output
+}
+
+func flattenWindowsVirtualMachineAdditionalUnattendContent(input *[]compute.AdditionalUnattendContent, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the `content` isn't returned by the API, so we look it up from the existing value (by setting) where possible
+	existingContent := make(map[string]string)
+	for _, v := range existing {
+		raw := v.(map[string]interface{})
+		existingContent[raw["setting"].(string)] = raw["content"].(string)
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		setting := string(v.SettingName)
+
+		content := existingContent[setting]
+		if v.Content != nil && *v.Content != "" {
+			content = *v.Content
+		}
+
+		output = append(output, map[string]interface{}{
+			"content": content,
+			"setting": setting,
+		})
+	}
+
+	return output
+}
+
+func expandWindowsVirtualMachineWinRMListeners(input []interface{}) (*compute.WinRMConfiguration, error) {
+	listeners := make([]compute.WinRMListener, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		listener := compute.WinRMListener{
+			Protocol: compute.ProtocolTypes(raw["protocol"].(string)),
+		}
+
+		certificateUrl := raw["certificate_url"].(string)
+		if certificateUrl != "" {
+			listener.CertificateURL = utils.String(certificateUrl)
+		}
+
+		if listener.Protocol == compute.HTTPS && certificateUrl == "" {
+			return nil, fmt.Errorf("A `certificate_url` must be specified for a `winrm_listener` using the `Https` protocol")
+		}
+
+		if listener.Protocol == compute.HTTP && certificateUrl != "" {
+			return nil, fmt.Errorf("A `certificate_url` cannot be specified for a `winrm_listener` using the `Http` protocol")
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return &compute.WinRMConfiguration{
+		Listeners: &listeners,
+	}, nil
+}
+
+func flattenWindowsVirtualMachineWinRMListeners(input *compute.WinRMConfiguration) []interface{} {
+	if input == nil || input.Listeners == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+
+	for _, v := range *input.Listeners {
+		certificateUrl := ""
+		if v.CertificateURL != nil {
+			certificateUrl = *v.CertificateURL
+		}
+
+		output = append(output, map[string]interface{}{
+			"certificate_url": certificateUrl,
+			"protocol":        string(v.Protocol),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,348 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMWindowsVirtualMachineScaleSet_basic(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "computer_name_prefix", "acctvm"),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_updates", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "unique_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachineScaleSet_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_windows_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWindowsVirtualMachineScaleSet_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_windows_virtual_machine_scale_set"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachineScaleSet_updateSku(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_sku(ri, location, "Standard_F2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard_F2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+			{
+				// the Scale Set should be updated in-place, rather than being replaced
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_sku(ri, location, "Standard_F4"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard_F4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachineScaleSet_scaleInPolicyAndTerminateNotification(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_scaleInPolicyAndTerminateNotification(ri, location, "OldestVM", "PT10M"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "OldestVM"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_notification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_notification.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_notification.0.timeout", "PT10M"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+			{
+				Config: testAccAzureRMWindowsVirtualMachineScaleSet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_notification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWindowsVirtualMachineScaleSetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVirtualMachineScaleSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Windows Virtual Machine Scale Set %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmScaleSetClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWindowsVirtualMachineScaleSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmScaleSetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_windows_virtual_machine_scale_set" {
+			continue
+		}
+
+		id, err := resourceid.ParseVirtualMachineScaleSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Windows Virtual Machine Scale Set %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWindowsVirtualMachineScaleSet_basic(rInt int, location string) string {
+	return testAccAzureRMWindowsVirtualMachineScaleSet_sku(rInt, location, "Standard_F2")
+}
+
+func testAccAzureRMWindowsVirtualMachineScaleSet_sku(rInt int, location, sku string) string {
+	// the Linux template is OS-agnostic, so it's reused here
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine_scale_set" "test" {
+  name                 = "acctestvmss-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  sku                  = "%s"
+  capacity             = 1
+  admin_username       = "adminuser"
+  admin_password       = "P@$$w0rd1234!"
+  computer_name_prefix = "acctvm"
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, rInt, sku)
+}
+
+func testAccAzureRMWindowsVirtualMachineScaleSet_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWindowsVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine_scale_set" "import" {
+  name                 = "${azurerm_windows_virtual_machine_scale_set.test.name}"
+  resource_group_name  = "${azurerm_windows_virtual_machine_scale_set.test.resource_group_name}"
+  location             = "${azurerm_windows_virtual_machine_scale_set.test.location}"
+  sku                  = "${azurerm_windows_virtual_machine_scale_set.test.sku}"
+  capacity             = "${azurerm_windows_virtual_machine_scale_set.test.capacity}"
+  admin_username       = "${azurerm_windows_virtual_machine_scale_set.test.admin_username}"
+  admin_password       = "${azurerm_windows_virtual_machine_scale_set.test.admin_password}"
+  computer_name_prefix = "${azurerm_windows_virtual_machine_scale_set.test.computer_name_prefix}"
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMWindowsVirtualMachineScaleSet_scaleInPolicyAndTerminateNotification(rInt int, location, scaleInPolicy, timeout string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine_scale_set" "test" {
+  name                 = "acctestvmss-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  sku                  = "Standard_F2"
+  capacity             = 1
+  admin_username       = "adminuser"
+  admin_password       = "P@$$w0rd1234!"
+  computer_name_prefix = "acctvm"
+  scale_in_policy      = "%s"
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  terminate_notification {
+    enabled = true
+    timeout = "%s"
+  }
+}
+`, template, rInt, scaleInPolicy, timeout)
+}
@@ -0,0 +1,525 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMWindowsVirtualMachine_basic(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_updates", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "os_disk.0.name"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_machine_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWindowsVirtualMachine_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_windows_virtual_machine"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_updateSize(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_size(ri, location, "Standard_F2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F2"),
+				),
+			},
+			{
+				// the Virtual Machine should be resized in-place, rather than being replaced
+				Config: testAccAzureRMWindowsVirtualMachine_size(ri, location, "Standard_F4"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "Standard_F4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_complete(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "computer_name", "winhost01"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "Windows_Server"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Pacific Standard Time"),
+					resource.TestCheckResourceAttr(resourceName, "winrm_listener.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_unattend_content.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+					"additional_unattend_content.0.content",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_spot(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_spot(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "Spot"),
+					resource.TestCheckResourceAttr(resourceName, "eviction_policy", "Delete"),
+					resource.TestCheckResourceAttr(resourceName, "max_bid_price", "-1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_diskEncryptionSet(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_diskEncryptionSet(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "os_disk.0.disk_encryption_set_id", "azurerm_disk_encryption_set.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWindowsVirtualMachine_dedicatedHost(t *testing.T) {
+	resourceName := "azurerm_windows_virtual_machine.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWindowsVirtualMachine_dedicatedHost(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "dedicated_host_id", "azurerm_dedicated_host.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_password",
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWindowsVirtualMachineExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVirtualMachineID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Windows Virtual Machine %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWindowsVirtualMachineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_windows_virtual_machine" {
+			continue
+		}
+
+		id, err := resourceid.ParseVirtualMachineID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Windows Virtual Machine %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWindowsVirtualMachine_basic(rInt int, location string) string {
+	return testAccAzureRMWindowsVirtualMachine_size(rInt, location, "Standard_F2")
+}
+
+func testAccAzureRMWindowsVirtualMachine_size(rInt int, location, size string) string {
+	// the Linux template is OS-agnostic, so it's reused here
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "%s"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, fmt.Sprintf("%d", rInt)[0:8], size)
+}
+
+func testAccAzureRMWindowsVirtualMachine_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWindowsVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "import" {
+  name                  = "${azurerm_windows_virtual_machine.test.name}"
+  resource_group_name   = "${azurerm_windows_virtual_machine.test.resource_group_name}"
+  location              = "${azurerm_windows_virtual_machine.test.location}"
+  size                  = "${azurerm_windows_virtual_machine.test.size}"
+  admin_username        = "${azurerm_windows_virtual_machine.test.admin_username}"
+  admin_password        = "${azurerm_windows_virtual_machine.test.admin_password}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMWindowsVirtualMachine_complete(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                     = "acctestVM-%d"
+  computer_name            = "winhost01"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  size                     = "Standard_F2"
+  admin_username           = "adminuser"
+  admin_password           = "P@$$w0rd1234!"
+  enable_automatic_updates = false
+  license_type             = "Windows_Server"
+  timezone                 = "Pacific Standard Time"
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  additional_unattend_content {
+    setting = "AutoLogon"
+    content = "<AutoLogon><Username>adminuser</Username><Password><Value>P@$$w0rd1234!</Value></Password><Enabled>true</Enabled><LogonCount>1</LogonCount></AutoLogon>"
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  winrm_listener {
+    protocol = "Http"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMWindowsVirtualMachine_spot(rInt int, location string) string {
+	// the Linux template is OS-agnostic, so it's reused here
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  priority            = "Spot"
+  eviction_policy     = "Delete"
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, fmt.Sprintf("%d", rInt)[0:8])
+}
+
+func testAccAzureRMWindowsVirtualMachine_diskEncryptionSet(rInt int, rString, location string) string {
+	// the Linux template is OS-agnostic, so it's reused here
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching                = "ReadWrite"
+    storage_account_type   = "Standard_LRS"
+    disk_encryption_set_id = "${azurerm_disk_encryption_set.test.id}"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  depends_on = ["azurerm_key_vault_access_policy.disk-encryption"]
+}
+`, template, testAccAzureRMDiskEncryptionSet_resources(rString), fmt.Sprintf("%d", rInt)[0:8])
+}
+
+func testAccAzureRMWindowsVirtualMachine_dedicatedHost(rInt int, location string) string {
+	// the Linux template is OS-agnostic, so it's reused here
+	template := testAccAzureRMLinuxVirtualMachine_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctestDHG-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  platform_fault_domain_count = 2
+}
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctestDH-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  dedicated_host_group_id = "${azurerm_dedicated_host_group.test.id}"
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+  license_type            = "Windows_Server_Hybrid"
+}
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  size                = "Standard_D2s_v3"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  dedicated_host_id   = "${azurerm_dedicated_host.test.id}"
+  network_interface_ids = [
+    "${azurerm_network_interface.test.id}",
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, rInt, rInt, fmt.Sprintf("%d", rInt)[0:8])
+}
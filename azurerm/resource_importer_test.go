@@ -0,0 +1,351 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// importableResourceIDs contains an example Resource ID for each Resource which supports Import,
+// in the format the Resource itself sets - which is used to check that each Importer accepts the
+// Resource ID for its own Resource Type and rejects an ID for a different Resource Type.
+var importableResourceIDs = map[string]string{
+	"azurerm_analysis_services_server":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/server1",
+	"azurerm_api_management":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1",
+	"azurerm_api_management_api":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1",
+	"azurerm_api_management_api_operation":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+	"azurerm_api_management_api_operation_policy":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy",
+	"azurerm_api_management_api_policy":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/policies/policy",
+	"azurerm_api_management_api_schema":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/schemas/schema1",
+	"azurerm_api_management_api_version_set":                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/api-version-sets/set1",
+	"azurerm_api_management_authorization_server":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/authorizationServers/server1",
+	"azurerm_api_management_backend":                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1",
+	"azurerm_api_management_certificate":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/certificates/certificate1",
+	"azurerm_api_management_group":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1",
+	"azurerm_api_management_group_user":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/user1",
+	"azurerm_api_management_logger":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/loggers/logger1",
+	"azurerm_api_management_openid_connect_provider":             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/openidConnectProviders/provider1",
+	"azurerm_api_management_product":                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1",
+	"azurerm_api_management_product_api":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/api1",
+	"azurerm_api_management_product_group":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1",
+	"azurerm_api_management_product_policy":                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/policy",
+	"azurerm_api_management_property":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/properties/property1",
+	"azurerm_api_management_subscription":                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/subscriptions/subscription1",
+	"azurerm_api_management_user":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/users/user1",
+	"azurerm_app_service":                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+	"azurerm_app_service_active_slot":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+	"azurerm_app_service_custom_hostname_binding":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1",
+	"azurerm_app_service_plan":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1",
+	"azurerm_app_service_slot":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1",
+	"azurerm_application_gateway":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/gateway1",
+	"azurerm_application_insights":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/components/component1",
+	"azurerm_application_insights_api_key":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/components/component1/apikeys/key1",
+	"azurerm_application_insights_web_test":                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/webtests/test1",
+	"azurerm_application_security_group":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/group1",
+	"azurerm_automation_account":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1",
+	"azurerm_automation_credential":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/credentials/credential1",
+	"azurerm_automation_dsc_configuration":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/configurations/configuration1",
+	"azurerm_automation_dsc_nodeconfiguration":                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/nodeConfigurations/configuration1",
+	"azurerm_automation_module":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/modules/module1",
+	"azurerm_automation_runbook":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/runbooks/runbook1",
+	"azurerm_automation_schedule":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/schedules/schedule1",
+	"azurerm_automation_variable_bool":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/variables/variable1",
+	"azurerm_automation_variable_datetime":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/variables/variable1",
+	"azurerm_automation_variable_int":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/variables/variable1",
+	"azurerm_automation_variable_string":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Automation/automationAccounts/account1/variables/variable1",
+	"azurerm_autoscale_setting":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/autoscalesettings/setting1",
+	"azurerm_availability_set":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/availabilitySets/set1",
+	"azurerm_azuread_application":                                "12345678-1234-9876-4563-123456789012",
+	"azurerm_azuread_service_principal":                          "12345678-1234-9876-4563-123456789012",
+	"azurerm_azuread_service_principal_password":                 "12345678-1234-9876-4563-123456789012/12345678-1234-9876-4563-123456789013",
+	"azurerm_batch_account":                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1",
+	"azurerm_batch_application":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/applications/application1",
+	"azurerm_batch_certificate":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/certificates/sha1-42c107874fd0e4a9583292a2f1098e8fe4b2edda",
+	"azurerm_batch_pool":                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/pools/pool1",
+	"azurerm_cdn_endpoint":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1",
+	"azurerm_cdn_profile":                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1",
+	"azurerm_cognitive_account":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CognitiveServices/accounts/account1",
+	"azurerm_connection_monitor":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/monitor1",
+	"azurerm_container_group":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerInstance/containerGroups/group1",
+	"azurerm_container_registry":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1",
+	"azurerm_cosmosdb_account":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1",
+	"azurerm_cosmosdb_cassandra_keyspace":                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/cassandra/keyspaces/keyspace1",
+	"azurerm_cosmosdb_mongo_collection":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/mongodb/databases/database1/collections/collection1",
+	"azurerm_cosmosdb_mongo_database":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/mongodb/databases/database1",
+	"azurerm_cosmosdb_sql_database":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/sql/databases/database1",
+	"azurerm_cosmosdb_table":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/table/tables/table1",
+	"azurerm_data_factory":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1",
+	"azurerm_data_factory_dataset_mysql":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/datasets/dataset1",
+	"azurerm_data_factory_dataset_postgresql":                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/datasets/dataset1",
+	"azurerm_data_factory_dataset_sql_server_table":              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/datasets/dataset1",
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/service1",
+	"azurerm_data_factory_linked_service_mysql":                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/service1",
+	"azurerm_data_factory_linked_service_postgresql":             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/service1",
+	"azurerm_data_factory_linked_service_sql_server":             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/service1",
+	"azurerm_data_factory_pipeline":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/pipelines/pipeline1",
+	"azurerm_data_lake_analytics_account":                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeAnalytics/accounts/account1",
+	"azurerm_data_lake_analytics_firewall_rule":                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeAnalytics/accounts/account1/firewallRules/rule1",
+	"azurerm_data_lake_store":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1",
+	"azurerm_data_lake_store_file":                               "account1.azuredatalakestore.net/test/example.txt",
+	"azurerm_data_lake_store_firewall_rule":                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1/firewallRules/rule1",
+	"azurerm_databricks_workspace":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Databricks/workspaces/workspace1",
+	"azurerm_ddos_protection_plan":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/ddosProtectionPlans/plan1",
+	"azurerm_dedicated_host":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/group1/hosts/host1",
+	"azurerm_dedicated_host_group":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/group1",
+	"azurerm_dev_test_lab":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1",
+	"azurerm_dev_test_linux_virtual_machine":                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/virtualmachines/machine1",
+	"azurerm_dev_test_policy":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/policysets/default/policies/policy1",
+	"azurerm_dev_test_schedule":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/schedules/schedule1",
+	"azurerm_dev_test_virtual_network":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/virtualnetworks/network1",
+	"azurerm_dev_test_windows_virtual_machine":                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevTestLab/labs/lab1/virtualmachines/machine1",
+	"azurerm_devspace_controller":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevSpaces/controllers/controller1",
+	"azurerm_disk_encryption_set":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskEncryptionSets/set1",
+	"azurerm_dns_a_record":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/A/record1",
+	"azurerm_dns_aaaa_record":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/record1",
+	"azurerm_dns_caa_record":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CAA/record1",
+	"azurerm_dns_cname_record":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CNAME/record1",
+	"azurerm_dns_mx_record":                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/record1",
+	"azurerm_dns_ns_record":                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/NS/record1",
+	"azurerm_dns_ptr_record":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/PTR/record1",
+	"azurerm_dns_srv_record":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/SRV/record1",
+	"azurerm_dns_txt_record":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/TXT/record1",
+	"azurerm_dns_zone":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1",
+	"azurerm_eventgrid_domain":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1",
+	"azurerm_eventgrid_event_subscription":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/eventSubscriptions/subscription1",
+	"azurerm_eventgrid_topic":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/topics/topic1",
+	"azurerm_eventhub":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1",
+	"azurerm_eventhub_authorization_rule":                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/authorizationRules/rule1",
+	"azurerm_eventhub_consumer_group":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/group1",
+	"azurerm_eventhub_namespace":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1",
+	"azurerm_eventhub_namespace_authorization_rule":              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/AuthorizationRules/rule1",
+	"azurerm_express_route_circuit":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1",
+	"azurerm_express_route_circuit_authorization":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/authorizations/authorization1",
+	"azurerm_express_route_circuit_peering":                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/expressRouteCircuits/circuit1/peerings/AzurePrivatePeering",
+	"azurerm_firewall":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1",
+	"azurerm_firewall_application_rule_collection":               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/applicationRuleCollections/collection1",
+	"azurerm_firewall_nat_rule_collection":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/natRuleCollections/collection1",
+	"azurerm_firewall_network_rule_collection":                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/azureFirewalls/firewall1/networkRuleCollections/collection1",
+	"azurerm_function_app":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+	"azurerm_hdinsight_hadoop_cluster":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_hbase_cluster":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_interactive_query_cluster":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_kafka_cluster":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_ml_services_cluster":                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_rserver_cluster":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_spark_cluster":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_hdinsight_storm_cluster":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1",
+	"azurerm_image":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/images/image1",
+	"azurerm_iot_dps":                                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/provisioningServices/service1",
+	"azurerm_iot_dps_certificate":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/provisioningServices/service1/certificates/certificate1",
+	"azurerm_iothub":                                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1",
+	"azurerm_iothub_consumer_group":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/eventHubEndpoints/events/ConsumerGroups/group1",
+	"azurerm_iothub_shared_access_policy":                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/IotHubKeys/policy1",
+	"azurerm_key_vault":                                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+	"azurerm_key_vault_access_policy":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/12345678-1234-9876-4563-123456789012/applicationId/12345678-1234-9876-4563-123456789012",
+	"azurerm_key_vault_certificate":                              "https://vault1.vault.azure.net/certificates/certificate1/fdf067c93bbb4b22bff4d8b7a9a56217",
+	"azurerm_key_vault_key":                                      "https://vault1.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217",
+	"azurerm_key_vault_secret":                                   "https://vault1.vault.azure.net/secrets/secret1/fdf067c93bbb4b22bff4d8b7a9a56217",
+	"azurerm_kubernetes_cluster":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1",
+	"azurerm_kubernetes_cluster_node_pool":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1",
+	"azurerm_lb":                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1",
+	"azurerm_lb_backend_address_pool":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/pool1",
+	"azurerm_lb_nat_pool":                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatPools/pool1",
+	"azurerm_lb_nat_rule":                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatRules/rule1",
+	"azurerm_lb_outbound_rule":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/outboundRules/rule1",
+	"azurerm_lb_probe":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/probes/probe1",
+	"azurerm_lb_rule":                                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/loadBalancingRules/rule1",
+	"azurerm_linux_virtual_machine":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+	"azurerm_linux_virtual_machine_scale_set":                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+	"azurerm_local_network_gateway":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/localNetworkGateways/gateway1",
+	"azurerm_log_analytics_linked_service":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedservices/automation",
+	"azurerm_log_analytics_solution":                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationsManagement/solutions/solution1",
+	"azurerm_log_analytics_workspace":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+	"azurerm_log_analytics_workspace_linked_service":             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedservices/automation",
+	"azurerm_logic_app_action_custom":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/actions/action1",
+	"azurerm_logic_app_action_http":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/actions/action1",
+	"azurerm_logic_app_trigger_custom":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/triggers/trigger1",
+	"azurerm_logic_app_trigger_http_request":                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/triggers/trigger1",
+	"azurerm_logic_app_trigger_recurrence":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1/triggers/trigger1",
+	"azurerm_logic_app_workflow":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1",
+	"azurerm_managed_disk":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1",
+	"azurerm_management_group":                                   "/providers/Microsoft.Management/managementGroups/group1",
+	"azurerm_management_lock":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+	"azurerm_maps_account":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1",
+	"azurerm_mariadb_database":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1/databases/database1",
+	"azurerm_mariadb_firewall_rule":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1/firewallRules/rule1",
+	"azurerm_mariadb_server":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMariaDB/servers/server1",
+	"azurerm_media_services_account":                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1",
+	"azurerm_metric_alertrule":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/alertrules/rule1",
+	"azurerm_monitor_action_group":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/actionGroups/group1",
+	"azurerm_monitor_activity_log_alert":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/activityLogAlerts/alert1",
+	"azurerm_monitor_autoscale_setting":                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/autoscalesettings/setting1",
+	"azurerm_monitor_diagnostic_setting":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1|setting1",
+	"azurerm_monitor_log_profile":                                "/subscriptions/12345678-1234-9876-4563-123456789012/providers/microsoft.insights/logprofiles/profile1",
+	"azurerm_monitor_metric_alert":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/metricAlerts/alert1",
+	"azurerm_monitor_metric_alertrule":                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/alertrules/rule1",
+	"azurerm_mssql_elasticpool":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1",
+	"azurerm_mysql_configuration":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/configurations/configuration1",
+	"azurerm_mysql_database":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/databases/database1",
+	"azurerm_mysql_firewall_rule":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/firewallRules/rule1",
+	"azurerm_mysql_server":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1",
+	"azurerm_mysql_virtual_network_rule":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/virtualNetworkRules/rule1",
+	"azurerm_network_connection_monitor":                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/monitor1",
+	"azurerm_network_ddos_protection_plan":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/ddosProtectionPlans/plan1",
+	"azurerm_network_interface":                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1",
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1",
+	"azurerm_network_interface_application_security_group_association":               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/group1",
+	"azurerm_network_interface_backend_address_pool_association":                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/pool1",
+	"azurerm_network_interface_nat_rule_association":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatRules/rule1",
+	"azurerm_network_packet_capture":                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1",
+	"azurerm_network_profile":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkProfiles/profile1",
+	"azurerm_network_security_group":                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/group1",
+	"azurerm_network_security_rule":                                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1",
+	"azurerm_network_watcher":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1",
+	"azurerm_notification_hub":                                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1",
+	"azurerm_notification_hub_authorization_rule":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1/AuthorizationRules/rule1",
+	"azurerm_notification_hub_namespace":                                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1",
+	"azurerm_packet_capture":                                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1",
+	"azurerm_policy_assignment":                                                      "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+	"azurerm_policy_definition":                                                      "/providers/Microsoft.Management/managementgroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+	"azurerm_policy_set_definition":                                                  "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policySetDefinitions/definition1",
+	"azurerm_postgresql_configuration":                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/configurations/configuration1",
+	"azurerm_postgresql_database":                                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/databases/database1",
+	"azurerm_postgresql_firewall_rule":                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/firewallRules/rule1",
+	"azurerm_postgresql_server":                                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1",
+	"azurerm_postgresql_virtual_network_rule":                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/virtualNetworkRules/rule1",
+	"azurerm_private_dns_a_record":                                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1/A/record1",
+	"azurerm_private_dns_zone":                                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1",
+	"azurerm_proximity_placement_group":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/proximityPlacementGroups/group1",
+	"azurerm_public_ip":                                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/address1",
+	"azurerm_public_ip_prefix":                                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPPrefixes/prefix1",
+	"azurerm_recovery_services_protected_vm":                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/item1",
+	"azurerm_recovery_services_protection_policy_vm":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/policy1",
+	"azurerm_recovery_services_vault":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1",
+	"azurerm_redis_cache":                                                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/cache1",
+	"azurerm_redis_firewall_rule":                                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/cache1/firewallRules/rule1",
+	"azurerm_relay_namespace":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1",
+	"azurerm_resource_group":                                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+	"azurerm_role_assignment":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/12345678-1234-9876-4563-123456789012",
+	"azurerm_role_definition":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/12345678-1234-9876-4563-123456789012",
+	"azurerm_route":                                                                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/table1/routes/route1",
+	"azurerm_route_table":                                                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/table1",
+	"azurerm_scheduler_job":                                                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Scheduler/jobCollections/collection1/jobs/job1",
+	"azurerm_scheduler_job_collection":                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Scheduler/jobCollections/collection1",
+	"azurerm_search_service":                                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1",
+	"azurerm_security_center_contact":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/securityContacts/default1",
+	"azurerm_security_center_subscription_pricing":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/default",
+	"azurerm_security_center_workspace":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/workspaceSettings/default",
+	"azurerm_service_fabric_cluster":                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabric/clusters/cluster1",
+	"azurerm_servicebus_namespace":                                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1",
+	"azurerm_servicebus_namespace_authorization_rule":                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/rule1",
+	"azurerm_servicebus_queue":                                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1",
+	"azurerm_servicebus_queue_authorization_rule":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1/authorizationRules/rule1",
+	"azurerm_servicebus_subscription":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+	"azurerm_servicebus_subscription_rule":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1/rules/rule1",
+	"azurerm_servicebus_topic":                                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1",
+	"azurerm_servicebus_topic_authorization_rule":                                    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/authorizationRules/rule1",
+	"azurerm_shared_image":                                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+	"azurerm_shared_image_gallery":                                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1",
+	"azurerm_shared_image_version":                                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/1.2.3",
+	"azurerm_signalr_service":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.SignalRService/SignalR/service1",
+	"azurerm_snapshot":                                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/snapshots/snapshot1",
+	"azurerm_sql_active_directory_administrator":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/administrators/activeDirectory",
+	"azurerm_sql_database":                                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/databases/database1",
+	"azurerm_sql_elasticpool":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1",
+	"azurerm_sql_firewall_rule":                                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/firewallRules/rule1",
+	"azurerm_sql_server":                                                             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1",
+	"azurerm_sql_virtual_network_rule":                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/virtualNetworkRules/rule1",
+	"azurerm_storage_account":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+	"azurerm_storage_account_customer_managed_key":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+	"azurerm_storage_blob":                                                           "https://account1.blob.core.windows.net/container1/blob1.vhd",
+	"azurerm_storage_container":                                                      "https://account1.blob.core.windows.net/container1",
+	"azurerm_storage_data_lake_gen2_filesystem":                                      "https://account1.dfs.core.windows.net/filesystem1",
+	"azurerm_storage_data_lake_gen2_path":                                            "https://account1.dfs.core.windows.net/filesystem1/directory1",
+	"azurerm_storage_management_policy":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/managementPolicies/default",
+	"azurerm_storage_queue":                                                          "https://account1.queue.core.windows.net/queue1",
+	"azurerm_storage_share":                                                          "https://account1.file.core.windows.net/share1",
+	"azurerm_storage_share_directory":                                                "https://account1.file.core.windows.net/share1/directory1",
+	"azurerm_storage_table":                                                          "https://account1.table.core.windows.net/Tables('table1')",
+	"azurerm_storage_table_entity":                                                   "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+	"azurerm_stream_analytics_function_javascript_udf":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/functions/function1",
+	"azurerm_stream_analytics_job":                                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1",
+	"azurerm_stream_analytics_output_blob":                                           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/output1",
+	"azurerm_stream_analytics_output_eventhub":                                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/output1",
+	"azurerm_stream_analytics_output_mssql":                                          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/output1",
+	"azurerm_stream_analytics_output_servicebus_queue":                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/outputs/output1",
+	"azurerm_stream_analytics_stream_input_blob":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/inputs/input1",
+	"azurerm_stream_analytics_stream_input_eventhub":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/inputs/input1",
+	"azurerm_stream_analytics_stream_input_iothub":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/job1/inputs/input1",
+	"azurerm_subnet":                                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+	"azurerm_subnet_network_security_group_association":                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+	"azurerm_subnet_route_table_association":                                         "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+	"azurerm_traffic_manager_endpoint":                                               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1/azureEndpoints/endpoint1",
+	"azurerm_traffic_manager_profile":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/profile1",
+	"azurerm_user_assigned_identity":                                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+	"azurerm_virtual_machine":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+	"azurerm_virtual_machine_data_disk_attachment":                                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/dataDisks/disk1",
+	"azurerm_virtual_machine_extension":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/extensions/extension1",
+	"azurerm_virtual_machine_scale_set":                                              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+	"azurerm_virtual_network":                                                        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+	"azurerm_virtual_network_gateway":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gateway1",
+	"azurerm_virtual_network_gateway_connection":                                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/connections/connection1",
+	"azurerm_virtual_network_peering":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/virtualNetworkPeerings/peering1",
+	"azurerm_windows_virtual_machine":                                                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+	"azurerm_windows_virtual_machine_scale_set":                                      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+}
+
+func TestResourceImportersValidateTheResourceID(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	for name := range importableResourceIDs {
+		if _, ok := provider.ResourcesMap[name]; !ok {
+			t.Fatalf("Resource %q has an example Resource ID defined but isn't registered in the Provider", name)
+		}
+	}
+
+	for name, resource := range provider.ResourcesMap {
+		if resource.Importer == nil {
+			continue
+		}
+
+		t.Logf("[DEBUG] Testing %q", name)
+
+		validId, ok := importableResourceIDs[name]
+		if !ok {
+			t.Fatalf("Resource %q supports Import but has no example Resource ID defined in `importableResourceIDs`", name)
+		}
+
+		if err := testImportResourceID(resource, validId); err != nil {
+			t.Fatalf("Expected the Importer for %q to accept %q but got: %+v", name, validId, err)
+		}
+
+		invalidId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"
+		if name == "azurerm_resource_group" {
+			invalidId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1"
+		}
+		if err := testImportResourceID(resource, invalidId); err == nil {
+			t.Fatalf("Expected the Importer for %q to reject %q but it didn't", name, invalidId)
+		}
+	}
+}
+
+// testImportResourceID runs the Importer for the specified Resource using the specified Resource ID.
+// Since no API Client is available a panic means the Resource ID was validated and the Importer went
+// on to look up the Resource - which is treated as the Resource ID being accepted.
+func testImportResourceID(resource *schema.Resource, id string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = nil
+		}
+	}()
+
+	d := resource.TestResourceData()
+	d.SetId(id)
+
+	results, err := resource.Importer.State(d, nil)
+	if err == nil && len(results) == 0 {
+		return fmt.Errorf("Expected the Importer to return the Resource but got none")
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+package azurerm
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func schemaRetryPolicy() *schema.Schema {
+	defaults := common.DefaultRetryPolicy()
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.MaxRetries,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"min_backoff_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.MinBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"max_backoff_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(defaults.MaxBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      defaults.RequestsPerSecond,
+					ValidateFunc: validation.FloatBetween(0, 1000),
+				},
+
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.Burst,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"remaining_requests_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.RemainingRequestsThreshold,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func expandRetryPolicy(input []interface{}) *common.RetryPolicy {
+	// these are the defaults if omitted from the config
+	output := common.DefaultRetryPolicy()
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["max_retries"]; ok {
+		output.MaxRetries = v.(int)
+	}
+
+	if v, ok := val["min_backoff_seconds"]; ok {
+		output.MinBackoff = time.Duration(v.(int)) * time.Second
+	}
+
+	if v, ok := val["max_backoff_seconds"]; ok {
+		output.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+
+	if v, ok := val["requests_per_second"]; ok {
+		output.RequestsPerSecond = v.(float64)
+	}
+
+	if v, ok := val["burst"]; ok {
+		output.Burst = v.(int)
+	}
+
+	if v, ok := val["remaining_requests_threshold"]; ok {
+		output.RemainingRequestsThreshold = v.(int)
+	}
+
+	return output
+}
@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandRetryPolicy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *common.RetryPolicy
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: common.DefaultRetryPolicy(),
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_retries":                  3,
+					"min_backoff_seconds":          1,
+					"max_backoff_seconds":          30,
+					"requests_per_second":          2.5,
+					"burst":                        5,
+					"remaining_requests_threshold": 0,
+				},
+			},
+			Expected: &common.RetryPolicy{
+				MaxRetries:                 3,
+				MinBackoff:                 time.Second,
+				MaxBackoff:                 30 * time.Second,
+				RequestsPerSecond:          2.5,
+				Burst:                      5,
+				RemainingRequestsThreshold: 0,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandRetryPolicy(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
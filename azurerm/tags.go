@@ -2,16 +2,19 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
+// tagsSchema returns the Schema for the Tags on a Resource - which intentionally isn't Computed, since the
+// tags inherited from the `default_tags` configured on the Provider are exposed in `tags_all` instead.
 func tagsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:         schema.TypeMap,
 		Optional:     true,
-		Computed:     true,
 		ValidateFunc: validateAzureRMTags,
 	}
 }
@@ -26,6 +29,16 @@ func tagsForceNewSchema() *schema.Schema {
 	}
 }
 
+// tagsSchemaWithRules returns the Schema for the Tags on a Resource which has different limits to
+// the defaults (for example Storage Accounts)
+func tagsSchemaWithRules(rules tags.ValidationRules) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		ValidateFunc: tags.Validate(rules),
+	}
+}
+
 func tagsForDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -34,37 +47,11 @@ func tagsForDataSourceSchema() *schema.Schema {
 }
 
 func tagValueToString(v interface{}) (string, error) {
-	switch value := v.(type) {
-	case string:
-		return value, nil
-	case int:
-		return fmt.Sprintf("%d", value), nil
-	default:
-		return "", fmt.Errorf("unknown tag type %T in tag value", value)
-	}
+	return tags.ValueToString(v)
 }
 
-func validateAzureRMTags(v interface{}, _ string) (warnings []string, errors []error) {
-	tagsMap := v.(map[string]interface{})
-
-	if len(tagsMap) > 15 {
-		errors = append(errors, fmt.Errorf("a maximum of 15 tags can be applied to each ARM resource"))
-	}
-
-	for k, v := range tagsMap {
-		if len(k) > 512 {
-			errors = append(errors, fmt.Errorf("the maximum length for a tag key is 512 characters: %q is %d characters", k, len(k)))
-		}
-
-		value, err := tagValueToString(v)
-		if err != nil {
-			errors = append(errors, err)
-		} else if len(value) > 256 {
-			errors = append(errors, fmt.Errorf("the maximum length for a tag value is 256 characters: the value for %q is %d characters", k, len(value)))
-		}
-	}
-
-	return warnings, errors
+func validateAzureRMTags(v interface{}, k string) (warnings []string, errors []error) {
+	return tags.Validate(tags.DefaultRules)(v, k)
 }
 
 func expandTags(tagsMap map[string]interface{}) map[string]*string {
@@ -79,6 +66,41 @@ func expandTags(tagsMap map[string]interface{}) map[string]*string {
 	return output
 }
 
+// expandTagsWithDefaults expands the specified tags, merged with the `default_tags` configured on the
+// Provider - where a tag is specified both on the resource and in the defaults the resource wins.
+// Tag keys are case-insensitive in Azure, so keys are compared case-insensitively.
+func expandTagsWithDefaults(meta interface{}, tagsMap map[string]interface{}) map[string]*string {
+	output := make(map[string]*string)
+
+	if client, ok := meta.(*ArmClient); ok {
+		for k, v := range client.defaultTags {
+			if _, exists := findTagCaseInsensitive(tagsMap, k); exists {
+				continue
+			}
+
+			//Validate should have ignored this error already
+			value, _ := tagValueToString(v)
+			output[k] = &value
+		}
+	}
+
+	for k, v := range expandTags(tagsMap) {
+		output[k] = v
+	}
+
+	return output
+}
+
+func findTagCaseInsensitive(tagsMap map[string]interface{}, key string) (interface{}, bool) {
+	for k, v := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
 func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*string {
 	if len(tagNames) == 0 {
 		return tagsMap
@@ -112,5 +134,171 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 		output[i] = *v
 	}
 
+	// where the Resource exposes `tags_all`, the tags which were inherited from the `default_tags` configured
+	// on the Provider (that is, those in `tags_all` but not in `tags`) aren't included in `tags`
+	if previous, ok := d.Get("tags_all").(map[string]interface{}); ok && len(previous) > 0 {
+		configured, _ := d.Get("tags").(map[string]interface{})
+		for k := range output {
+			if _, exists := findTagCaseInsensitive(configured, k); exists {
+				continue
+			}
+			if _, inherited := findTagCaseInsensitive(previous, k); inherited {
+				delete(output, k)
+			}
+		}
+	}
+
 	d.Set("tags", output)
+	d.Set("tags_all", flattenTags(tagMap))
+}
+
+func flattenTags(tagMap map[string]*string) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagMap))
+	for k, v := range tagMap {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+	return output
+}
+
+// hasTagsChange returns whether the tags for the Resource have changed - either the `tags` specified on the
+// Resource or those inherited from the `default_tags` configured on the Provider.
+func hasTagsChange(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.HasChange("tags_all")
+}
+
+// resourcesWithoutARMTags are Resources which expose a `tags` field which doesn't map to Azure Resource
+// Manager tags (for example DNS Records, where these are stored as metadata on the record) - and as such
+// the `default_tags` and `required_tag_keys` configured on the Provider don't apply to them.
+var resourcesWithoutARMTags = map[string]bool{
+	"azurerm_dns_a_record":          true,
+	"azurerm_dns_aaaa_record":       true,
+	"azurerm_dns_caa_record":        true,
+	"azurerm_dns_cname_record":      true,
+	"azurerm_dns_mx_record":         true,
+	"azurerm_dns_ns_record":         true,
+	"azurerm_dns_ptr_record":        true,
+	"azurerm_dns_srv_record":        true,
+	"azurerm_dns_txt_record":        true,
+	"azurerm_key_vault_certificate": true,
+	"azurerm_key_vault_key":         true,
+	"azurerm_key_vault_secret":      true,
+	"azurerm_private_dns_a_record":  true,
+}
+
+// customizeDiffForTags adds a Computed `tags_all` field and a CustomizeDiff to each of the specified Resources
+// which supports Tags, which:
+//   - plans `tags_all` as the `tags` specified on the Resource merged with the `default_tags` configured on the
+//     Provider, so that default tags which are added, changed or removed show up in the diff for existing
+//     resources.
+//   - ensures that the `required_tag_keys` configured on the Provider are specified at plan time -
+//     either on the Resource itself or via the `default_tags` configured on the Provider.
+//
+// Resources where changing the `tags` forces a new resource to be created don't have the `default_tags`
+// applied, since otherwise changing the `default_tags` on the Provider would recreate every one of them.
+func customizeDiffForTags(resources map[string]*schema.Resource) {
+	for name, resource := range resources {
+		if resourcesWithoutARMTags[name] {
+			continue
+		}
+
+		s, ok := resource.Schema["tags"]
+		if !ok || s.Type != schema.TypeMap || !s.Optional {
+			continue
+		}
+
+		applyDefaultTags := !s.ForceNew
+		if applyDefaultTags {
+			resource.Schema["tags_all"] = &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			}
+		}
+
+		validateFunc := s.ValidateFunc
+		existing := resource.CustomizeDiff
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			if err := validateRequiredTags(d, meta, applyDefaultTags); err != nil {
+				return err
+			}
+
+			if applyDefaultTags {
+				if err := setTagsAll(d, meta, validateFunc); err != nil {
+					return err
+				}
+			}
+
+			if existing != nil {
+				return existing(d, meta)
+			}
+
+			return nil
+		}
+	}
+}
+
+// setTagsAll sets the planned value for `tags_all` to the tags specified on the Resource merged with the
+// `default_tags` configured on the Provider (matching the tags sent to Azure by `expandTagsWithDefaults`)
+// and then validates the merged tags using the Resource's validation rules for `tags`.
+func setTagsAll(d *schema.ResourceDiff, meta interface{}, validateFunc schema.SchemaValidateFunc) error {
+	// the value isn't known until apply, so neither is the merged value
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	configured := make(map[string]interface{})
+	if v, ok := d.Get("tags").(map[string]interface{}); ok {
+		configured = v
+	}
+
+	merged := make(map[string]interface{})
+	for k, v := range expandTagsWithDefaults(meta, configured) {
+		merged[k] = *v
+	}
+
+	if validateFunc != nil {
+		_, errors := validateFunc(merged, "tags")
+		if len(errors) > 0 {
+			return fmt.Errorf("Error validating `tags` merged with the `default_tags` configured on the Provider: %+v", errors[0])
+		}
+	}
+
+	if existing, ok := d.Get("tags_all").(map[string]interface{}); ok && reflect.DeepEqual(existing, merged) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", merged)
+}
+
+// validateRequiredTags validates that the `required_tag_keys` configured on the Provider are present in the
+// `tags` for this Resource - including those which come from the `default_tags` when these are applied.
+func validateRequiredTags(d *schema.ResourceDiff, meta interface{}, includeDefaultTags bool) error {
+	client, ok := meta.(*ArmClient)
+	if !ok || len(client.requiredTagKeys) == 0 {
+		return nil
+	}
+
+	// the value isn't known until apply, so we can't validate it at this point
+	if !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	merged := make(map[string]interface{})
+	if includeDefaultTags {
+		for k, v := range client.defaultTags {
+			merged[k] = v
+		}
+	}
+	if v, ok := d.Get("tags").(map[string]interface{}); ok {
+		for k, value := range v {
+			merged[k] = value
+		}
+	}
+
+	if err := tags.ValidateRequiredKeys(merged, client.requiredTagKeys); err != nil {
+		return fmt.Errorf("Error validating `tags`: %+v (configured via `required_tag_keys` on the Provider)", err)
+	}
+
+	return nil
 }
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
 	tagsMap := make(map[string]interface{})
-	for i := 0; i < 16; i++ {
+	for i := 0; i < 51; i++ {
 		tagsMap[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
 	}
 
@@ -18,7 +23,7 @@ func TestValidateMaximumNumberOfARMTags(t *testing.T) {
 		t.Fatal("Expected one validation error for too many tags")
 	}
 
-	if !strings.Contains(es[0].Error(), "a maximum of 15 tags") {
+	if !strings.Contains(es[0].Error(), "a maximum of 50 tags") {
 		t.Fatal("Wrong validation error message for too many tags")
 	}
 }
@@ -113,3 +118,323 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestExpandARMTagsWithDefaults(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"CostCentre": "1234",
+			"owner":      "platform",
+		},
+	}
+
+	testData := map[string]interface{}{
+		"Owner":       "webapp",
+		"environment": "Production",
+	}
+
+	expanded := expandTagsWithDefaults(client, testData)
+
+	expected := map[string]string{
+		"CostCentre":  "1234",
+		"Owner":       "webapp",
+		"environment": "Production",
+	}
+
+	if len(expanded) != len(expected) {
+		t.Fatalf("Expected %d results in expanded tag map, got %d", len(expected), len(expanded))
+	}
+
+	for k, v := range expected {
+		if expanded[k] == nil || *expanded[k] != v {
+			t.Fatalf("Expected tag %q to be %q but got %v", k, v, expanded[k])
+		}
+	}
+}
+
+func TestSetDefaultTagsDuringPlan(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"cost_centre": "1234",
+			"team":        "core",
+		},
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": resourceArmResourceGroup(),
+	}
+	customizeDiffForTags(resources)
+	resource := resources["azurerm_resource_group"]
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+			"team":        "webapp",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	// the resource was created before `cost_centre` was added to the `default_tags` on the Provider
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"id":                   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			"name":                 "example",
+			"location":             "westeurope",
+			"tags.%":               "2",
+			"tags.environment":     "Production",
+			"tags.team":            "webapp",
+			"tags_all.%":           "2",
+			"tags_all.environment": "Production",
+			"tags_all.team":        "webapp",
+		},
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(rawConfig), client)
+	if err != nil {
+		t.Fatalf("Error computing diff: %+v", err)
+	}
+	if diff == nil {
+		t.Fatalf("Expected a diff adding the new default tag but didn't get one")
+	}
+
+	expected := map[string]string{
+		"tags_all.%":           "3",
+		"tags_all.cost_centre": "1234",
+	}
+	if len(diff.Attributes) != len(expected) {
+		t.Fatalf("Expected %d attributes in the diff but got %d: %+v", len(expected), len(diff.Attributes), diff.Attributes)
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("Expected %q to be in the diff but it wasn't: %+v", k, diff.Attributes)
+		}
+		if attr.New != v {
+			t.Fatalf("Expected the new value for %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+
+	// once the default tag has been applied there should be no diff
+	state.Attributes["tags_all.%"] = "3"
+	state.Attributes["tags_all.cost_centre"] = "1234"
+	diff, err = resource.Diff(state, terraform.NewResourceConfig(rawConfig), client)
+	if err != nil {
+		t.Fatalf("Error computing diff: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("Expected no diff once the default tags were applied but got: %+v", diff.Attributes)
+	}
+}
+
+func TestCustomizeDiffForTags(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": resourceArmResourceGroup(),
+		"azurerm_dns_a_record":   resourceArmDnsARecord(),
+		"azurerm_role_definition": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	customizeDiffForTags(resources)
+
+	if resources["azurerm_resource_group"].CustomizeDiff == nil {
+		t.Fatalf("Expected a CustomizeDiff to be configured for `azurerm_resource_group`")
+	}
+
+	if resources["azurerm_dns_a_record"].CustomizeDiff != nil {
+		t.Fatalf("Expected no CustomizeDiff to be configured for `azurerm_dns_a_record` since it doesn't use ARM Tags")
+	}
+
+	if resources["azurerm_role_definition"].CustomizeDiff != nil {
+		t.Fatalf("Expected no CustomizeDiff to be configured for a resource without Tags")
+	}
+}
+
+func TestSetDefaultTagsRemovedFromProvider(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"team": "core",
+		},
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": resourceArmResourceGroup(),
+	}
+	customizeDiffForTags(resources)
+	resource := resources["azurerm_resource_group"]
+
+	// `tags` isn't specified in the config, so they only come from the `default_tags`
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	// the resource was created when `cost_centre` was also included in the `default_tags` on the Provider
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"id":                   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			"name":                 "example",
+			"location":             "westeurope",
+			"tags_all.%":           "2",
+			"tags_all.cost_centre": "1234",
+			"tags_all.team":        "core",
+		},
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(rawConfig), client)
+	if err != nil {
+		t.Fatalf("Error computing diff: %+v", err)
+	}
+	if diff == nil {
+		t.Fatalf("Expected a diff removing the default tag but didn't get one")
+	}
+
+	attr, ok := diff.Attributes["tags_all.cost_centre"]
+	if !ok || !attr.NewRemoved {
+		t.Fatalf("Expected `tags_all.cost_centre` to be removed but got: %+v", diff.Attributes)
+	}
+	if attr, ok := diff.Attributes["tags_all.team"]; ok && attr.Old != attr.New {
+		t.Fatalf("Expected `tags_all.team` to be unchanged but got: %+v", diff.Attributes)
+	}
+}
+
+func TestSetDefaultTagsValidatesMergedTags(t *testing.T) {
+	defaultTags := make(map[string]interface{})
+	for i := 0; i < 50; i++ {
+		defaultTags[fmt.Sprintf("default%d", i)] = "value"
+	}
+	client := &ArmClient{
+		defaultTags: defaultTags,
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": resourceArmResourceGroup(),
+	}
+	customizeDiffForTags(resources)
+	resource := resources["azurerm_resource_group"]
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	_, err = resource.Diff(&terraform.InstanceState{}, terraform.NewResourceConfig(rawConfig), client)
+	if err == nil {
+		t.Fatalf("Expected an error since the merged tags exceed the maximum number of tags but didn't get one")
+	}
+}
+
+func TestSetDefaultTagsSkipsForceNewTags(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"team": "core",
+		},
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_container_group": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+
+				"tags": tagsForceNewSchema(),
+			},
+		},
+	}
+	customizeDiffForTags(resources)
+	resource := resources["azurerm_container_group"]
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerInstance/containerGroups/example",
+		Attributes: map[string]string{
+			"id":               "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerInstance/containerGroups/example",
+			"name":             "example",
+			"tags.%":           "1",
+			"tags.environment": "Production",
+		},
+	}
+
+	// adding a default tag on the Provider mustn't recreate resources where the tags are ForceNew
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(rawConfig), client)
+	if err != nil {
+		t.Fatalf("Error computing diff: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("Expected no diff for a resource with ForceNew tags but got: %+v", diff.Attributes)
+	}
+}
+
+func TestFlattenAndSetTagsExcludesInheritedTags(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": resourceArmResourceGroup(),
+		"azurerm_dns_a_record":   resourceArmDnsARecord(),
+	}
+	customizeDiffForTags(resources)
+
+	// the `team` tag was inherited from the `default_tags` configured on the Provider
+	d := resources["azurerm_resource_group"].Data(&terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"tags.%":               "1",
+			"tags.environment":     "Production",
+			"tags_all.%":           "2",
+			"tags_all.environment": "Production",
+			"tags_all.team":        "core",
+		},
+	})
+	flattenAndSetTags(d, map[string]*string{
+		"environment": utils.String("Production"),
+		"team":        utils.String("core"),
+		"owner":       utils.String("someone"),
+	})
+
+	tags := d.Get("tags").(map[string]interface{})
+	if len(tags) != 2 || tags["environment"] != "Production" || tags["owner"] != "someone" {
+		t.Fatalf("Expected `tags` to contain `environment` and `owner` but got: %+v", tags)
+	}
+	tagsAll := d.Get("tags_all").(map[string]interface{})
+	if len(tagsAll) != 3 {
+		t.Fatalf("Expected `tags_all` to contain 3 tags but got: %+v", tagsAll)
+	}
+
+	// resources which don't expose `tags_all` should have all of the tags set into `tags`
+	d = resources["azurerm_dns_a_record"].Data(&terraform.InstanceState{})
+	flattenAndSetTags(d, map[string]*string{
+		"team": utils.String("core"),
+	})
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) != 1 {
+		t.Fatalf("Expected `tags` to contain 1 tag but got: %+v", tags)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func validateRFC3339Date(v interface{}, k string) (warnings []string, errors []error) {
@@ -205,3 +206,28 @@ func validateFilePath() schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// validateNetworkInterfaceAssociationID returns a SchemaValidateFunc which validates that the specified value
+// is in the format `{networkInterfaceIPConfigurationId}|{resourceId}` - as used by the Network Interface
+// Association resources - where the associated Resource ID is validated using the specified ValidateFunc
+func validateNetworkInterfaceAssociationID(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return warnings, errors
+		}
+
+		segments := strings.Split(v, "|")
+		if len(segments) != 2 {
+			errors = append(errors, fmt.Errorf("Expected %q to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{resourceId} but got %q", k, v))
+			return warnings, errors
+		}
+
+		if warnings, errors = resourceid.ValidateNetworkInterfaceIPConfigurationID(segments[0], k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		return validateFunc(segments[1], k)
+	}
+}